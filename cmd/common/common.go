@@ -1,6 +1,6 @@
 package common
 
 const (
-	GooseMigrationVersion = 3
+	GooseMigrationVersion = 16
 	ServerVersion         = "1.3.5"
 )
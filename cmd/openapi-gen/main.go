@@ -0,0 +1,38 @@
+// openapi-gen writes the SDK's API paths from the server's OpenAPI document, so the SDK stops
+// compiling if it calls an operation the server no longer has. Run it with go generate ./pkg/sdk
+// or make genapi after changing the routes; TestSDKPathsUpToDate fails until you do.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/server"
+)
+
+func main() {
+	if err := Run(); err != nil {
+		fmt.Println("An error occured:", err)
+		os.Exit(1)
+	}
+}
+
+func Run() error {
+	out := flag.String("o", "pkg/sdk/paths_gen.go", "file to write the sdk paths to")
+	flag.Parse()
+
+	gin.SetMode(gin.ReleaseMode)
+	src, err := server.SDKPaths()
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", *out, err)
+	}
+
+	fmt.Printf("Wrote %s\n", *out)
+	return nil
+}
@@ -59,7 +59,7 @@ func Run() error {
 		return fmt.Errorf("initializing app: %w", err)
 	}
 
-	if !a.Config.SkipLaunchSyncs {
+	if !a.Svc.Config.Current().SkipLaunchSyncs {
 		slog.Info("syncing webex rooms and connectwise boards")
 		p := &models.SyncPayload{
 			WebexRecipients:    true,
@@ -82,6 +82,10 @@ func Run() error {
 		slog.Info("SKIP AUTH ENABLED")
 	}
 
+	go a.Svc.Config.Run(ctx)
+	go a.Svc.Scheduler.Run(ctx)
+	go a.Svc.Reconciler.Run(ctx)
+
 	if !a.TestFlags.SkipHooks {
 		if err := a.Svc.Hooks.ProcessAllHooks(); err != nil {
 			return fmt.Errorf("processing connectwise hooks: %w", err)
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	exportCmd = &cobra.Command{
+		Use:               "export",
+		PersistentPreRunE: createClient,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := getServerState()
+			if err != nil {
+				return err
+			}
+
+			m := s.toManifest()
+			var buf bytes.Buffer
+			enc := yaml.NewEncoder(&buf)
+			enc.SetIndent(2)
+			if err := enc.Encode(m); err != nil {
+				return fmt.Errorf("encoding yaml: %w", err)
+			}
+
+			if exportFile == "" {
+				fmt.Print(buf.String())
+				return nil
+			}
+
+			if err := os.WriteFile(exportFile, buf.Bytes(), 0o644); err != nil {
+				return fmt.Errorf("writing file: %w", err)
+			}
+
+			fmt.Printf("Exported %d rules and %d forwards to %s\n", len(m.Rules), len(m.Forwards), exportFile)
+			return nil
+		},
+	}
+
+	applyCmd = &cobra.Command{
+		Use:               "apply",
+		PersistentPreRunE: createClient,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if applyFile == "" {
+				return errors.New("no file provided - pass with flag --file or -f")
+			}
+
+			data, err := os.ReadFile(applyFile)
+			if err != nil {
+				return fmt.Errorf("reading file: %w", err)
+			}
+
+			m := &manifest{}
+			dec := yaml.NewDecoder(bytes.NewReader(data))
+			dec.KnownFields(true)
+			if err := dec.Decode(m); err != nil {
+				return fmt.Errorf("parsing %s: %w", applyFile, err)
+			}
+
+			s, err := getServerState()
+			if err != nil {
+				return err
+			}
+
+			steps, err := planManifest(m, s)
+			if err != nil {
+				return err
+			}
+
+			if len(steps) == 0 {
+				fmt.Println("No changes; the server already matches the file")
+				return nil
+			}
+
+			for _, st := range steps {
+				fmt.Println(st.desc)
+			}
+
+			if applyDryRun {
+				fmt.Printf("\n%d changes planned; run without --dry-run to apply them\n", len(steps))
+				return nil
+			}
+
+			for i, st := range steps {
+				if err := st.run(); err != nil {
+					return fmt.Errorf("applied %d of %d changes; %s: %w", i, len(steps), st.desc, err)
+				}
+			}
+
+			fmt.Printf("\nApplied %d changes\n", len(steps))
+			return nil
+		},
+	}
+)
+
+// planStep is one change apply makes. The description starts with +, ~, or - for a create,
+// update, or delete.
+type planStep struct {
+	desc string
+	run  func() error
+}
+
+// planManifest works out the changes that make the server match the manifest. All unknown
+// names and duplicates are reported together, and nothing is planned if there are any.
+func planManifest(m *manifest, s *serverState) ([]planStep, error) {
+	var (
+		steps []planStep
+		errs  []error
+	)
+
+	if m.Config != nil {
+		if st := planConfig(m.Config, s.config); st != nil {
+			steps = append(steps, *st)
+		}
+	}
+
+	if m.Rules != nil {
+		st, err := planRules(m.Rules, s)
+		steps = append(steps, st...)
+		errs = append(errs, err)
+	}
+
+	if m.Forwards != nil {
+		st, err := planForwards(m.Forwards, s)
+		steps = append(steps, st...)
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return steps, nil
+}
+
+func planConfig(mc *manifestConfig, current *models.Config) *planStep {
+	want := *current
+	var changes []string
+	setBool := func(name string, dst *bool, src *bool) {
+		if src != nil && *src != *dst {
+			changes = append(changes, fmt.Sprintf("%s %v -> %v", name, *dst, *src))
+			*dst = *src
+		}
+	}
+	setInt := func(name string, dst *int, src *int) {
+		if src != nil && *src != *dst {
+			changes = append(changes, fmt.Sprintf("%s %d -> %d", name, *dst, *src))
+			*dst = *src
+		}
+	}
+
+	setBool("attempt_notify", &want.AttemptNotify, mc.AttemptNotify)
+	setInt("max_message_length", &want.MaxMessageLength, mc.MaxMessageLength)
+	setInt("max_concurrent_syncs", &want.MaxConcurrentSyncs, mc.MaxConcurrentSyncs)
+	setBool("skip_launch_syncs", &want.SkipLaunchSyncs, mc.SkipLaunchSyncs)
+	setInt("reconcile_interval_minutes", &want.ReconcileIntervalMinutes, mc.ReconcileIntervalMinutes)
+	setInt("reconcile_lookback_minutes", &want.ReconcileLookbackMinutes, mc.ReconcileLookbackMinutes)
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return &planStep{
+		desc: "~ config: " + strings.Join(changes, ", "),
+		run: func() error {
+			_, err := client.UpdateConfig(&want)
+			return err
+		},
+	}
+}
+
+func planRules(rules []manifestRule, s *serverState) ([]planStep, error) {
+	type ruleKey struct{ board, recip int }
+
+	existing := make(map[ruleKey]models.NotifierRuleFull, len(s.rules))
+	for _, r := range s.rules {
+		existing[ruleKey{r.BoardID, r.RecipientID}] = r
+	}
+
+	var (
+		steps []planStep
+		errs  []error
+		seen  = make(map[ruleKey]bool)
+	)
+
+	for i, mr := range rules {
+		boardID, err := s.boardID(mr.Board)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", i+1, err))
+			continue
+		}
+
+		recipID, err := s.recipID(mr.Recipient)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", i+1, err))
+			continue
+		}
+
+		k := ruleKey{boardID, recipID}
+		if seen[k] {
+			errs = append(errs, fmt.Errorf("rule %d: %s -> %s is in the file more than once", i+1, mr.Board, mr.Recipient))
+			continue
+		}
+		seen[k] = true
+
+		name := fmt.Sprintf("rule %s -> %s", mr.Board, mr.Recipient)
+		e, ok := existing[k]
+		switch {
+		case !ok:
+			p := &models.NotifierRule{CwBoardID: boardID, WebexRecipientID: recipID, NotifyEnabled: mr.Enabled}
+			steps = append(steps, planStep{
+				desc: "+ " + name,
+				run: func() error {
+					_, err := client.CreateNotifierRule(p)
+					return err
+				},
+			})
+		case e.Enabled != mr.Enabled:
+			steps = append(steps, planStep{
+				desc: fmt.Sprintf("~ %s: enabled %v -> %v", name, e.Enabled, mr.Enabled),
+				run: func() error {
+					_, err := client.PatchNotifierRule(e.ID, map[string]any{"enabled": mr.Enabled})
+					return err
+				},
+			})
+		}
+	}
+
+	for _, r := range s.rules {
+		if seen[ruleKey{r.BoardID, r.RecipientID}] {
+			continue
+		}
+
+		steps = append(steps, planStep{
+			desc: fmt.Sprintf("- rule %s -> %s", r.BoardName, r.RecipientName),
+			run:  func() error { return client.DeleteNotifierRule(r.ID) },
+		})
+	}
+
+	return steps, errors.Join(errs...)
+}
+
+// planForwards matches forwards by source and destination. If the server has more than one
+// forward between the same pair, the first is kept and the rest are deleted.
+func planForwards(fwds []manifestForward, s *serverState) ([]planStep, error) {
+	type fwdKey struct{ src, dst int }
+
+	sortManifestForwards(s.forwards)
+	existing := make(map[fwdKey]models.NotifierForwardFull, len(s.forwards))
+	for _, f := range s.forwards {
+		k := fwdKey{f.SourceID, f.DestinationID}
+		if _, ok := existing[k]; !ok {
+			existing[k] = f
+		}
+	}
+
+	var (
+		steps []planStep
+		errs  []error
+		kept  = make(map[int]bool)
+		seen  = make(map[fwdKey]bool)
+	)
+
+	for i, mf := range fwds {
+		srcID, err := s.recipID(mf.From)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("forward %d: %w", i+1, err))
+			continue
+		}
+
+		dstID, err := s.recipID(mf.To)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("forward %d: %w", i+1, err))
+			continue
+		}
+
+		start, err := parseManifestDate(mf.StartDate)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("forward %d: start: %w", i+1, err))
+			continue
+		}
+
+		end, err := parseManifestDate(mf.EndDate)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("forward %d: end: %w", i+1, err))
+			continue
+		}
+
+		k := fwdKey{srcID, dstID}
+		if seen[k] {
+			errs = append(errs, fmt.Errorf("forward %d: %s -> %s is in the file more than once", i+1, mf.From, mf.To))
+			continue
+		}
+		seen[k] = true
+
+		p := &models.NotifierForward{
+			SourceID:      srcID,
+			DestID:        dstID,
+			StartDate:     start,
+			EndDate:       end,
+			Enabled:       mf.Enabled,
+			UserKeepsCopy: mf.UserKeepsCopy,
+		}
+
+		name := fmt.Sprintf("forward %s -> %s", mf.From, mf.To)
+		e, ok := existing[k]
+		if !ok {
+			steps = append(steps, planStep{
+				desc: "+ " + name,
+				run: func() error {
+					_, err := client.CreateUserForward(p)
+					return err
+				},
+			})
+			continue
+		}
+		kept[e.ID] = true
+
+		changes := forwardChanges(e, mf)
+		if len(changes) == 0 {
+			continue
+		}
+
+		p.ID = e.ID
+		steps = append(steps, planStep{
+			desc: fmt.Sprintf("~ %s: %s", name, strings.Join(changes, ", ")),
+			run: func() error {
+				_, err := client.UpdateUserForward(p)
+				return err
+			},
+		})
+	}
+
+	for _, f := range s.forwards {
+		if kept[f.ID] {
+			continue
+		}
+
+		steps = append(steps, planStep{
+			desc: fmt.Sprintf("- forward %s -> %s", f.SourceName, f.DestinationName),
+			run:  func() error { return client.DeleteUserForward(f.ID) },
+		})
+	}
+
+	return steps, errors.Join(errs...)
+}
+
+func forwardChanges(e models.NotifierForwardFull, mf manifestForward) []string {
+	var changes []string
+	if sd := formatManifestDate(e.StartDate); sd != mf.StartDate {
+		changes = append(changes, fmt.Sprintf("start_date %s -> %s", dateOrNone(sd), dateOrNone(mf.StartDate)))
+	}
+
+	if ed := formatManifestDate(e.EndDate); ed != mf.EndDate {
+		changes = append(changes, fmt.Sprintf("end_date %s -> %s", dateOrNone(ed), dateOrNone(mf.EndDate)))
+	}
+
+	if e.Enabled != mf.Enabled {
+		changes = append(changes, fmt.Sprintf("enabled %v -> %v", e.Enabled, mf.Enabled))
+	}
+
+	if e.UserKeepsCopy != mf.UserKeepsCopy {
+		changes = append(changes, fmt.Sprintf("user_keeps_copy %v -> %v", e.UserKeepsCopy, mf.UserKeepsCopy))
+	}
+
+	return changes
+}
+
+func dateOrNone(d string) string {
+	if d == "" {
+		return "none"
+	}
+
+	return d
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportFile, "output", "o", "", "file to write the yaml to (default stdout)")
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "yaml file to apply, in the format written by export")
+	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "show the planned changes without making them")
+}
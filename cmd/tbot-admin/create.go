@@ -3,6 +3,8 @@ package main
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -106,6 +108,48 @@ var (
 		},
 	}
 
+	createSyncScheduleCmd = &cobra.Command{
+		Use:     "sync-schedule",
+		Aliases: []string{"schedule"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if scheduleName == "" {
+				return errors.New("schedule name is required")
+			}
+
+			if scheduleCron == "" {
+				return errors.New("cron expression is required, such as \"0 2 * * *\" or @hourly")
+			}
+
+			if !syncBoards && !syncWebexRecipients && !syncTickets && !syncCompanies {
+				return errors.New("at least one sync target must be set")
+			}
+
+			p := &models.SyncSchedule{
+				Name: scheduleName,
+				Cron: scheduleCron,
+				Payload: models.SyncPayload{
+					WebexRecipients:    syncWebexRecipients,
+					CWBoards:           syncBoards,
+					CWTickets:          syncTickets,
+					CWCompanies:        syncCompanies,
+					BoardIDs:           syncBoardIDs,
+					MaxConcurrentSyncs: maxConcurrentSyncs,
+				},
+				Enabled: !scheduleDisabled,
+			}
+
+			s, err := client.CreateSyncSchedule(p)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("ID: %d\nName: %s\nCron: %s\nEnabled: %v\nNext Run: %s\n",
+				s.ID, s.Name, s.Cron, s.Enabled, formatNextRun(s))
+
+			return nil
+		},
+	}
+
 	createUserCmd = &cobra.Command{
 		Use: "user",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -113,12 +157,12 @@ var (
 				return errors.New("no email address provided - pass with flag --email or -e")
 			}
 
-			u, err := client.CreateUser(emailAddress)
+			u, err := client.CreateUser(emailAddress, models.Role(userRole))
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("User created:\nID:%d\nEmail:%s\n", u.ID, u.EmailAddress)
+			fmt.Printf("User created:\nID:%d\nEmail:%s\nRole:%s\n", u.ID, u.EmailAddress, u.Role)
 			return nil
 		},
 	}
@@ -131,19 +175,36 @@ var (
 				return errors.New("no email address provided - pass with flag --email or -e")
 			}
 
-			k, err := client.CreateAPIKey(emailAddress)
+			p := &models.CreateAPIKeyPayload{
+				Email:  emailAddress,
+				Scopes: keyScopes,
+			}
+
+			if keyName != "" {
+				p.Name = &keyName
+			}
+
+			if keyExpires != "" {
+				exp, err := parseExpiry(keyExpires)
+				if err != nil {
+					return err
+				}
+				p.ExpiresOn = &exp
+			}
+
+			k, err := client.CreateAPIKey(p)
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("API key created for %s\nCopy and save this key, it won't be shown again:\n%s\n", emailAddress, k)
+			fmt.Printf("API key %d created for %s\nCopy and save this key, it won't be shown again:\n%s\n", k.ID, emailAddress, k.Key)
 			return nil
 		},
 	}
 )
 
 func init() {
-	createCmd.AddCommand(createNotifierRuleCmd, createForwardCmd, createUserCmd, createAPIKeyCmd)
+	createCmd.AddCommand(createNotifierRuleCmd, createForwardCmd, createUserCmd, createAPIKeyCmd, createSyncScheduleCmd)
 	createNotifierRuleCmd.Flags().IntVarP(&boardID, "board-id", "b", 0, "board id to use")
 	createNotifierRuleCmd.Flags().IntVarP(&recipientID, "recipient-id", "r", 0, "recipient id to use")
 	createForwardCmd.Flags().BoolVarP(&forwardUserKeeps, "user-keeps-copy", "k", false, "user keeps a copy of forwarded emails")
@@ -152,6 +213,37 @@ func init() {
 	createForwardCmd.Flags().StringVarP(&forwardStartDate, "start-date", "a", "", "start date for forward (YYYY-MM-DD)")
 	createForwardCmd.Flags().StringVarP(&forwardEndDate, "end-date", "e", "", "end date for forward (YYYY-MM-DD)")
 	createForwardCmd.Flags().BoolVarP(&forwardEnabled, "enabled", "x", true, "enable the forward")
+	createSyncScheduleCmd.Flags().StringVarP(&scheduleName, "name", "n", "", "name of the schedule")
+	createSyncScheduleCmd.Flags().StringVarP(&scheduleCron, "cron", "c", "", "cron expression in server local time, such as \"0 */6 * * *\" or @daily")
+	createSyncScheduleCmd.Flags().BoolVarP(&syncBoards, "boards", "b", false, "sync connectwise boards")
+	createSyncScheduleCmd.Flags().BoolVarP(&syncWebexRecipients, "recipients", "r", false, "sync webex rooms")
+	createSyncScheduleCmd.Flags().BoolVarP(&syncTickets, "tickets", "t", false, "sync open connectwise tickets")
+	createSyncScheduleCmd.Flags().BoolVar(&syncCompanies, "companies", false, "sync connectwise companies and contacts")
+	createSyncScheduleCmd.Flags().IntSliceVarP(&syncBoardIDs, "sync-boards", "i", nil, "board ids to sync tickets for (all if not set)")
+	createSyncScheduleCmd.Flags().IntVar(&maxConcurrentSyncs, "max-syncs", 5, "max amount of concurrent syncs to run")
+	createSyncScheduleCmd.Flags().BoolVar(&scheduleDisabled, "disabled", false, "create the schedule disabled")
 	createUserCmd.Flags().StringVarP(&emailAddress, "email", "e", "", "email address to create a user for")
+	createUserCmd.Flags().StringVarP(&userRole, "role", "r", string(models.RoleReadOnly), "role of the user: admin, operator, self_service, or read_only")
 	createAPIKeyCmd.Flags().StringVarP(&emailAddress, "email", "e", "", "email address to create an api key for")
+	createAPIKeyCmd.Flags().StringVarP(&keyName, "name", "n", "", "name or description of the key")
+	createAPIKeyCmd.Flags().StringSliceVarP(&keyScopes, "scope", "s", nil, fmt.Sprintf("scope to limit the key to; repeat for more (default all) %v", models.APIKeyScopes))
+	createAPIKeyCmd.Flags().StringVarP(&keyExpires, "expires", "x", "", "when the key expires, as a date (YYYY-MM-DD) or a number of days such as 90d")
+}
+
+// parseExpiry parses a key expiry given as a date or a number of days from now.
+func parseExpiry(s string) (time.Time, error) {
+	if d, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(d)
+		if err != nil || n <= 0 {
+			return time.Time{}, fmt.Errorf("invalid number of days %q", s)
+		}
+		return time.Now().AddDate(0, 0, n), nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing expiry date: %w", err)
+	}
+
+	return t, nil
 }
@@ -39,6 +39,23 @@ var (
 		},
 	}
 
+	deleteSyncScheduleCmd = &cobra.Command{
+		Use:     "sync-schedule",
+		Aliases: []string{"schedule"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if id == 0 {
+				return errors.New("schedule id is required")
+			}
+
+			if err := client.DeleteSyncSchedule(id); err != nil {
+				return err
+			}
+
+			fmt.Printf("Sync schedule %d successfully deleted\n", id)
+			return nil
+		},
+	}
+
 	deleteUserCmd = &cobra.Command{
 		Use: "user",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -74,7 +91,8 @@ var (
 )
 
 func init() {
-	deleteCmd.AddCommand(deleteNotifierRuleCmd, deleteForwardCmd, deleteUserCmd, deleteAPIKeyCmd)
+	deleteCmd.AddCommand(deleteNotifierRuleCmd, deleteForwardCmd, deleteUserCmd, deleteAPIKeyCmd, deleteSyncScheduleCmd)
+	deleteSyncScheduleCmd.Flags().IntVar(&id, "id", 0, "id of the schedule to delete")
 	deleteForwardCmd.Flags().IntVar(&id, "id", 0, "id of the forward to delete")
 	deleteNotifierRuleCmd.Flags().IntVar(&id, "id", 0, "id of the notifier to delete")
 	deleteAPIKeyCmd.Flags().IntVar(&id, "id", 0, "id of the key to delete")
@@ -7,9 +7,12 @@ var (
 	cfgAttemptNotify bool
 	cfgMaxMsgLen     int
 	cfgMaxSyncs      int
+	cfgReconInterval int
+	cfgReconLookback int
 
 	boardID     int
 	recipientID int
+	ruleEnabled bool
 
 	forwardSrcID     int
 	forwardDestID    int
@@ -19,8 +22,44 @@ var (
 	forwardUserKeeps bool
 
 	emailAddress string
+	userRole     string
+	searchName   string
+
+	keyName       string
+	keyScopes     []string
+	keyExpires    string
+	keyGraceHours int
+
+	loginURL       string
+	loginNoBrowser bool
 
 	syncAll, syncBoards, syncWebexRecipients, syncTickets bool
+	syncCompanies                                         bool
 	syncBoardIDs                                          []int
 	maxConcurrentSyncs                                    int
+	syncWait                                              bool
+	syncRunsLimit                                         int
+
+	ticketStatusID     int
+	ticketOwnerID      int
+	ticketCompanyID    int
+	ticketUpdatedSince string
+	ticketState        string
+	searchLimit        int
+
+	auditUserID   int
+	auditAction   string
+	auditEntity   string
+	auditEntityID int
+	auditSince    string
+	auditUntil    string
+	auditLimit    int
+
+	exportFile  string
+	applyFile   string
+	applyDryRun bool
+
+	scheduleName     string
+	scheduleCron     string
+	scheduleDisabled bool
 )
@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/thecoretg/ticketbot/internal/models"
@@ -59,17 +61,96 @@ var (
 			return nil
 		},
 	}
+
+	getTicketCmd = &cobra.Command{
+		Use: "ticket",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := client.GetTicket(id)
+			if err != nil {
+				return err
+			}
+
+			printFullTicket(t)
+			return nil
+		},
+	}
+
+	getSyncRunCmd = &cobra.Command{
+		Use:     "sync-run",
+		Aliases: []string{"sync"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			run, err := client.GetSyncRun(id)
+			if err != nil {
+				return err
+			}
+
+			printSyncRun(run)
+			return nil
+		},
+	}
 )
 
 func init() {
-	getCmd.AddCommand(getCfgCmd, getForwardCmd)
+	getCmd.AddCommand(getCfgCmd, getForwardCmd, getSyncRunCmd, getTicketCmd)
 	getNotifierRuleCmd.Flags().IntVar(&id, "id", 0, "id of notifier rule")
 	getForwardCmd.Flags().IntVar(&id, "id", 0, "id of forward")
+	getSyncRunCmd.Flags().IntVar(&id, "id", 0, "id of sync run")
+	getTicketCmd.Flags().IntVar(&id, "id", 0, "id of ticket")
 }
 
 func printCfg(cfg *models.Config) {
 	fmt.Printf("Attempt Notify: %v\n"+
 		"Max Msg Length: %d\n"+
-		"Max Concurrent Syncs: %d\n",
-		cfg.AttemptNotify, cfg.MaxMessageLength, cfg.MaxConcurrentSyncs)
+		"Max Concurrent Syncs: %d\n"+
+		"Reconcile Interval Minutes: %d\n"+
+		"Reconcile Lookback Minutes: %d\n",
+		cfg.AttemptNotify, cfg.MaxMessageLength, cfg.MaxConcurrentSyncs,
+		cfg.ReconcileIntervalMinutes, cfg.ReconcileLookbackMinutes)
+}
+
+func printFullTicket(t *models.FullTicket) {
+	owner := "NA"
+	if t.Owner != nil {
+		owner = t.Owner.Identifier
+	}
+
+	contact := "NA"
+	if t.Contact != nil {
+		contact = t.Contact.FirstName
+		if t.Contact.LastName != nil && *t.Contact.LastName != "" {
+			contact = fmt.Sprintf("%s %s", contact, *t.Contact.LastName)
+		}
+	}
+
+	var rsc []string
+	for _, r := range t.Resources {
+		rsc = append(rsc, r.Identifier)
+	}
+
+	fmt.Printf("ID: %d\nSummary: %s\nBoard: %s\nStatus: %s\nCompany: %s\nContact: %s\n"+
+		"Owner: %s\nResources: %s\nUpdated: %s\n",
+		t.Ticket.ID, t.Ticket.Summary, t.Board.Name, t.Status.Name, t.Company.Name, contact,
+		owner, strings.Join(rsc, ", "), t.Ticket.UpdatedOn.Format(time.DateTime))
+
+	if len(t.Notes) == 0 {
+		return
+	}
+
+	fmt.Println("Notes:")
+	for _, n := range t.Notes {
+		sender := "NA"
+		switch {
+		case n.Member != nil:
+			sender = n.Member.Identifier
+		case n.Contact != nil:
+			sender = n.Contact.FirstName
+		}
+
+		content := ""
+		if n.Content != nil {
+			content = *n.Content
+		}
+
+		fmt.Printf("  [%s] %s: %s\n", n.AddedOn.Format(time.DateTime), sender, content)
+	}
 }
@@ -2,9 +2,11 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/sdk"
 )
 
 var (
@@ -31,6 +33,76 @@ var (
 		},
 	}
 
+	listCompaniesCmd = &cobra.Command{
+		Use: "companies",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			companies, err := client.ListCompanies(searchName)
+			if err != nil {
+				return err
+			}
+
+			if len(companies) == 0 {
+				fmt.Println("No companies found")
+				return nil
+			}
+
+			cwCompaniesTable(companies)
+			return nil
+		},
+	}
+
+	listContactsCmd = &cobra.Command{
+		Use: "contacts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contacts, err := client.ListContacts(searchName)
+			if err != nil {
+				return err
+			}
+
+			if len(contacts) == 0 {
+				fmt.Println("No contacts found")
+				return nil
+			}
+
+			cwContactsTable(contacts)
+			return nil
+		},
+	}
+
+	listTicketsCmd = &cobra.Command{
+		Use: "tickets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := &sdk.TicketQuery{
+				BoardID:   boardID,
+				StatusID:  ticketStatusID,
+				OwnerID:   ticketOwnerID,
+				CompanyID: ticketCompanyID,
+				State:     ticketState,
+			}
+
+			if ticketUpdatedSince != "" {
+				t, err := time.ParseInLocation("2006-01-02", ticketUpdatedSince, time.Local)
+				if err != nil {
+					return fmt.Errorf("parsing updated since date: %w", err)
+				}
+				q.UpdatedSince = t
+			}
+
+			tickets, err := client.ListTickets(q)
+			if err != nil {
+				return err
+			}
+
+			if len(tickets) == 0 {
+				fmt.Println("No tickets found")
+				return nil
+			}
+
+			cwTicketsTable(tickets)
+			return nil
+		},
+	}
+
 	listNotifierRulesCmd = &cobra.Command{
 		Use:     "notifier-rules",
 		Aliases: []string{"rules"},
@@ -107,6 +179,92 @@ var (
 		},
 	}
 
+	listSyncRunsCmd = &cobra.Command{
+		Use:     "sync-runs",
+		Aliases: []string{"syncs"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runs, err := client.ListSyncRuns(syncRunsLimit)
+			if err != nil {
+				return err
+			}
+
+			if len(runs) == 0 {
+				fmt.Println("No sync runs found")
+				return nil
+			}
+
+			syncRunsTable(runs)
+			return nil
+		},
+	}
+
+	listSyncSchedulesCmd = &cobra.Command{
+		Use:     "sync-schedules",
+		Aliases: []string{"schedules"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := client.ListSyncSchedules()
+			if err != nil {
+				return err
+			}
+
+			if len(s) == 0 {
+				fmt.Println("No sync schedules found")
+				return nil
+			}
+
+			syncSchedulesTable(s)
+			return nil
+		},
+	}
+
+	listAuditEventsCmd = &cobra.Command{
+		Use:     "audit-events",
+		Aliases: []string{"audit"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := &sdk.AuditQuery{
+				UserID:     auditUserID,
+				Action:     models.AuditAction(auditAction),
+				EntityType: models.AuditEntity(auditEntity),
+				EntityID:   auditEntityID,
+				Limit:      auditLimit,
+			}
+
+			dates := map[*time.Time]string{&q.Since: auditSince, &q.Until: auditUntil}
+			for dst, v := range dates {
+				if v == "" {
+					continue
+				}
+
+				t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+				if err != nil {
+					return fmt.Errorf("parsing date %s: %w", v, err)
+				}
+				*dst = t
+			}
+
+			events, err := client.ListAuditEvents(q)
+			if err != nil {
+				return err
+			}
+
+			if len(events) == 0 {
+				fmt.Println("No audit events found")
+				return nil
+			}
+
+			// events only have user ids; show emails if we're allowed to see them
+			emails := make(map[int]string)
+			if users, err := client.ListUsers(); err == nil {
+				for _, u := range users {
+					emails[u.ID] = u.EmailAddress
+				}
+			}
+
+			auditEventsTable(events, emails)
+			return nil
+		},
+	}
+
 	listAPIKeysCmd = &cobra.Command{
 		Use:     "api-keys",
 		Aliases: []string{"keys"},
@@ -129,7 +287,24 @@ var (
 
 func init() {
 	listCmd.AddCommand(listBoardsCmd, listNotifierRulesCmd, listForwardsCmd,
-		listWebexRecipientsCmd, listUsersCmd, listAPIKeysCmd)
+		listWebexRecipientsCmd, listUsersCmd, listAPIKeysCmd, listSyncRunsCmd, listSyncSchedulesCmd,
+		listCompaniesCmd, listContactsCmd, listTicketsCmd, listAuditEventsCmd)
+	listCompaniesCmd.Flags().StringVarP(&searchName, "name", "n", "", "only show active companies with a name containing this")
+	listContactsCmd.Flags().StringVarP(&searchName, "name", "n", "", "only show active contacts with a name containing this")
+	listTicketsCmd.Flags().IntVarP(&boardID, "board-id", "b", 0, "only show tickets on this board")
+	listTicketsCmd.Flags().IntVarP(&ticketStatusID, "status-id", "s", 0, "only show tickets with this status")
+	listTicketsCmd.Flags().IntVarP(&ticketOwnerID, "owner-id", "o", 0, "only show tickets owned by this member")
+	listTicketsCmd.Flags().IntVarP(&ticketCompanyID, "company-id", "c", 0, "only show tickets for this company")
+	listTicketsCmd.Flags().StringVarP(&ticketUpdatedSince, "updated-since", "u", "", "only show tickets updated on or after this date (YYYY-MM-DD)")
+	listTicketsCmd.Flags().StringVar(&ticketState, "state", "", "only show open or closed tickets (open, closed)")
+	listAuditEventsCmd.Flags().IntVarP(&auditUserID, "user-id", "u", 0, "only show changes made by this user")
+	listAuditEventsCmd.Flags().StringVarP(&auditAction, "action", "a", "", "only show this action (create, update, delete, rotate, sync)")
+	listAuditEventsCmd.Flags().StringVarP(&auditEntity, "entity", "e", "", "only show changes to this kind of thing (e.g. notifier_rule, config)")
+	listAuditEventsCmd.Flags().IntVarP(&auditEntityID, "entity-id", "i", 0, "only show changes to the thing with this id")
+	listAuditEventsCmd.Flags().StringVarP(&auditSince, "since", "s", "", "only show changes on or after this date (YYYY-MM-DD)")
+	listAuditEventsCmd.Flags().StringVar(&auditUntil, "until", "", "only show changes before this date (YYYY-MM-DD)")
+	listAuditEventsCmd.Flags().IntVarP(&auditLimit, "limit", "l", 0, "max amount of events to show (server default if not set)")
+	listSyncRunsCmd.Flags().IntVarP(&syncRunsLimit, "limit", "l", 0, "max amount of runs to show (server default if not set)")
 }
 
 func filterEmptyTitleRooms(rooms []models.WebexRecipient) []models.WebexRecipient {
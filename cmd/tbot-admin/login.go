@@ -0,0 +1,117 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/sdk"
+)
+
+var (
+	loginCmd = &cobra.Command{
+		Use: "login",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = godotenv.Load()
+
+			base := cmp.Or(loginURL, os.Getenv("TBOT_BASE_URL"))
+			if base == "" {
+				return errors.New("no server url; set TBOT_BASE_URL or use --url")
+			}
+
+			c, err := sdk.NewClient("", base)
+			if err != nil {
+				return fmt.Errorf("creating api client: %w", err)
+			}
+
+			d, err := c.StartLogin()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Open this page to sign in, and check that it shows the code %s:\n%s\n\n", d.UserCode, d.VerificationURIComplete)
+			if !loginNoBrowser {
+				openBrowser(d.VerificationURIComplete)
+			}
+			fmt.Println("Waiting for you to sign in...")
+
+			t, err := c.WaitForLogin(cmd.Context(), d)
+			if err != nil {
+				switch {
+				case errors.Is(err, models.ErrLoginExpired):
+					return errors.New("login timed out; run login again")
+				case errors.Is(err, models.ErrLoginDenied):
+					return errors.New("login was denied; make sure an admin has added your email as a user")
+				}
+				return err
+			}
+
+			s := &sdk.Session{BaseURL: base, SessionTokens: *t}
+			if err := s.Save(); err != nil {
+				return err
+			}
+
+			p, _ := sdk.SessionPath()
+			fmt.Printf("Logged in as %s; session saved to %s\n", t.Email, p)
+			return nil
+		},
+	}
+
+	logoutCmd = &cobra.Command{
+		Use: "logout",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := sdk.LoadSession()
+			if err != nil {
+				if errors.Is(err, sdk.ErrNoSession) {
+					fmt.Println("Not logged in")
+					return nil
+				}
+				return err
+			}
+
+			c, err := sdk.NewClient("", s.BaseURL)
+			if err != nil {
+				return fmt.Errorf("creating api client: %w", err)
+			}
+
+			if err := c.Logout(s.RefreshToken); err != nil {
+				// the local session is removed anyway so a dead server can't keep the user logged in
+				fmt.Fprintf(os.Stderr, "couldn't end session on server: %v\n", err)
+			}
+
+			if err := sdk.DeleteSession(); err != nil {
+				return err
+			}
+
+			fmt.Println("Logged out")
+			return nil
+		},
+	}
+)
+
+func init() {
+	loginCmd.Flags().StringVarP(&loginURL, "url", "u", "", "server url (default from TBOT_BASE_URL)")
+	loginCmd.Flags().BoolVar(&loginNoBrowser, "no-browser", false, "print the sign in url without opening a browser")
+}
+
+// openBrowser tries to open the url in the default browser. Failures are ignored since the url
+// is printed too.
+func openBrowser(url string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	_ = cmd.Start()
+}
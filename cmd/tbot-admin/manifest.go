@@ -0,0 +1,259 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+const manifestDateLayout = "2006-01-02"
+
+// manifest is the YAML form of the notifier setup, used by export and apply. Boards and recipients
+// are referenced by name so the file reads well and survives a fresh database. A section left out
+// of the file is not touched by apply, but an empty list deletes everything of that kind.
+type manifest struct {
+	Config   *manifestConfig   `yaml:"config,omitempty"`
+	Rules    []manifestRule    `yaml:"rules"`
+	Forwards []manifestForward `yaml:"forwards"`
+}
+
+// manifestConfig fields are pointers so a hand written file can set only some of them.
+type manifestConfig struct {
+	AttemptNotify            *bool `yaml:"attempt_notify,omitempty"`
+	MaxMessageLength         *int  `yaml:"max_message_length,omitempty"`
+	MaxConcurrentSyncs       *int  `yaml:"max_concurrent_syncs,omitempty"`
+	SkipLaunchSyncs          *bool `yaml:"skip_launch_syncs,omitempty"`
+	ReconcileIntervalMinutes *int  `yaml:"reconcile_interval_minutes,omitempty"`
+	ReconcileLookbackMinutes *int  `yaml:"reconcile_lookback_minutes,omitempty"`
+}
+
+type manifestRule struct {
+	Board     string `yaml:"board"`
+	Recipient string `yaml:"recipient"`
+	Enabled   bool   `yaml:"enabled"`
+}
+
+type manifestForward struct {
+	From          string `yaml:"from"`
+	To            string `yaml:"to"`
+	StartDate     string `yaml:"start_date,omitempty"`
+	EndDate       string `yaml:"end_date,omitempty"`
+	Enabled       bool   `yaml:"enabled"`
+	UserKeepsCopy bool   `yaml:"user_keeps_copy"`
+}
+
+// serverState is everything export and apply need from the server.
+type serverState struct {
+	config   *models.Config
+	boards   []models.Board
+	recips   []models.WebexRecipient
+	rules    []models.NotifierRuleFull
+	forwards []models.NotifierForwardFull
+}
+
+func getServerState() (*serverState, error) {
+	var (
+		s   = &serverState{}
+		err error
+	)
+
+	if s.config, err = client.GetConfig(); err != nil {
+		return nil, fmt.Errorf("getting config: %w", err)
+	}
+
+	if s.boards, err = client.ListBoards(); err != nil {
+		return nil, fmt.Errorf("listing boards: %w", err)
+	}
+
+	if s.recips, err = client.ListRecipients(); err != nil {
+		return nil, fmt.Errorf("listing recipients: %w", err)
+	}
+
+	if s.rules, err = client.ListNotifierRules(); err != nil {
+		return nil, fmt.Errorf("listing notifier rules: %w", err)
+	}
+
+	if s.forwards, err = client.ListUserForwards(); err != nil {
+		return nil, fmt.Errorf("listing forwards: %w", err)
+	}
+
+	return s, nil
+}
+
+// boardID finds a board by name, preferring ones that aren't deleted. Boards are only referenced
+// by name, so two live boards with the same name are an error rather than a guess.
+func (s *serverState) boardID(name string) (int, error) {
+	var live, deleted []int
+	for _, b := range s.boards {
+		if !strings.EqualFold(b.Name, name) {
+			continue
+		}
+
+		if b.Deleted {
+			deleted = append(deleted, b.ID)
+			continue
+		}
+		live = append(live, b.ID)
+	}
+
+	switch {
+	case len(live) == 1:
+		return live[0], nil
+	case len(live) > 1:
+		return 0, fmt.Errorf("ambiguous board name %q: %d boards have it; rename one in connectwise", name, len(live))
+	case len(deleted) > 0:
+		return deleted[0], nil
+	default:
+		return 0, fmt.Errorf("no board named %q", name)
+	}
+}
+
+// recipID finds a recipient by email address or name. Names can be shared, so an ambiguous
+// name is an error and the recipient has to be given by email instead.
+func (s *serverState) recipID(ref string) (int, error) {
+	var matches []int
+	for _, r := range s.recips {
+		if r.Email != nil && strings.EqualFold(*r.Email, ref) {
+			return r.ID, nil
+		}
+
+		if strings.EqualFold(r.Name, ref) {
+			matches = append(matches, r.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no recipient named %q", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, fmt.Errorf("%d recipients are named %q; use an email address instead", len(matches), ref)
+	}
+}
+
+// recipRef is how a recipient is written in the manifest: its name, or its email if the name
+// isn't unique.
+func (s *serverState) recipRef(id int) string {
+	var (
+		rec   models.WebexRecipient
+		count int
+	)
+
+	for _, r := range s.recips {
+		if r.ID == id {
+			rec = r
+		}
+	}
+
+	for _, r := range s.recips {
+		if strings.EqualFold(r.Name, rec.Name) {
+			count++
+		}
+	}
+
+	if count > 1 && rec.Email != nil {
+		return *rec.Email
+	}
+
+	return rec.Name
+}
+
+func (s *serverState) boardName(id int) string {
+	for _, b := range s.boards {
+		if b.ID == id {
+			return b.Name
+		}
+	}
+
+	return ""
+}
+
+// toManifest builds the manifest that describes the server as it is.
+func (s *serverState) toManifest() *manifest {
+	c := s.config
+	m := &manifest{
+		Config: &manifestConfig{
+			AttemptNotify:            &c.AttemptNotify,
+			MaxMessageLength:         &c.MaxMessageLength,
+			MaxConcurrentSyncs:       &c.MaxConcurrentSyncs,
+			SkipLaunchSyncs:          &c.SkipLaunchSyncs,
+			ReconcileIntervalMinutes: &c.ReconcileIntervalMinutes,
+			ReconcileLookbackMinutes: &c.ReconcileLookbackMinutes,
+		},
+		Rules:    []manifestRule{},
+		Forwards: []manifestForward{},
+	}
+
+	// sorted so exports of the same setup diff cleanly
+	slices.SortStableFunc(s.rules, func(a, b models.NotifierRuleFull) int {
+		return cmp.Or(cmp.Compare(a.BoardName, b.BoardName), cmp.Compare(a.RecipientName, b.RecipientName))
+	})
+	for _, r := range s.rules {
+		m.Rules = append(m.Rules, manifestRule{
+			Board:     s.boardName(r.BoardID),
+			Recipient: s.recipRef(r.RecipientID),
+			Enabled:   r.Enabled,
+		})
+	}
+
+	// apply keeps only the first forward between a pair, so only that one is written
+	sortManifestForwards(s.forwards)
+	seen := make(map[[2]int]bool)
+	for _, f := range s.forwards {
+		k := [2]int{f.SourceID, f.DestinationID}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		m.Forwards = append(m.Forwards, manifestForward{
+			From:          s.recipRef(f.SourceID),
+			To:            s.recipRef(f.DestinationID),
+			StartDate:     formatManifestDate(f.StartDate),
+			EndDate:       formatManifestDate(f.EndDate),
+			Enabled:       f.Enabled,
+			UserKeepsCopy: f.UserKeepsCopy,
+		})
+	}
+
+	return m
+}
+
+// sortManifestForwards orders forwards by name, and by ID within a pair, so export and apply
+// agree on which duplicate is the first.
+func sortManifestForwards(fwds []models.NotifierForwardFull) {
+	slices.SortStableFunc(fwds, func(a, b models.NotifierForwardFull) int {
+		return cmp.Or(
+			cmp.Compare(a.SourceName, b.SourceName),
+			cmp.Compare(a.DestinationName, b.DestinationName),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+}
+
+// formatManifestDate writes dates in UTC, the same way the create commands parse them.
+func formatManifestDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(manifestDateLayout)
+}
+
+func parseManifestDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(manifestDateLayout, s)
+	if err != nil {
+		return nil, fmt.Errorf("date %q is not in format YYYY-MM-DD", s)
+	}
+
+	return &t, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -23,7 +24,7 @@ var (
 			}
 
 			if err := client.AuthTest(); err != nil {
-				return fmt.Errorf("error authenticating: %w", err)
+				return fmt.Errorf("error authenticating; run login or set TBOT_API_KEY: %w", err)
 			}
 
 			m := tui.NewModel(client, currentAPIKey)
@@ -51,11 +52,12 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.AddCommand(versionCmd, pingCmd, authCheckCmd, syncCmd, listCmd, getCmd, createCmd, updateCmd, deleteCmd)
+	rootCmd.AddCommand(versionCmd, loginCmd, logoutCmd, pingCmd, authCheckCmd, syncCmd, searchCmd, listCmd, getCmd, createCmd, updateCmd, deleteCmd, rotateCmd, exportCmd, applyCmd)
 }
 
 var currentAPIKey string
 
+// createClient uses TBOT_API_KEY if it is set, and otherwise the session saved by login.
 func createClient(cmd *cobra.Command, args []string) error {
 	_ = godotenv.Load()
 
@@ -63,6 +65,21 @@ func createClient(cmd *cobra.Command, args []string) error {
 	currentAPIKey = os.Getenv("TBOT_API_KEY")
 	base := os.Getenv("TBOT_BASE_URL")
 
+	if currentAPIKey == "" {
+		s, err := sdk.LoadSession()
+		if err != nil && !errors.Is(err, sdk.ErrNoSession) {
+			return err
+		}
+
+		if s != nil && (base == "" || base == s.BaseURL) {
+			client, err = sdk.NewSessionClient(s)
+			if err != nil {
+				return fmt.Errorf("creating api client: %w", err)
+			}
+			return nil
+		}
+	}
+
 	client, err = sdk.NewClient(currentAPIKey, base)
 	if err != nil {
 		return fmt.Errorf("creating api client: %w", err)
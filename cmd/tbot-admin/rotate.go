@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	rotateCmd = &cobra.Command{
+		Use:               "rotate",
+		PersistentPreRunE: createClient,
+	}
+
+	rotateAPIKeyCmd = &cobra.Command{
+		Use:     "api-key",
+		Aliases: []string{"key"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if id == 0 {
+				return errors.New("key id is required")
+			}
+
+			r, err := client.RotateAPIKey(id, keyGraceHours)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("API key %d rotated for %s; it stops working at %s\nNew key ID: %d\nCopy and save the new key, it won't be shown again:\n%s\n",
+				r.OldKeyID, r.Email, r.OldKeyExpiresOn.Local().Format("2006-01-02 15:04"), r.ID, r.Key)
+			return nil
+		},
+	}
+)
+
+func init() {
+	rotateCmd.AddCommand(rotateAPIKeyCmd)
+	rotateAPIKeyCmd.Flags().IntVarP(&id, "id", "i", 0, "id of the key to rotate")
+	rotateAPIKeyCmd.Flags().IntVarP(&keyGraceHours, "grace", "g", 0, "hours the old key keeps working (default 24)")
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var searchCmd = &cobra.Command{
+	Use:               "search [terms]",
+	Args:              cobra.MinimumNArgs(1),
+	PersistentPreRunE: createClient,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		results, err := client.SearchTickets(strings.Join(args, " "), searchLimit)
+		if err != nil {
+			return err
+		}
+
+		if len(results) == 0 {
+			fmt.Println("No tickets found")
+			return nil
+		}
+
+		ticketSearchTable(results)
+		return nil
+	},
+}
+
+func init() {
+	searchCmd.Flags().IntVarP(&searchLimit, "limit", "l", 0, "max amount of results to show (server default if not set)")
+}
@@ -3,11 +3,16 @@ package main
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/thecoretg/ticketbot/internal/models"
 )
 
+const syncPollInterval = time.Second
+
 var syncCmd = &cobra.Command{
 	Use:               "sync",
 	PersistentPreRunE: createClient,
@@ -16,9 +21,10 @@ var syncCmd = &cobra.Command{
 			syncBoards = true
 			syncWebexRecipients = true
 			syncTickets = true
+			syncCompanies = true
 		}
 
-		if !syncBoards && !syncWebexRecipients && !syncTickets {
+		if !syncBoards && !syncWebexRecipients && !syncTickets && !syncCompanies {
 			return errors.New("at least one sync target must be set")
 		}
 
@@ -30,23 +36,108 @@ var syncCmd = &cobra.Command{
 			WebexRecipients:    syncWebexRecipients,
 			CWBoards:           syncBoards,
 			CWTickets:          syncTickets,
+			CWCompanies:        syncCompanies,
 			BoardIDs:           syncBoardIDs,
 			MaxConcurrentSyncs: maxConcurrentSyncs,
 		}
-		if err := client.Sync(p); err != nil {
+
+		run, err := client.Sync(p)
+		if err != nil {
 			return err
 		}
 
-		fmt.Println("Sync started. You will not get confirmation, but this is usually done in less than a second.")
-		return nil
+		if !syncWait {
+			fmt.Printf("Sync started with run ID %d. Check on it with: tbot-admin get sync-run --id %d\n", run.ID, run.ID)
+			return nil
+		}
+
+		fmt.Printf("Sync started with run ID %d, waiting for it to finish...\n", run.ID)
+		return waitForSync(run.ID)
 	},
 }
 
 func init() {
-	syncCmd.Flags().BoolVar(&syncAll, "all", false, "sync boards, recipients, and tickets (will sync all boards for tickets unless specified)")
+	syncCmd.Flags().BoolVar(&syncAll, "all", false, "sync boards, recipients, companies, and tickets (will sync all boards for tickets unless specified)")
 	syncCmd.Flags().BoolVarP(&syncBoards, "boards", "b", false, "sync connectwise boards")
 	syncCmd.Flags().BoolVarP(&syncWebexRecipients, "recipients", "r", false, "sync webex rooms")
 	syncCmd.Flags().BoolVarP(&syncTickets, "tickets", "t", false, "sync connectwise tickets; this will take a while")
+	syncCmd.Flags().BoolVarP(&syncCompanies, "companies", "c", false, "sync connectwise companies and contacts")
 	syncCmd.Flags().IntSliceVarP(&syncBoardIDs, "sync-boards", "i", nil, "board ids to sync")
 	syncCmd.Flags().IntVar(&maxConcurrentSyncs, "max-syncs", 5, "max amount of concurrent syncs to run")
+	syncCmd.Flags().BoolVarP(&syncWait, "wait", "w", false, "wait for the sync to finish, showing progress")
+}
+
+// waitForSync polls a sync run until it finishes, printing progress whenever it changes.
+func waitForSync(id int) error {
+	var last string
+	for {
+		run, err := client.GetSyncRun(id)
+		if err != nil {
+			return fmt.Errorf("getting sync run: %w", err)
+		}
+
+		if p := syncProgressLine(run); p != last {
+			fmt.Printf("[%s] %s\n", time.Since(run.StartedOn).Round(time.Second), p)
+			last = p
+		}
+
+		if run.Finished() {
+			printSyncRun(run)
+			if run.Status == models.SyncRunStatusFailed {
+				return fmt.Errorf("sync run %d failed with %d errors", run.ID, len(run.Errors))
+			}
+			return nil
+		}
+
+		time.Sleep(syncPollInterval)
+	}
+}
+
+func syncProgressLine(run *models.SyncRun) string {
+	targets := sortedSyncTargets(run)
+	if len(targets) == 0 {
+		return "starting"
+	}
+
+	parts := make([]string, 0, len(targets))
+	for _, t := range targets {
+		r := run.Results[t]
+		parts = append(parts, fmt.Sprintf("%s: %d/%d upserted, %d deleted, %d failed", t, r.Upserted, r.Total, r.Deleted, r.Failed))
+	}
+
+	return strings.Join(parts, " | ")
+}
+
+func sortedSyncTargets(run *models.SyncRun) []models.SyncTarget {
+	targets := make([]models.SyncTarget, 0, len(run.Results))
+	for t := range run.Results {
+		targets = append(targets, t)
+	}
+	slices.Sort(targets)
+
+	return targets
+}
+
+func printSyncRun(run *models.SyncRun) {
+	fmt.Printf("ID: %d\nStatus: %s\nStarted: %s\nFinished: %s\n",
+		run.ID, run.Status, run.StartedOn.Format(time.DateTime), formatSyncFinished(run))
+
+	if len(run.Results) > 0 {
+		syncResultsTable(run)
+	}
+
+	if len(run.Errors) > 0 {
+		fmt.Println("Errors:")
+		for _, e := range run.Errors {
+			fmt.Printf("  - %s\n", e)
+		}
+	}
+}
+
+func formatSyncFinished(run *models.SyncRun) string {
+	if run.FinishedOn == nil {
+		return "NA"
+	}
+
+	return fmt.Sprintf("%s (took %s)", run.FinishedOn.Format(time.DateTime), run.FinishedOn.Sub(run.StartedOn).Round(time.Second))
 }
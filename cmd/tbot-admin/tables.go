@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
@@ -37,9 +39,9 @@ func apiUsersTable(users []models.APIUser) {
 	})
 
 	t := defaultTable()
-	t.Headers("ID", "EMAIL", "CREATED ON")
+	t.Headers("ID", "EMAIL", "ROLE", "CREATED ON")
 	for _, u := range users {
-		t.Row(strconv.Itoa(u.ID), u.EmailAddress, u.CreatedOn.Format("2006-01-02"))
+		t.Row(strconv.Itoa(u.ID), u.EmailAddress, string(u.Role), u.CreatedOn.Format("2006-01-02"))
 	}
 
 	fmt.Println(t)
@@ -51,9 +53,39 @@ func apiKeysTable(keys []models.APIKey) {
 	})
 
 	t := defaultTable()
-	t.Headers("USER ID", "KEY ID", "CREATED ON")
+	t.Headers("USER ID", "KEY ID", "NAME", "PREFIX", "SCOPES", "EXPIRES", "LAST USED", "CREATED ON")
 	for _, k := range keys {
-		t.Row(strconv.Itoa(k.UserID), strconv.Itoa(k.ID), k.CreatedOn.Format("2006-01-02"))
+		prefix := "legacy"
+		if k.KeyID != nil {
+			prefix = fmt.Sprintf("tbk_%s", *k.KeyID)
+		} else if k.LegacyUntil != nil {
+			prefix = fmt.Sprintf("legacy (until %s)", k.LegacyUntil.Format("2006-01-02"))
+		}
+
+		name := ""
+		if k.Name != nil {
+			name = *k.Name
+		}
+
+		scopes := "all"
+		if len(k.Scopes) > 0 {
+			scopes = strings.Join(k.Scopes, ", ")
+		}
+
+		expires := "never"
+		if k.ExpiresOn != nil {
+			expires = k.ExpiresOn.Local().Format("2006-01-02 15:04")
+		}
+
+		lastUsed := "never"
+		if k.LastUsedAt != nil {
+			lastUsed = k.LastUsedAt.Local().Format("2006-01-02 15:04")
+			if k.LastUsedIP != nil {
+				lastUsed += " from " + *k.LastUsedIP
+			}
+		}
+
+		t.Row(strconv.Itoa(k.UserID), strconv.Itoa(k.ID), name, prefix, scopes, expires, lastUsed, k.CreatedOn.Format("2006-01-02"))
 	}
 
 	fmt.Println(t)
@@ -73,6 +105,76 @@ func cwBoardsToTable(boards []models.Board) {
 	fmt.Println(t)
 }
 
+func cwCompaniesTable(companies []models.Company) {
+	t := defaultTable()
+	t.Headers("ID", "NAME", "DELETED")
+	for _, c := range companies {
+		t.Row(strconv.Itoa(c.ID), c.Name, boolToIcon(c.Deleted))
+	}
+
+	fmt.Println(t)
+}
+
+func cwContactsTable(contacts []models.Contact) {
+	t := defaultTable()
+	t.Headers("ID", "NAME", "COMPANY ID", "DELETED")
+	for _, c := range contacts {
+		name := c.FirstName
+		if c.LastName != nil && *c.LastName != "" {
+			name = fmt.Sprintf("%s %s", name, *c.LastName)
+		}
+
+		company := "NA"
+		if c.CompanyID != nil {
+			company = strconv.Itoa(*c.CompanyID)
+		}
+
+		t.Row(strconv.Itoa(c.ID), name, company, boolToIcon(c.Deleted))
+	}
+
+	fmt.Println(t)
+}
+
+func cwTicketsTable(tickets []models.Ticket) {
+	t := defaultTable()
+	t.Headers("ID", "SUMMARY", "BOARD ID", "STATUS ID", "OWNER ID", "COMPANY ID", "UPDATED")
+	for _, tk := range tickets {
+		owner := "NA"
+		if tk.OwnerID != nil {
+			owner = strconv.Itoa(*tk.OwnerID)
+		}
+
+		t.Row(
+			strconv.Itoa(tk.ID),
+			tk.Summary,
+			strconv.Itoa(tk.BoardID),
+			strconv.Itoa(tk.StatusID),
+			owner,
+			strconv.Itoa(tk.CompanyID),
+			tk.UpdatedOn.Format("2006-01-02 15:04:05"),
+		)
+	}
+
+	fmt.Println(t)
+}
+
+func ticketSearchTable(results []models.TicketSearchResult) {
+	t := defaultTable()
+	t.Headers("ID", "SUMMARY", "COMPANY", "BOARD", "STATUS", "UPDATED")
+	for _, r := range results {
+		t.Row(
+			strconv.Itoa(r.ID),
+			r.Summary,
+			r.CompanyName,
+			r.BoardName,
+			r.StatusName,
+			r.UpdatedOn.Format("2006-01-02 15:04:05"),
+		)
+	}
+
+	fmt.Println(t)
+}
+
 func notifierRulesTable(notifiers []models.NotifierRuleFull) {
 	t := defaultTable()
 	t.Headers("ID", "ENABLED", "BOARD", "RECIPIENT")
@@ -106,6 +208,100 @@ func userForwardsTable(forwards []models.NotifierForwardFull) {
 	fmt.Println(t)
 }
 
+func syncRunsTable(runs []models.SyncRun) {
+	t := defaultTable()
+	t.Headers("ID", "STATUS", "TARGETS", "STARTED", "TOOK", "ERRORS")
+	for _, r := range runs {
+		took := "NA"
+		if r.FinishedOn != nil {
+			took = r.FinishedOn.Sub(r.StartedOn).Round(time.Second).String()
+		}
+
+		var targets []string
+		for _, st := range sortedSyncTargets(&r) {
+			targets = append(targets, string(st))
+		}
+
+		t.Row(
+			strconv.Itoa(r.ID),
+			string(r.Status),
+			strings.Join(targets, ", "),
+			r.StartedOn.Format("2006-01-02 15:04:05"),
+			took,
+			strconv.Itoa(len(r.Errors)),
+		)
+	}
+
+	fmt.Println(t)
+}
+
+func syncSchedulesTable(scheds []models.SyncSchedule) {
+	t := defaultTable()
+	t.Headers("ID", "NAME", "CRON", "TARGETS", "ENABLED", "LAST RUN", "NEXT RUN")
+	for _, s := range scheds {
+		lastRun := "NA"
+		if s.LastRunOn != nil {
+			lastRun = s.LastRunOn.Local().Format("2006-01-02 15:04")
+		}
+
+		t.Row(
+			strconv.Itoa(s.ID),
+			s.Name,
+			s.Cron,
+			strings.Join(syncPayloadTargets(s.Payload), ", "),
+			strconv.FormatBool(s.Enabled),
+			lastRun,
+			formatNextRun(&s),
+		)
+	}
+
+	fmt.Println(t)
+}
+
+func syncPayloadTargets(p models.SyncPayload) []string {
+	var targets []string
+	if p.CWBoards {
+		targets = append(targets, string(models.SyncTargetCWBoards))
+	}
+
+	if p.WebexRecipients {
+		targets = append(targets, string(models.SyncTargetWebexRecipients))
+	}
+
+	if p.CWCompanies {
+		targets = append(targets, string(models.SyncTargetCWCompanies))
+	}
+
+	if p.CWTickets {
+		t := string(models.SyncTargetCWTickets)
+		if len(p.BoardIDs) > 0 {
+			t = fmt.Sprintf("%s %v", t, p.BoardIDs)
+		}
+		targets = append(targets, t)
+	}
+
+	return targets
+}
+
+func formatNextRun(s *models.SyncSchedule) string {
+	if s.NextRunOn == nil {
+		return "NA"
+	}
+
+	return s.NextRunOn.Local().Format("2006-01-02 15:04")
+}
+
+func syncResultsTable(run *models.SyncRun) {
+	t := defaultTable()
+	t.Headers("TARGET", "TOTAL", "UPSERTED", "DELETED", "FAILED")
+	for _, st := range sortedSyncTargets(run) {
+		r := run.Results[st]
+		t.Row(string(st), strconv.Itoa(r.Total), strconv.Itoa(r.Upserted), strconv.Itoa(r.Deleted), strconv.Itoa(r.Failed))
+	}
+
+	fmt.Println(t)
+}
+
 func defaultTable() *table.Table {
 	return table.New().
 		Border(lipgloss.NormalBorder()).
@@ -126,3 +322,34 @@ func boolToIcon(b bool) string {
 
 	return i
 }
+
+func auditEventsTable(events []models.AuditEvent, emails map[int]string) {
+	t := defaultTable()
+	t.Headers("ID", "TIME", "USER", "ACTION", "ENTITY", "ENTITY ID", "CHANGED")
+	for _, e := range events {
+		u := "system"
+		if e.UserID != nil {
+			u = strconv.Itoa(*e.UserID)
+			if email, ok := emails[*e.UserID]; ok {
+				u = email
+			}
+		}
+
+		entityID := "NA"
+		if e.EntityID != nil {
+			entityID = strconv.Itoa(*e.EntityID)
+		}
+
+		t.Row(
+			strconv.Itoa(e.ID),
+			e.CreatedOn.Local().Format("2006-01-02 15:04:05"),
+			u,
+			string(e.Action),
+			string(e.EntityType),
+			entityID,
+			strings.Join(e.ChangedFields(), ", "),
+		)
+	}
+
+	fmt.Println(t)
+}
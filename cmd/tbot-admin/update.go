@@ -1,9 +1,12 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/thecoretg/ticketbot/internal/models"
 )
 
 var (
@@ -33,6 +36,14 @@ var (
 				cfg.MaxConcurrentSyncs = cfgMaxSyncs
 			}
 
+			if cmd.Flags().Changed("reconcile-interval") {
+				cfg.ReconcileIntervalMinutes = cfgReconInterval
+			}
+
+			if cmd.Flags().Changed("reconcile-lookback") {
+				cfg.ReconcileLookbackMinutes = cfgReconLookback
+			}
+
 			cfg, err = client.UpdateConfig(cfg)
 			if err != nil {
 				return err
@@ -43,11 +54,162 @@ var (
 			return nil
 		},
 	}
+
+	updateNotifierRuleCmd = &cobra.Command{
+		Use:     "notifier-rule",
+		Aliases: []string{"rule"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if id == 0 {
+				return errors.New("notifier rule id is required")
+			}
+
+			fields := map[string]any{}
+			if cmd.Flags().Changed("board-id") {
+				fields["board_id"] = boardID
+			}
+
+			if cmd.Flags().Changed("recipient-id") {
+				fields["recipient_id"] = recipientID
+			}
+
+			if cmd.Flags().Changed("enabled") {
+				fields["enabled"] = ruleEnabled
+			}
+
+			if len(fields) == 0 {
+				return errors.New("nothing to update - pass at least one of --board-id, --recipient-id, or --enabled")
+			}
+
+			n, err := client.PatchNotifierRule(id, fields)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("ID: %d\nRoom: %d\nBoard: %d\nNotify: %v\n",
+				n.ID, n.WebexRecipientID, n.CwBoardID, n.NotifyEnabled)
+
+			return nil
+		},
+	}
+
+	updateForwardCmd = &cobra.Command{
+		Use:     "notifier-forward",
+		Aliases: []string{"forward", "fwd"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if id == 0 {
+				return errors.New("forward id is required")
+			}
+
+			fields := map[string]any{}
+			if cmd.Flags().Changed("dest-id") {
+				fields["destination_id"] = forwardDestID
+			}
+
+			for _, d := range []struct{ flag, field, val string }{
+				{"start-date", "start_date", forwardStartDate},
+				{"end-date", "end_date", forwardEndDate},
+			} {
+				if !cmd.Flags().Changed(d.flag) {
+					continue
+				}
+
+				date, err := parseOptionalDate(d.val)
+				if err != nil {
+					return fmt.Errorf("parsing %s: %w", d.flag, err)
+				}
+				fields[d.field] = date
+			}
+
+			if cmd.Flags().Changed("enabled") {
+				fields["enabled"] = forwardEnabled
+			}
+
+			if cmd.Flags().Changed("user-keeps-copy") {
+				fields["user_keeps_copy"] = forwardUserKeeps
+			}
+
+			if len(fields) == 0 {
+				return errors.New("nothing to update - pass at least one of --dest-id, --start-date, --end-date, --enabled, or --user-keeps-copy")
+			}
+
+			uf, err := client.PatchUserForward(id, fields)
+			if err != nil {
+				return fmt.Errorf("updating user forward: %w", err)
+			}
+
+			fmt.Printf("ID: %d\nSource: %d\nForward To: %d\nStart Date: %s\nEnd Date: %s\nUser Keeps Copy: %v\nEnabled: %v\n",
+				uf.ID, uf.SourceID, uf.DestID, uf.StartDate, uf.EndDate, uf.UserKeepsCopy, uf.Enabled)
+
+			return nil
+		},
+	}
+
+	updateUserCmd = &cobra.Command{
+		Use: "user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if id == 0 {
+				return errors.New("user id is required")
+			}
+
+			fields := map[string]any{}
+			if cmd.Flags().Changed("email") {
+				fields["email_address"] = emailAddress
+			}
+
+			if cmd.Flags().Changed("role") {
+				if !models.Role(userRole).Valid() {
+					return fmt.Errorf("invalid role %q; must be one of %v", userRole, models.Roles)
+				}
+				fields["role"] = userRole
+			}
+
+			if len(fields) == 0 {
+				return errors.New("nothing to update - pass at least one of --email or --role")
+			}
+
+			u, err := client.PatchUser(id, fields)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("User updated:\nID:%d\nEmail:%s\nRole:%s\n", u.ID, u.EmailAddress, u.Role)
+			return nil
+		},
+	}
 )
 
+// parseOptionalDate parses a YYYY-MM-DD date, or returns nil for "none" so the date is cleared.
+func parseOptionalDate(s string) (*time.Time, error) {
+	if s == "none" {
+		return nil, nil
+	}
+
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
 func init() {
-	updateCmd.AddCommand(updateCfgCmd)
+	updateCmd.AddCommand(updateCfgCmd, updateNotifierRuleCmd, updateForwardCmd, updateUserCmd)
 	updateCfgCmd.Flags().BoolVarP(&cfgAttemptNotify, "attempt-notify", "n", false, "attempt notify on server")
 	updateCfgCmd.Flags().IntVarP(&cfgMaxMsgLen, "max-msg-length", "l", 300, "max webex message length")
 	updateCfgCmd.Flags().IntVarP(&cfgMaxSyncs, "max-concurrent-syncs", "s", 5, "max concurrent syncs")
+	updateCfgCmd.Flags().IntVar(&cfgReconInterval, "reconcile-interval", 10, "minutes between checks for missed connectwise webhooks (0 to disable)")
+	updateCfgCmd.Flags().IntVar(&cfgReconLookback, "reconcile-lookback", 60, "minutes of ticket updates each reconcile looks back over")
+	updateNotifierRuleCmd.Flags().IntVar(&id, "id", 0, "id of the notifier rule to update")
+	updateNotifierRuleCmd.Flags().IntVarP(&boardID, "board-id", "b", 0, "board id to use")
+	updateNotifierRuleCmd.Flags().IntVarP(&recipientID, "recipient-id", "r", 0, "recipient id to use")
+	updateNotifierRuleCmd.Flags().BoolVarP(&ruleEnabled, "enabled", "x", true, "enable notifications for the rule")
+	updateForwardCmd.Flags().IntVar(&id, "id", 0, "id of the forward to update")
+	updateForwardCmd.Flags().IntVarP(&forwardDestID, "dest-id", "d", 0, "destination recipient id to forward to")
+	updateForwardCmd.Flags().StringVarP(&forwardStartDate, "start-date", "a", "", "start date for forward (YYYY-MM-DD, or none to clear)")
+	updateForwardCmd.Flags().StringVarP(&forwardEndDate, "end-date", "e", "", "end date for forward (YYYY-MM-DD, or none to clear)")
+	updateForwardCmd.Flags().BoolVarP(&forwardEnabled, "enabled", "x", true, "enable the forward")
+	updateForwardCmd.Flags().BoolVarP(&forwardUserKeeps, "user-keeps-copy", "k", false, "user keeps a copy of forwarded emails")
+	updateUserCmd.Flags().IntVar(&id, "id", 0, "id of the user to update")
+	updateUserCmd.Flags().StringVarP(&emailAddress, "email", "e", "", "new email address of the user")
+	updateUserCmd.Flags().StringVarP(&userRole, "role", "r", "", "new role of the user: admin, operator, self_service, or read_only")
 }
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const deleteAPIKey = `-- name: DeleteAPIKey :exec
@@ -20,7 +21,7 @@ func (q *Queries) DeleteAPIKey(ctx context.Context, id int) error {
 }
 
 const getAPIKey = `-- name: GetAPIKey :one
-SELECT id, user_id, key_hash, created_on, updated_on, key_hint FROM api_key
+SELECT id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip FROM api_key
 WHERE id = $1
 `
 
@@ -34,25 +35,72 @@ func (q *Queries) GetAPIKey(ctx context.Context, id int) (*ApiKey, error) {
 		&i.CreatedOn,
 		&i.UpdatedOn,
 		&i.KeyHint,
+		&i.KeyID,
+		&i.LegacyUntil,
+		&i.Name,
+		&i.ExpiresOn,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+	)
+	return &i, err
+}
+
+const getAPIKeyByKeyID = `-- name: GetAPIKeyByKeyID :one
+SELECT id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip FROM api_key
+WHERE key_id = $1
+`
+
+func (q *Queries) GetAPIKeyByKeyID(ctx context.Context, keyID *string) (*ApiKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyByKeyID, keyID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.KeyHash,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.KeyHint,
+		&i.KeyID,
+		&i.LegacyUntil,
+		&i.Name,
+		&i.ExpiresOn,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
 	)
 	return &i, err
 }
 
 const insertAPIKey = `-- name: InsertAPIKey :one
 INSERT INTO api_key
-(user_id, key_hash, key_hint)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, key_hash, created_on, updated_on, key_hint
+(user_id, key_hash, key_hint, key_id, legacy_until, name, expires_on, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip
 `
 
 type InsertAPIKeyParams struct {
-	UserID  int     `json:"user_id"`
-	KeyHash []byte  `json:"key_hash"`
-	KeyHint *string `json:"key_hint"`
+	UserID      int        `json:"user_id"`
+	KeyHash     []byte     `json:"key_hash"`
+	KeyHint     *string    `json:"key_hint"`
+	KeyID       *string    `json:"key_id"`
+	LegacyUntil *time.Time `json:"legacy_until"`
+	Name        *string    `json:"name"`
+	ExpiresOn   *time.Time `json:"expires_on"`
+	Scopes      []string   `json:"scopes"`
 }
 
 func (q *Queries) InsertAPIKey(ctx context.Context, arg InsertAPIKeyParams) (*ApiKey, error) {
-	row := q.db.QueryRow(ctx, insertAPIKey, arg.UserID, arg.KeyHash, arg.KeyHint)
+	row := q.db.QueryRow(ctx, insertAPIKey,
+		arg.UserID,
+		arg.KeyHash,
+		arg.KeyHint,
+		arg.KeyID,
+		arg.LegacyUntil,
+		arg.Name,
+		arg.ExpiresOn,
+		arg.Scopes,
+	)
 	var i ApiKey
 	err := row.Scan(
 		&i.ID,
@@ -61,12 +109,19 @@ func (q *Queries) InsertAPIKey(ctx context.Context, arg InsertAPIKeyParams) (*Ap
 		&i.CreatedOn,
 		&i.UpdatedOn,
 		&i.KeyHint,
+		&i.KeyID,
+		&i.LegacyUntil,
+		&i.Name,
+		&i.ExpiresOn,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
 	)
 	return &i, err
 }
 
 const listAPIKeys = `-- name: ListAPIKeys :many
-SELECT id, user_id, key_hash, created_on, updated_on, key_hint FROM api_key
+SELECT id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip FROM api_key
 ORDER BY created_on
 `
 
@@ -86,6 +141,53 @@ func (q *Queries) ListAPIKeys(ctx context.Context) ([]*ApiKey, error) {
 			&i.CreatedOn,
 			&i.UpdatedOn,
 			&i.KeyHint,
+			&i.KeyID,
+			&i.LegacyUntil,
+			&i.Name,
+			&i.ExpiresOn,
+			&i.Scopes,
+			&i.LastUsedAt,
+			&i.LastUsedIp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLegacyAPIKeys = `-- name: ListLegacyAPIKeys :many
+SELECT id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip FROM api_key
+WHERE key_id IS NULL AND legacy_until > NOW()
+ORDER BY created_on
+`
+
+func (q *Queries) ListLegacyAPIKeys(ctx context.Context) ([]*ApiKey, error) {
+	rows, err := q.db.Query(ctx, listLegacyAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.KeyHash,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+			&i.KeyHint,
+			&i.KeyID,
+			&i.LegacyUntil,
+			&i.Name,
+			&i.ExpiresOn,
+			&i.Scopes,
+			&i.LastUsedAt,
+			&i.LastUsedIp,
 		); err != nil {
 			return nil, err
 		}
@@ -103,7 +205,7 @@ SET
     delete = true,
     updated_on = NOW()
 WHERE id = $1
-RETURNING id, user_id, key_hash, created_on, updated_on, key_hint
+RETURNING id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip
 `
 
 func (q *Queries) SoftDeleteAPIKey(ctx context.Context, id int) (*ApiKey, error) {
@@ -116,6 +218,66 @@ func (q *Queries) SoftDeleteAPIKey(ctx context.Context, id int) (*ApiKey, error)
 		&i.CreatedOn,
 		&i.UpdatedOn,
 		&i.KeyHint,
+		&i.KeyID,
+		&i.LegacyUntil,
+		&i.Name,
+		&i.ExpiresOn,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+	)
+	return &i, err
+}
+
+const updateAPIKeyExpiry = `-- name: UpdateAPIKeyExpiry :one
+UPDATE api_key
+SET
+    expires_on = $2,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING id, user_id, key_hash, created_on, updated_on, key_hint, key_id, legacy_until, name, expires_on, scopes, last_used_at, last_used_ip
+`
+
+type UpdateAPIKeyExpiryParams struct {
+	ID        int        `json:"id"`
+	ExpiresOn *time.Time `json:"expires_on"`
+}
+
+func (q *Queries) UpdateAPIKeyExpiry(ctx context.Context, arg UpdateAPIKeyExpiryParams) (*ApiKey, error) {
+	row := q.db.QueryRow(ctx, updateAPIKeyExpiry, arg.ID, arg.ExpiresOn)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.KeyHash,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.KeyHint,
+		&i.KeyID,
+		&i.LegacyUntil,
+		&i.Name,
+		&i.ExpiresOn,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
 	)
 	return &i, err
 }
+
+const updateAPIKeyLastUsed = `-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_key
+SET
+    last_used_at = NOW(),
+    last_used_ip = $2
+WHERE id = $1
+`
+
+type UpdateAPIKeyLastUsedParams struct {
+	ID         int     `json:"id"`
+	LastUsedIp *string `json:"last_used_ip"`
+}
+
+func (q *Queries) UpdateAPIKeyLastUsed(ctx context.Context, arg UpdateAPIKeyLastUsedParams) error {
+	_, err := q.db.Exec(ctx, updateAPIKeyLastUsed, arg.ID, arg.LastUsedIp)
+	return err
+}
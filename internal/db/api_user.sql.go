@@ -35,7 +35,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id int) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, email_address, created_on, updated_on FROM api_user
+SELECT id, email_address, created_on, updated_on, role FROM api_user
 WHERE id = $1 LIMIT 1
 `
 
@@ -47,12 +47,13 @@ func (q *Queries) GetUser(ctx context.Context, id int) (*ApiUser, error) {
 		&i.EmailAddress,
 		&i.CreatedOn,
 		&i.UpdatedOn,
+		&i.Role,
 	)
 	return &i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email_address, created_on, updated_on FROM api_user
+SELECT id, email_address, created_on, updated_on, role FROM api_user
 WHERE email_address = $1 LIMIT 1
 `
 
@@ -64,31 +65,38 @@ func (q *Queries) GetUserByEmail(ctx context.Context, emailAddress string) (*Api
 		&i.EmailAddress,
 		&i.CreatedOn,
 		&i.UpdatedOn,
+		&i.Role,
 	)
 	return &i, err
 }
 
 const insertUser = `-- name: InsertUser :one
 INSERT INTO api_user
-(email_address)
-VALUES ($1)
-RETURNING id, email_address, created_on, updated_on
+(email_address, role)
+VALUES ($1, $2)
+RETURNING id, email_address, created_on, updated_on, role
 `
 
-func (q *Queries) InsertUser(ctx context.Context, emailAddress string) (*ApiUser, error) {
-	row := q.db.QueryRow(ctx, insertUser, emailAddress)
+type InsertUserParams struct {
+	EmailAddress string `json:"email_address"`
+	Role         string `json:"role"`
+}
+
+func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (*ApiUser, error) {
+	row := q.db.QueryRow(ctx, insertUser, arg.EmailAddress, arg.Role)
 	var i ApiUser
 	err := row.Scan(
 		&i.ID,
 		&i.EmailAddress,
 		&i.CreatedOn,
 		&i.UpdatedOn,
+		&i.Role,
 	)
 	return &i, err
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, email_address, created_on, updated_on FROM api_user
+SELECT id, email_address, created_on, updated_on, role FROM api_user
 ORDER BY email_address
 `
 
@@ -106,6 +114,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]*ApiUser, error) {
 			&i.EmailAddress,
 			&i.CreatedOn,
 			&i.UpdatedOn,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -121,24 +130,27 @@ const updateUser = `-- name: UpdateUser :one
 UPDATE api_user
 SET
     email_address = $2,
+    role = $3,
     updated_on = NOW()
 WHERE id = $1
-RETURNING id, email_address, created_on, updated_on
+RETURNING id, email_address, created_on, updated_on, role
 `
 
 type UpdateUserParams struct {
 	ID           int    `json:"id"`
 	EmailAddress string `json:"email_address"`
+	Role         string `json:"role"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (*ApiUser, error) {
-	row := q.db.QueryRow(ctx, updateUser, arg.ID, arg.EmailAddress)
+	row := q.db.QueryRow(ctx, updateUser, arg.ID, arg.EmailAddress, arg.Role)
 	var i ApiUser
 	err := row.Scan(
 		&i.ID,
 		&i.EmailAddress,
 		&i.CreatedOn,
 		&i.UpdatedOn,
+		&i.Role,
 	)
 	return &i, err
 }
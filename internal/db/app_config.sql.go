@@ -10,7 +10,7 @@ import (
 )
 
 const getAppConfig = `-- name: GetAppConfig :one
-SELECT id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs FROM app_config
+SELECT id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs, reconcile_interval_minutes, reconcile_lookback_minutes FROM app_config
 WHERE id = 1
 `
 
@@ -23,6 +23,8 @@ func (q *Queries) GetAppConfig(ctx context.Context) (*AppConfig, error) {
 		&i.MaxMessageLength,
 		&i.MaxConcurrentSyncs,
 		&i.SkipLaunchSyncs,
+		&i.ReconcileIntervalMinutes,
+		&i.ReconcileLookbackMinutes,
 	)
 	return &i, err
 }
@@ -30,7 +32,7 @@ func (q *Queries) GetAppConfig(ctx context.Context) (*AppConfig, error) {
 const insertDefaultAppConfig = `-- name: InsertDefaultAppConfig :one
 INSERT INTO app_config (id) VALUES (1)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
-RETURNING id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs
+RETURNING id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs, reconcile_interval_minutes, reconcile_lookback_minutes
 `
 
 func (q *Queries) InsertDefaultAppConfig(ctx context.Context) (*AppConfig, error) {
@@ -42,26 +44,32 @@ func (q *Queries) InsertDefaultAppConfig(ctx context.Context) (*AppConfig, error
 		&i.MaxMessageLength,
 		&i.MaxConcurrentSyncs,
 		&i.SkipLaunchSyncs,
+		&i.ReconcileIntervalMinutes,
+		&i.ReconcileLookbackMinutes,
 	)
 	return &i, err
 }
 
 const upsertAppConfig = `-- name: UpsertAppConfig :one
-INSERT INTO app_config(id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs)
-VALUES(1, $1, $2, $3, $4)
+INSERT INTO app_config(id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs, reconcile_interval_minutes, reconcile_lookback_minutes)
+VALUES(1, $1, $2, $3, $4, $5, $6)
 ON CONFLICT (id) DO UPDATE SET
     attempt_notify = EXCLUDED.attempt_notify,
     max_message_length = EXCLUDED.max_message_length,
     max_concurrent_syncs = EXCLUDED.max_concurrent_syncs,
-    skip_launch_syncs = EXCLUDED.skip_launch_syncs
-RETURNING id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs
+    skip_launch_syncs = EXCLUDED.skip_launch_syncs,
+    reconcile_interval_minutes = EXCLUDED.reconcile_interval_minutes,
+    reconcile_lookback_minutes = EXCLUDED.reconcile_lookback_minutes
+RETURNING id, attempt_notify, max_message_length, max_concurrent_syncs, skip_launch_syncs, reconcile_interval_minutes, reconcile_lookback_minutes
 `
 
 type UpsertAppConfigParams struct {
-	AttemptNotify      bool `json:"attempt_notify"`
-	MaxMessageLength   int  `json:"max_message_length"`
-	MaxConcurrentSyncs int  `json:"max_concurrent_syncs"`
-	SkipLaunchSyncs    bool `json:"skip_launch_syncs"`
+	AttemptNotify            bool `json:"attempt_notify"`
+	MaxMessageLength         int  `json:"max_message_length"`
+	MaxConcurrentSyncs       int  `json:"max_concurrent_syncs"`
+	SkipLaunchSyncs          bool `json:"skip_launch_syncs"`
+	ReconcileIntervalMinutes int  `json:"reconcile_interval_minutes"`
+	ReconcileLookbackMinutes int  `json:"reconcile_lookback_minutes"`
 }
 
 func (q *Queries) UpsertAppConfig(ctx context.Context, arg UpsertAppConfigParams) (*AppConfig, error) {
@@ -70,6 +78,8 @@ func (q *Queries) UpsertAppConfig(ctx context.Context, arg UpsertAppConfigParams
 		arg.MaxMessageLength,
 		arg.MaxConcurrentSyncs,
 		arg.SkipLaunchSyncs,
+		arg.ReconcileIntervalMinutes,
+		arg.ReconcileLookbackMinutes,
 	)
 	var i AppConfig
 	err := row.Scan(
@@ -78,6 +88,8 @@ func (q *Queries) UpsertAppConfig(ctx context.Context, arg UpsertAppConfigParams
 		&i.MaxMessageLength,
 		&i.MaxConcurrentSyncs,
 		&i.SkipLaunchSyncs,
+		&i.ReconcileIntervalMinutes,
+		&i.ReconcileLookbackMinutes,
 	)
 	return &i, err
 }
@@ -68,6 +68,38 @@ func (q *Queries) ListCompanies(ctx context.Context) ([]*CwCompany, error) {
 	return items, nil
 }
 
+const searchCompanies = `-- name: SearchCompanies :many
+SELECT id, name, updated_on, added_on, deleted FROM cw_company
+WHERE deleted = false AND strpos(lower(name), lower($1::text)) > 0
+ORDER BY name
+`
+
+func (q *Queries) SearchCompanies(ctx context.Context, name string) ([]*CwCompany, error) {
+	rows, err := q.db.Query(ctx, searchCompanies, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CwCompany
+	for rows.Next() {
+		var i CwCompany
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.UpdatedOn,
+			&i.AddedOn,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteCompany = `-- name: SoftDeleteCompany :exec
 UPDATE cw_company
 SET
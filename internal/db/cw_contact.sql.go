@@ -72,6 +72,40 @@ func (q *Queries) ListContacts(ctx context.Context) ([]*CwContact, error) {
 	return items, nil
 }
 
+const searchContacts = `-- name: SearchContacts :many
+SELECT id, first_name, last_name, company_id, updated_on, added_on, deleted FROM cw_contact
+WHERE deleted = false AND strpos(lower(first_name || ' ' || COALESCE(last_name, '')), lower($1::text)) > 0
+ORDER BY first_name, last_name
+`
+
+func (q *Queries) SearchContacts(ctx context.Context, name string) ([]*CwContact, error) {
+	rows, err := q.db.Query(ctx, searchContacts, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CwContact
+	for rows.Next() {
+		var i CwContact
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.CompanyID,
+			&i.UpdatedOn,
+			&i.AddedOn,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteContact = `-- name: SoftDeleteContact :exec
 UPDATE cw_contact
 SET
@@ -19,6 +19,27 @@ func (q *Queries) DeleteMember(ctx context.Context, id int) error {
 	return err
 }
 
+const getActiveMemberByEmail = `-- name: GetActiveMemberByEmail :one
+SELECT id, identifier, first_name, last_name, primary_email, updated_on, added_on, deleted FROM cw_member
+WHERE deleted = false AND lower(primary_email) = lower($1::text) LIMIT 1
+`
+
+func (q *Queries) GetActiveMemberByEmail(ctx context.Context, email string) (*CwMember, error) {
+	row := q.db.QueryRow(ctx, getActiveMemberByEmail, email)
+	var i CwMember
+	err := row.Scan(
+		&i.ID,
+		&i.Identifier,
+		&i.FirstName,
+		&i.LastName,
+		&i.PrimaryEmail,
+		&i.UpdatedOn,
+		&i.AddedOn,
+		&i.Deleted,
+	)
+	return &i, err
+}
+
 const getMember = `-- name: GetMember :one
 SELECT id, identifier, first_name, last_name, primary_email, updated_on, added_on, deleted FROM cw_member
 WHERE id = $1 LIMIT 1
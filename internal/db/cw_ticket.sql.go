@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const checkTicketExists = `-- name: CheckTicketExists :one
@@ -97,6 +98,75 @@ func (q *Queries) ListTickets(ctx context.Context) ([]*CwTicket, error) {
 	return items, nil
 }
 
+const queryTickets = `-- name: QueryTickets :many
+SELECT id, summary, board_id, status_id, owner_id, company_id, contact_id, resources, updated_by, updated_on, added_on, deleted FROM cw_ticket
+WHERE deleted = false
+    AND id > $1::int
+    AND ($2::int IS NULL OR board_id = $2)
+    AND ($3::int IS NULL OR status_id = $3)
+    AND ($4::int IS NULL OR owner_id = $4)
+    AND ($5::int IS NULL OR company_id = $5)
+    AND ($6::timestamp IS NULL OR updated_on >= $6)
+    AND ($7::bool IS NULL OR status_id IN (
+        SELECT s.id FROM cw_ticket_status s WHERE s.closed = $7
+    ))
+ORDER BY id
+LIMIT $8::int
+`
+
+type QueryTicketsParams struct {
+	Cursor       int        `json:"cursor"`
+	BoardID      *int       `json:"board_id"`
+	StatusID     *int       `json:"status_id"`
+	OwnerID      *int       `json:"owner_id"`
+	CompanyID    *int       `json:"company_id"`
+	UpdatedSince *time.Time `json:"updated_since"`
+	Closed       *bool      `json:"closed"`
+	PageSize     int        `json:"page_size"`
+}
+
+func (q *Queries) QueryTickets(ctx context.Context, arg QueryTicketsParams) ([]*CwTicket, error) {
+	rows, err := q.db.Query(ctx, queryTickets,
+		arg.Cursor,
+		arg.BoardID,
+		arg.StatusID,
+		arg.OwnerID,
+		arg.CompanyID,
+		arg.UpdatedSince,
+		arg.Closed,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CwTicket
+	for rows.Next() {
+		var i CwTicket
+		if err := rows.Scan(
+			&i.ID,
+			&i.Summary,
+			&i.BoardID,
+			&i.StatusID,
+			&i.OwnerID,
+			&i.CompanyID,
+			&i.ContactID,
+			&i.Resources,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+			&i.AddedOn,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteTicket = `-- name: SoftDeleteTicket :exec
 UPDATE cw_ticket
 SET
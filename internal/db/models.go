@@ -9,12 +9,19 @@ import (
 )
 
 type ApiKey struct {
-	ID        int       `json:"id"`
-	UserID    int       `json:"user_id"`
-	KeyHash   []byte    `json:"key_hash"`
-	CreatedOn time.Time `json:"created_on"`
-	UpdatedOn time.Time `json:"updated_on"`
-	KeyHint   *string   `json:"key_hint"`
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	KeyHash     []byte     `json:"key_hash"`
+	CreatedOn   time.Time  `json:"created_on"`
+	UpdatedOn   time.Time  `json:"updated_on"`
+	KeyHint     *string    `json:"key_hint"`
+	KeyID       *string    `json:"key_id"`
+	LegacyUntil *time.Time `json:"legacy_until"`
+	Name        *string    `json:"name"`
+	ExpiresOn   *time.Time `json:"expires_on"`
+	Scopes      []string   `json:"scopes"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIp  *string    `json:"last_used_ip"`
 }
 
 type ApiUser struct {
@@ -22,14 +29,28 @@ type ApiUser struct {
 	EmailAddress string    `json:"email_address"`
 	CreatedOn    time.Time `json:"created_on"`
 	UpdatedOn    time.Time `json:"updated_on"`
+	Role         string    `json:"role"`
 }
 
 type AppConfig struct {
-	ID                 int  `json:"id"`
-	AttemptNotify      bool `json:"attempt_notify"`
-	MaxMessageLength   int  `json:"max_message_length"`
-	MaxConcurrentSyncs int  `json:"max_concurrent_syncs"`
-	SkipLaunchSyncs    bool `json:"skip_launch_syncs"`
+	ID                       int  `json:"id"`
+	AttemptNotify            bool `json:"attempt_notify"`
+	MaxMessageLength         int  `json:"max_message_length"`
+	MaxConcurrentSyncs       int  `json:"max_concurrent_syncs"`
+	SkipLaunchSyncs          bool `json:"skip_launch_syncs"`
+	ReconcileIntervalMinutes int  `json:"reconcile_interval_minutes"`
+	ReconcileLookbackMinutes int  `json:"reconcile_lookback_minutes"`
+}
+
+type AuditEvent struct {
+	ID         int       `json:"id"`
+	UserID     *int      `json:"user_id"`
+	Action     string    `json:"action"`
+	EntityType string    `json:"entity_type"`
+	EntityID   *int      `json:"entity_id"`
+	Before     []byte    `json:"before"`
+	After      []byte    `json:"after"`
+	CreatedOn  time.Time `json:"created_on"`
 }
 
 type CwBoard struct {
@@ -40,6 +61,48 @@ type CwBoard struct {
 	Deleted   bool      `json:"deleted"`
 }
 
+type CwBoardItem struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	Inactive  bool      `json:"inactive"`
+	AddedOn   time.Time `json:"added_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+	Deleted   bool      `json:"deleted"`
+}
+
+type CwBoardSubtype struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	Inactive  bool      `json:"inactive"`
+	AddedOn   time.Time `json:"added_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+	Deleted   bool      `json:"deleted"`
+}
+
+type CwBoardTeam struct {
+	ID          int       `json:"id"`
+	BoardID     int       `json:"board_id"`
+	Name        string    `json:"name"`
+	LeaderID    *int      `json:"leader_id"`
+	DefaultTeam bool      `json:"default_team"`
+	AddedOn     time.Time `json:"added_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Deleted     bool      `json:"deleted"`
+}
+
+type CwBoardType struct {
+	ID          int       `json:"id"`
+	BoardID     int       `json:"board_id"`
+	Name        string    `json:"name"`
+	DefaultType bool      `json:"default_type"`
+	Inactive    bool      `json:"inactive"`
+	AddedOn     time.Time `json:"added_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Deleted     bool      `json:"deleted"`
+}
+
 type CwCompany struct {
 	ID        int       `json:"id"`
 	Name      string    `json:"name"`
@@ -69,6 +132,17 @@ type CwMember struct {
 	Deleted      bool      `json:"deleted"`
 }
 
+type CwPriority struct {
+	ID              int       `json:"id"`
+	Name            string    `json:"name"`
+	Color           *string   `json:"color"`
+	SortOrder       *int      `json:"sort_order"`
+	DefaultPriority bool      `json:"default_priority"`
+	AddedOn         time.Time `json:"added_on"`
+	UpdatedOn       time.Time `json:"updated_on"`
+	Deleted         bool      `json:"deleted"`
+}
+
 type CwTicket struct {
 	ID        int       `json:"id"`
 	Summary   string    `json:"summary"`
@@ -108,6 +182,42 @@ type CwTicketStatus struct {
 	Deleted        bool      `json:"deleted"`
 }
 
+type DeviceLogin struct {
+	ID             int       `json:"id"`
+	DeviceCodeHash []byte    `json:"device_code_hash"`
+	UserCode       string    `json:"user_code"`
+	State          string    `json:"state"`
+	UserID         *int      `json:"user_id"`
+	DeniedReason   *string   `json:"denied_reason"`
+	ExpiresOn      time.Time `json:"expires_on"`
+	CreatedOn      time.Time `json:"created_on"`
+}
+
+type DispatchNotification struct {
+	ID              int        `json:"id"`
+	ScheduleEntryID int        `json:"schedule_entry_id"`
+	TicketID        int        `json:"ticket_id"`
+	MemberID        int        `json:"member_id"`
+	DateStart       time.Time  `json:"date_start"`
+	DateEnd         *time.Time `json:"date_end"`
+	RecipientID     *int       `json:"recipient_id"`
+	ForwardedFromID *int       `json:"forwarded_from_id"`
+	Sent            bool       `json:"sent"`
+	CreatedOn       time.Time  `json:"created_on"`
+	ClaimedOn       *time.Time `json:"claimed_on"`
+}
+
+type LoginSession struct {
+	ID               int       `json:"id"`
+	UserID           int       `json:"user_id"`
+	TokenHash        []byte    `json:"token_hash"`
+	RefreshHash      []byte    `json:"refresh_hash"`
+	ExpiresOn        time.Time `json:"expires_on"`
+	RefreshExpiresOn time.Time `json:"refresh_expires_on"`
+	CreatedOn        time.Time `json:"created_on"`
+	UpdatedOn        time.Time `json:"updated_on"`
+}
+
 type NotifierForward struct {
 	ID            int        `json:"id"`
 	SourceID      int        `json:"source_id"`
@@ -128,6 +238,27 @@ type NotifierRule struct {
 	CreatedOn        time.Time `json:"created_on"`
 }
 
+type SyncRun struct {
+	ID         int        `json:"id"`
+	Payload    []byte     `json:"payload"`
+	Status     string     `json:"status"`
+	Results    []byte     `json:"results"`
+	Errors     []string   `json:"errors"`
+	StartedOn  time.Time  `json:"started_on"`
+	FinishedOn *time.Time `json:"finished_on"`
+}
+
+type SyncSchedule struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Cron      string     `json:"cron"`
+	Payload   []byte     `json:"payload"`
+	Enabled   bool       `json:"enabled"`
+	LastRunOn *time.Time `json:"last_run_on"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn time.Time  `json:"updated_on"`
+}
+
 type TicketNotification struct {
 	ID              int       `json:"id"`
 	TicketID        int       `json:"ticket_id"`
@@ -233,3 +233,52 @@ func (q *Queries) ListNotifierForwardsFull(ctx context.Context) ([]*ListNotifier
 	}
 	return items, nil
 }
+
+const updateNotifierForward = `-- name: UpdateNotifierForward :one
+UPDATE notifier_forward
+SET
+    source_id = $2,
+    destination_id = $3,
+    start_date = $4,
+    end_date = $5,
+    enabled = $6,
+    user_keeps_copy = $7,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING id, source_id, destination_id, start_date, end_date, enabled, user_keeps_copy, created_on, updated_on
+`
+
+type UpdateNotifierForwardParams struct {
+	ID            int        `json:"id"`
+	SourceID      int        `json:"source_id"`
+	DestinationID int        `json:"destination_id"`
+	StartDate     *time.Time `json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+	Enabled       bool       `json:"enabled"`
+	UserKeepsCopy bool       `json:"user_keeps_copy"`
+}
+
+func (q *Queries) UpdateNotifierForward(ctx context.Context, arg UpdateNotifierForwardParams) (*NotifierForward, error) {
+	row := q.db.QueryRow(ctx, updateNotifierForward,
+		arg.ID,
+		arg.SourceID,
+		arg.DestinationID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.Enabled,
+		arg.UserKeepsCopy,
+	)
+	var i NotifierForward
+	err := row.Scan(
+		&i.ID,
+		&i.SourceID,
+		&i.DestinationID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Enabled,
+		&i.UserKeepsCopy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return &i, err
+}
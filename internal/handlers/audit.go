@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
+)
+
+type AuditHandler struct {
+	Svc *audit.Service
+}
+
+func NewAuditHandler(svc *audit.Service) *AuditHandler {
+	return &AuditHandler{
+		Svc: svc,
+	}
+}
+
+func (h *AuditHandler) ListEvents(c *gin.Context) {
+	f, err := auditFilterFromQuery(c)
+	if err != nil {
+		badRequestError(c, err)
+		return
+	}
+
+	e, err := h.Svc.List(c.Request.Context(), f)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, e)
+}
+
+func auditFilterFromQuery(c *gin.Context) (models.AuditFilter, error) {
+	var (
+		f   models.AuditFilter
+		err error
+	)
+
+	if f.UserID, err = queryIntPtr(c, "user_id"); err != nil {
+		return f, err
+	}
+
+	if f.EntityID, err = queryIntPtr(c, "entity_id"); err != nil {
+		return f, err
+	}
+
+	if v := c.Query("action"); v != "" {
+		a := models.AuditAction(v)
+		if !slices.Contains(models.AuditActions, a) {
+			return f, fmt.Errorf("query param action: %s is not one of %v", v, models.AuditActions)
+		}
+		f.Action = &a
+	}
+
+	if v := c.Query("entity_type"); v != "" {
+		e := models.AuditEntity(v)
+		if !slices.Contains(models.AuditEntities, e) {
+			return f, fmt.Errorf("query param entity_type: %s is not one of %v", v, models.AuditEntities)
+		}
+		f.EntityType = &e
+	}
+
+	for param, dst := range map[string]**time.Time{"since": &f.Since, "until": &f.Until} {
+		v := c.Query(param)
+		if v == "" {
+			continue
+		}
+
+		t, err := parseQueryTime(v)
+		if err != nil {
+			return f, fmt.Errorf("query param %s: %s is not a valid RFC3339 time or date", param, v)
+		}
+		*dst = &t
+	}
+
+	limit, err := queryIntPtr(c, "limit")
+	if err != nil {
+		return f, err
+	}
+
+	if limit != nil {
+		f.Limit = *limit
+	}
+
+	return f, nil
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/service/botcmd"
+	"github.com/thecoretg/ticketbot/pkg/webex"
+)
+
+type BotHandler struct {
+	Service *botcmd.Service
+}
+
+func NewBotHandler(svc *botcmd.Service) *BotHandler {
+	return &BotHandler{Service: svc}
+}
+
+func (h *BotHandler) HandleMessage(c *gin.Context) {
+	p := &webex.MessageHookPayload{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+
+	ctx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		if err := h.Service.HandleMessage(ctx, p); err != nil {
+			slog.Error("handling webex bot message", "message_id", p.Data.ID, "error", err.Error())
+		}
+	}()
+
+	resultJSON(c, "message payload received")
+}
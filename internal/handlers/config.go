@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -35,6 +36,10 @@ func (h *ConfigHandler) Update(c *gin.Context) {
 
 	cfg, err := h.Service.Update(c.Request.Context(), p)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidConfig) {
+			badRequestError(c, err)
+			return
+		}
 		internalServerError(c, fmt.Errorf("updating config: %w", err))
 		return
 	}
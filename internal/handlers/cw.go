@@ -2,6 +2,10 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/thecoretg/ticketbot/internal/models"
@@ -36,6 +40,66 @@ func (h *CWHandler) ListMembers(c *gin.Context) {
 	outputJSON(c, m)
 }
 
+func (h *CWHandler) ListCompanies(c *gin.Context) {
+	co, err := h.Service.ListCompanies(c.Request.Context(), c.Query("name"))
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, co)
+}
+
+func (h *CWHandler) GetCompany(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	co, err := h.Service.GetCompany(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrCompanyNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, co)
+}
+
+func (h *CWHandler) ListContacts(c *gin.Context) {
+	ct, err := h.Service.ListContacts(c.Request.Context(), c.Query("name"))
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, ct)
+}
+
+func (h *CWHandler) GetContact(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	ct, err := h.Service.GetContact(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrContactNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, ct)
+}
+
 func (h *CWHandler) GetBoard(c *gin.Context) {
 	id, err := convertID(c)
 	if err != nil {
@@ -55,3 +119,145 @@ func (h *CWHandler) GetBoard(c *gin.Context) {
 
 	outputJSON(c, b)
 }
+
+func (h *CWHandler) ListTickets(c *gin.Context) {
+	f, err := ticketFilterFromQuery(c)
+	if err != nil {
+		badRequestError(c, err)
+		return
+	}
+
+	t, next, err := h.Service.QueryTickets(c.Request.Context(), f)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	if next != 0 {
+		q := c.Request.URL.Query()
+		q.Set("cursor", strconv.Itoa(next))
+		path := strings.TrimPrefix(c.Request.URL.Path, "/")
+		c.Header("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, path, q.Encode()))
+	}
+
+	outputJSON(c, t)
+}
+
+func (h *CWHandler) GetTicket(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	t, err := h.Service.GetFullTicket(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrTicketNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, t)
+}
+
+func ticketFilterFromQuery(c *gin.Context) (models.TicketFilter, error) {
+	var (
+		f   models.TicketFilter
+		err error
+	)
+
+	ptrs := map[string]**int{
+		"board_id":   &f.BoardID,
+		"status_id":  &f.StatusID,
+		"owner_id":   &f.OwnerID,
+		"company_id": &f.CompanyID,
+	}
+
+	for param, dst := range ptrs {
+		if *dst, err = queryIntPtr(c, param); err != nil {
+			return f, err
+		}
+	}
+
+	if v := c.Query("updated_since"); v != "" {
+		t, err := parseQueryTime(v)
+		if err != nil {
+			return f, fmt.Errorf("query param updated_since: %s is not a valid RFC3339 time or date", v)
+		}
+		f.UpdatedSince = &t
+	}
+
+	switch v := c.Query("state"); v {
+	case "":
+	case "open", "closed":
+		closed := v == "closed"
+		f.Closed = &closed
+	default:
+		return f, fmt.Errorf("query param state: %s is not one of open, closed", v)
+	}
+
+	for param, dst := range map[string]*int{"cursor": &f.Cursor, "limit": &f.Limit} {
+		i, err := queryIntPtr(c, param)
+		if err != nil {
+			return f, err
+		}
+		if i != nil {
+			*dst = *i
+		}
+	}
+
+	return f, nil
+}
+
+func queryIntPtr(c *gin.Context, param string) (*int, error) {
+	v := c.Query(param)
+	if v == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("query param %s: %s is not a valid integer", param, v)
+	}
+
+	return &i, nil
+}
+
+func parseQueryTime(v string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, v)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return t.UTC(), nil
+}
+
+func (h *CWHandler) SearchTickets(c *gin.Context) {
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			badQueryIntError(c, "limit", l)
+			return
+		}
+	}
+
+	r, err := h.Service.SearchTickets(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		if errors.Is(err, cwsvc.ErrEmptySearch) {
+			badRequestError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, r)
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/user"
+)
+
+type LoginHandler struct {
+	Service *user.Service
+}
+
+func NewLoginHandler(svc *user.Service) *LoginHandler {
+	return &LoginHandler{Service: svc}
+}
+
+// loginPageData fills the browser page for device logins. Only one of the form, the confirm
+// link, or the message is shown.
+type loginPageData struct {
+	Title        string
+	Message      string
+	ShowForm     bool
+	UserCode     string
+	AuthorizeURL string
+}
+
+var loginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Ticketbot - {{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 32em; margin: 4em auto; text-align: center; }
+code { font-size: 2em; letter-spacing: 0.1em; }
+a.button, button { display: inline-block; padding: 0.6em 1.2em; background: #222; color: #fff; border: 0; text-decoration: none; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{if .ShowForm}}
+<form method="get">
+<p>Enter the code shown in your terminal.</p>
+<p><input name="user_code" autocomplete="off" autofocus></p>
+<button type="submit">Continue</button>
+</form>
+{{else if .AuthorizeURL}}
+<p>Check that this code matches the one in your terminal.</p>
+<p><code>{{.UserCode}}</code></p>
+<p>If it doesn't, close this page.</p>
+<a class="button" href="{{.AuthorizeURL}}">Sign in</a>
+{{else}}
+<p>{{.Message}}</p>
+{{end}}
+</body>
+</html>
+`))
+
+func renderLoginPage(c *gin.Context, status int, d loginPageData) {
+	c.Status(status)
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	if err := loginPage.Execute(c.Writer, d); err != nil {
+		slog.Error("rendering login page", "error", err.Error())
+	}
+}
+
+// StartDeviceLogin is called by the CLI to begin a login.
+func (h *LoginHandler) StartDeviceLogin(c *gin.Context) {
+	d, err := h.Service.StartDeviceLogin(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, models.ErrLoginNotConfigured) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, d)
+}
+
+// DevicePage is where the user confirms the code from their terminal before signing in.
+func (h *LoginHandler) DevicePage(c *gin.Context) {
+	code := c.Query("user_code")
+	if code == "" {
+		renderLoginPage(c, http.StatusOK, loginPageData{Title: "Log In", ShowForm: true})
+		return
+	}
+
+	d, err := h.Service.PendingDeviceLogin(c.Request.Context(), code)
+	if err != nil {
+		h.renderLoginError(c, err)
+		return
+	}
+
+	renderLoginPage(c, http.StatusOK, loginPageData{
+		Title:        "Log In",
+		UserCode:     d.UserCode,
+		AuthorizeURL: "device/authorize?user_code=" + url.QueryEscape(d.UserCode),
+	})
+}
+
+// AuthorizeDevice sends the browser to the identity provider.
+func (h *LoginHandler) AuthorizeDevice(c *gin.Context) {
+	u, err := h.Service.DeviceLoginAuthURL(c.Request.Context(), c.Query("user_code"))
+	if err != nil {
+		h.renderLoginError(c, err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, u)
+}
+
+// Callback is where the identity provider returns the browser after sign in.
+func (h *LoginHandler) Callback(c *gin.Context) {
+	state := c.Query("state")
+	if e := c.Query("error"); e != "" {
+		slog.Warn("login: provider returned error", "error", e, "description", c.Query("error_description"))
+		h.Service.DenyDeviceLogin(c.Request.Context(), state, e)
+		renderLoginPage(c, http.StatusBadRequest, loginPageData{Title: "Login Failed", Message: "Sign in was cancelled or failed. Run the login command again to retry."})
+		return
+	}
+
+	u, err := h.Service.CompleteDeviceLogin(c.Request.Context(), state, c.Query("code"))
+	if err != nil {
+		h.renderLoginError(c, err)
+		return
+	}
+
+	renderLoginPage(c, http.StatusOK, loginPageData{
+		Title:   "Logged In",
+		Message: "You're signed in as " + u.EmailAddress + ". You can close this page and return to your terminal.",
+	})
+}
+
+func (h *LoginHandler) renderLoginError(c *gin.Context, err error) {
+	var noUser user.ErrNoUserForEmail
+	switch {
+	case errors.Is(err, models.ErrLoginNotConfigured):
+		renderLoginPage(c, http.StatusNotFound, loginPageData{Title: "Login Unavailable", Message: err.Error()})
+	case errors.Is(err, models.ErrDeviceLoginNotFound), errors.Is(err, models.ErrLoginExpired):
+		renderLoginPage(c, http.StatusNotFound, loginPageData{Title: "Code Not Found", Message: "The code is invalid, expired, or already used. Run the login command again for a new one."})
+	case errors.As(err, &noUser):
+		renderLoginPage(c, http.StatusForbidden, loginPageData{Title: "Login Denied", Message: err.Error()})
+	default:
+		slog.Error("login: completing device login", "error", err.Error())
+		renderLoginPage(c, http.StatusInternalServerError, loginPageData{Title: "Login Failed", Message: "Something went wrong signing you in. Check the server logs."})
+	}
+}
+
+// PollDeviceLogin is polled by the CLI until the user has signed in. Errors use the RFC 8628 codes,
+// which are also their messages.
+func (h *LoginHandler) PollDeviceLogin(c *gin.Context) {
+	p := &models.DeviceTokenPayload{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+
+	t, err := h.Service.PollDeviceLogin(c.Request.Context(), p.DeviceCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrLoginNotConfigured):
+			notFoundError(c, err)
+		case errors.Is(err, models.ErrAuthorizationPending), errors.Is(err, models.ErrLoginExpired), errors.Is(err, models.ErrLoginDenied):
+			errCodeJSON(c, http.StatusBadRequest, err.Error(), err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+
+	outputJSON(c, t)
+}
+
+func (h *LoginHandler) RefreshSession(c *gin.Context) {
+	p := &models.RefreshTokenPayload{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+
+	t, err := h.Service.RefreshSession(c.Request.Context(), p.RefreshToken)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRefreshToken) {
+			errCodeJSON(c, http.StatusUnauthorized, err.Error(), err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, t)
+}
+
+func (h *LoginHandler) Logout(c *gin.Context) {
+	p := &models.RefreshTokenPayload{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+
+	if err := h.Service.Logout(c.Request.Context(), p.RefreshToken); err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
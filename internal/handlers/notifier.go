@@ -5,17 +5,18 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/middleware"
 	"github.com/thecoretg/ticketbot/internal/models"
 	"github.com/thecoretg/ticketbot/internal/service/notifier"
 )
 
 type NotifierHandler struct {
-	Svc notifier.Service
+	Svc *notifier.Service
 }
 
 func NewNotifierHandler(svc *notifier.Service) *NotifierHandler {
 	return &NotifierHandler{
-		Svc: *svc,
+		Svc: svc,
 	}
 }
 
@@ -70,6 +71,46 @@ func (h *NotifierHandler) AddNotifierRule(c *gin.Context) {
 	outputJSON(c, n)
 }
 
+// UpdateNotifierRule handles both PUT and PATCH.
+func (h *NotifierHandler) UpdateNotifierRule(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	existing, err := h.Svc.GetNotifierRule(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrNotifierNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	p := bindUpdate(c, existing)
+	if p == nil {
+		return
+	}
+	p.ID = id
+
+	n, err := h.Svc.UpdateNotifierRule(c.Request.Context(), p)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrNotifierNotFound):
+			notFoundError(c, err)
+		case errors.Is(err, notifier.ErrNotifierConflict):
+			conflictError(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+
+	outputJSON(c, n)
+}
+
 func (h *NotifierHandler) DeleteNotifierRule(c *gin.Context) {
 	id, err := convertID(c)
 	if err != nil {
@@ -90,7 +131,17 @@ func (h *NotifierHandler) DeleteNotifierRule(c *gin.Context) {
 }
 
 func (h *NotifierHandler) ListForwards(c *gin.Context) {
-	n, err := h.Svc.ListForwardsFull(c.Request.Context())
+	var (
+		n   []*models.NotifierForwardFull
+		err error
+	)
+
+	// self service users only see forwards from their own webex
+	if middleware.UserRole(c) == models.RoleSelfService {
+		n, err = h.Svc.ListOwnForwards(c.Request.Context(), middleware.UserEmail(c))
+	} else {
+		n, err = h.Svc.ListForwardsFull(c.Request.Context())
+	}
 	if err != nil {
 		internalServerError(c, err)
 		return
@@ -116,6 +167,10 @@ func (h *NotifierHandler) GetForward(c *gin.Context) {
 		return
 	}
 
+	if !h.checkForwardOwner(c, f.SourceID) {
+		return
+	}
+
 	outputJSON(c, f)
 }
 
@@ -126,6 +181,10 @@ func (h *NotifierHandler) AddUserForward(c *gin.Context) {
 		return
 	}
 
+	if !h.checkForwardOwner(c, p.SourceID) {
+		return
+	}
+
 	f, err := h.Svc.AddForward(c.Request.Context(), p)
 	if err != nil {
 		internalServerError(c, err)
@@ -135,6 +194,47 @@ func (h *NotifierHandler) AddUserForward(c *gin.Context) {
 	outputJSON(c, f)
 }
 
+// UpdateUserForward handles both PUT and PATCH.
+func (h *NotifierHandler) UpdateUserForward(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	existing, err := h.Svc.GetForward(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrUserForwardNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	p := bindUpdate(c, existing)
+	if p == nil {
+		return
+	}
+	p.ID = id
+
+	if !h.checkForwardOwner(c, existing.SourceID, p.SourceID) {
+		return
+	}
+
+	f, err := h.Svc.UpdateForward(c.Request.Context(), p)
+	if err != nil {
+		if errors.Is(err, models.ErrUserForwardNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, f)
+}
+
 func (h *NotifierHandler) DeleteUserForward(c *gin.Context) {
 	id, err := convertID(c)
 	if err != nil {
@@ -142,6 +242,22 @@ func (h *NotifierHandler) DeleteUserForward(c *gin.Context) {
 		return
 	}
 
+	if middleware.UserRole(c) == models.RoleSelfService {
+		f, err := h.Svc.GetForward(c.Request.Context(), id)
+		if err != nil {
+			if errors.Is(err, models.ErrUserForwardNotFound) {
+				notFoundError(c, err)
+				return
+			}
+			internalServerError(c, err)
+			return
+		}
+
+		if !h.checkForwardOwner(c, f.SourceID) {
+			return
+		}
+	}
+
 	if err := h.Svc.DeleteForward(c.Request.Context(), id); err != nil {
 		if errors.Is(err, models.ErrUserForwardNotFound) {
 			notFoundError(c, err)
@@ -153,3 +269,24 @@ func (h *NotifierHandler) DeleteUserForward(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// checkForwardOwner makes sure self service users only touch forwards from their own webex
+// recipient. Other roles may manage any forward. If it returns false, the response was written.
+func (h *NotifierHandler) checkForwardOwner(c *gin.Context, sourceIDs ...int) bool {
+	if middleware.UserRole(c) != models.RoleSelfService {
+		return true
+	}
+
+	for _, id := range sourceIDs {
+		if err := h.Svc.CheckForwardOwner(c.Request.Context(), middleware.UserEmail(c), id); err != nil {
+			if errors.Is(err, models.ErrForwardNotOwned) {
+				forbiddenError(c, err)
+				return false
+			}
+			internalServerError(c, err)
+			return false
+		}
+	}
+
+	return true
+}
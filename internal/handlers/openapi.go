@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/openapi"
+)
+
+// HandleOpenAPI serves the API document, which is built from the routes as they're registered.
+func HandleOpenAPI(doc *openapi.Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		outputJSON(c, doc)
+	}
+}
+
+// HandleNoRoute gives unknown paths the same error body as every other error.
+func HandleNoRoute(c *gin.Context) {
+	notFoundError(c, fmt.Errorf("no route for %s %s", c.Request.Method, c.Request.URL.Path))
+}
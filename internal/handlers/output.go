@@ -5,18 +5,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/models"
 )
 
 // Output wrappers for consistent json output in handlers
 
-type APIError struct {
-	Message string `json:"error"`
-}
-
-func (e *APIError) Error() string {
-	return e.Message
-}
-
 type ResultOutput struct {
 	Result string `json:"result"`
 }
@@ -42,6 +35,14 @@ func badPayloadError(c *gin.Context, err error) {
 	errJSON(c, http.StatusBadRequest, e)
 }
 
+func badRequestError(c *gin.Context, err error) {
+	errJSON(c, http.StatusBadRequest, err)
+}
+
+func forbiddenError(c *gin.Context, err error) {
+	errJSON(c, http.StatusForbidden, err)
+}
+
 func notFoundError(c *gin.Context, err error) {
 	errJSON(c, http.StatusNotFound, err)
 }
@@ -51,6 +52,15 @@ func badIntError(c *gin.Context) {
 	errJSON(c, http.StatusBadRequest, fmt.Errorf("%s is not a valid integer", s))
 }
 
+func badQueryIntError(c *gin.Context, param, val string) {
+	errJSON(c, http.StatusBadRequest, fmt.Errorf("query param %s: %s is not a valid integer", param, val))
+}
+
 func errJSON(c *gin.Context, code int, err error) {
-	c.JSON(code, APIError{Message: err.Error()})
+	c.JSON(code, models.NewErrorResponse(code, err.Error()))
+}
+
+// errCodeJSON is for routes that document their own error codes instead of using the status.
+func errCodeJSON(c *gin.Context, status int, code string, err error) {
+	c.JSON(status, models.ErrorResponse{Error: models.APIError{Code: code, Message: err.Error()}})
 }
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/scheduler"
+)
+
+type ScheduleHandler struct {
+	Svc *scheduler.Service
+}
+
+func NewScheduleHandler(svc *scheduler.Service) *ScheduleHandler {
+	return &ScheduleHandler{
+		Svc: svc,
+	}
+}
+
+func (h *ScheduleHandler) ListSchedules(c *gin.Context) {
+	s, err := h.Svc.ListSchedules(c.Request.Context())
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, s)
+}
+
+func (h *ScheduleHandler) GetSchedule(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	s, err := h.Svc.GetSchedule(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrSyncScheduleNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, s)
+}
+
+func (h *ScheduleHandler) AddSchedule(c *gin.Context) {
+	p := &models.SyncSchedule{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+
+	s, err := h.Svc.AddSchedule(c.Request.Context(), p)
+	if err != nil {
+		scheduleError(c, err)
+		return
+	}
+
+	outputJSON(c, s)
+}
+
+func (h *ScheduleHandler) UpdateSchedule(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	p := &models.SyncSchedule{}
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+	p.ID = id
+
+	s, err := h.Svc.UpdateSchedule(c.Request.Context(), p)
+	if err != nil {
+		scheduleError(c, err)
+		return
+	}
+
+	outputJSON(c, s)
+}
+
+func (h *ScheduleHandler) DeleteSchedule(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	if err := h.Svc.DeleteSchedule(c.Request.Context(), id); err != nil {
+		if errors.Is(err, models.ErrSyncScheduleNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func scheduleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, scheduler.ErrInvalidSchedule):
+		badRequestError(c, err)
+	case errors.Is(err, scheduler.ErrScheduleConflict):
+		conflictError(c, err)
+	case errors.Is(err, models.ErrSyncScheduleNotFound):
+		notFoundError(c, err)
+	default:
+		internalServerError(c, err)
+	}
+}
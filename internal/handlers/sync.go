@@ -1,8 +1,8 @@
 package handlers
 
 import (
-	"context"
-	"log/slog"
+	"errors"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/thecoretg/ticketbot/internal/models"
@@ -27,12 +27,55 @@ func (h *SyncHandler) HandleSync(c *gin.Context) {
 		return
 	}
 
-	ctx := context.WithoutCancel(c.Request.Context())
-	go func() {
-		if err := h.Svc.Sync(ctx, p); err != nil {
-			slog.Error("syncing", "error", err.Error())
+	run, err := h.Svc.StartSync(c.Request.Context(), p)
+	if err != nil {
+		if errors.Is(err, syncsvc.ErrInvalidPayload) {
+			badRequestError(c, err)
+			return
 		}
-	}()
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, run)
+}
+
+func (h *SyncHandler) ListRuns(c *gin.Context) {
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			badQueryIntError(c, "limit", l)
+			return
+		}
+	}
+
+	r, err := h.Svc.ListRuns(c.Request.Context(), limit)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, r)
+}
+
+func (h *SyncHandler) GetRun(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	r, err := h.Svc.GetRun(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrSyncRunNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
 
-	resultJSON(c, "sync started")
+	outputJSON(c, r)
 }
@@ -50,3 +50,30 @@ func (h *TicketbotHandler) deleteTicket(ctx context.Context, id int) {
 		slog.Error("soft deleting ticket from webhook", "ticket_id", id, "error", err.Error())
 	}
 }
+
+func (h *TicketbotHandler) ProcessScheduleEntry(c *gin.Context) {
+	w := &psa.WebhookPayload{}
+	if err := c.ShouldBindJSON(w); err != nil {
+		badPayloadError(c, err)
+		return
+	}
+	id := w.ID
+
+	ctx := context.WithoutCancel(c.Request.Context())
+	switch w.Action {
+	case "added", "updated":
+		go h.processScheduleEntry(ctx, id)
+	case "deleted":
+		slog.Debug("ignoring deleted schedule entry", "schedule_entry_id", id)
+	default:
+		slog.Warn("unknown schedule webhook action", "action", w.Action, "schedule_entry_id", id)
+	}
+
+	resultJSON(c, "schedule payload received")
+}
+
+func (h *TicketbotHandler) processScheduleEntry(ctx context.Context, id int) {
+	if err := h.Service.ProcessScheduleEntry(ctx, id); err != nil {
+		slog.Error("processing schedule webhook", "schedule_entry_id", id, "error", err.Error())
+	}
+}
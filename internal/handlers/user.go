@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/thecoretg/ticketbot/internal/models"
@@ -74,16 +75,64 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	u, err := h.Service.InsertUser(c.Request.Context(), p.EmailAddress)
+	u, err := h.Service.InsertUser(c.Request.Context(), p.EmailAddress, p.Role)
 	if err != nil {
 		if errors.Is(err, user.ErrUserAlreadyExists{Email: p.EmailAddress}) {
 			conflictError(c, err)
 			return
 		}
+		if errors.As(err, &user.ErrInvalidRole{}) {
+			badRequestError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	outputJSON(c, u)
+}
+
+// UpdateUser handles both PUT and PATCH.
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	existing, err := h.Service.GetUser(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			notFoundError(c, err)
+			return
+		}
 		internalServerError(c, err)
 		return
 	}
 
+	p := bindUpdate(c, existing)
+	if p == nil {
+		return
+	}
+	p.ID = id
+
+	u, err := h.Service.UpdateUser(c.Request.Context(), p, c.GetInt("user_id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrAPIUserNotFound):
+			notFoundError(c, err)
+		case errors.As(err, &user.ErrUserAlreadyExists{}):
+			conflictError(c, err)
+		case errors.As(err, &user.ErrInvalidRole{}):
+			badRequestError(c, err)
+		case errors.Is(err, user.ErrCannotChangeOwnRole{}):
+			forbiddenError(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+
 	outputJSON(c, u)
 }
 
@@ -106,7 +155,7 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 			return
 		}
 		if errors.Is(err, user.ErrCannotDeleteSelf{}) {
-			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			forbiddenError(c, err)
 			return
 		}
 		internalServerError(c, err)
@@ -157,18 +206,56 @@ func (h *UserHandler) AddAPIKey(c *gin.Context) {
 		return
 	}
 
-	k, err := h.Service.AddAPIKey(c.Request.Context(), p.Email)
+	k, err := h.Service.AddAPIKey(c.Request.Context(), p)
 	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		if errors.As(err, &user.ErrInvalidScope{}) || errors.Is(err, user.ErrExpiryInPast{}) {
+			badRequestError(c, err)
+			return
+		}
 		internalServerError(c, err)
 		return
 	}
 
-	o := models.CreateAPIKeyResponse{
-		Email: p.Email,
-		Key:   k,
+	outputJSON(c, k)
+}
+
+func (h *UserHandler) RotateAPIKey(c *gin.Context) {
+	id, err := convertID(c)
+	if err != nil {
+		badIntError(c)
+		return
+	}
+
+	p := &models.RotateAPIKeyPayload{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(p); err != nil {
+			badPayloadError(c, err)
+			return
+		}
+	}
+
+	if p.GraceHours < 0 {
+		badRequestError(c, errors.New("grace hours cannot be negative"))
+		return
+	}
+
+	r, err := h.Service.RotateAPIKey(c.Request.Context(), id, time.Duration(p.GraceHours)*time.Hour)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIKeyNotFound) {
+			notFoundError(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
 	}
 
-	outputJSON(c, o)
+	slog.Info("api key rotated", "authenticated_user_id", c.GetInt("user_id"),
+		"old_key_id", r.OldKeyID, "new_key_id", r.ID, "old_key_expires_on", r.OldKeyExpiresOn)
+	outputJSON(c, r)
 }
 
 func (h *UserHandler) DeleteAPIKey(c *gin.Context) {
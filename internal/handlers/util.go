@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -10,3 +11,20 @@ func convertID(c *gin.Context) (int, error) {
 	s := c.Param("id")
 	return strconv.Atoi(s)
 }
+
+// bindUpdate binds the body of an update request. A PUT replaces the whole item, so it starts
+// empty; a PATCH starts from the existing item and only changes the fields in the body. If it
+// returns nil, the response was written.
+func bindUpdate[T any](c *gin.Context, existing *T) *T {
+	p := new(T)
+	if c.Request.Method == http.MethodPatch {
+		*p = *existing
+	}
+
+	if err := c.ShouldBindJSON(p); err != nil {
+		badPayloadError(c, err)
+		return nil
+	}
+
+	return p
+}
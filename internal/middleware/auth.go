@@ -1,70 +1,95 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/thecoretg/ticketbot/internal/models"
-	"golang.org/x/crypto/bcrypt"
 )
 
-func APIKeyAuth(r models.APIKeyRepository) gin.HandlerFunc {
+// KeyAuthenticator finds the user an API key or login session belongs to, and records when keys
+// are used.
+type KeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*models.APIUser, *models.APIKey, error)
+	AuthenticateSession(ctx context.Context, token string) (*models.APIUser, error)
+	RecordKeyUse(ctx context.Context, keyID int, ip string) error
+}
+
+func APIKeyAuth(a KeyAuthenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		auth := c.GetHeader("Authorization")
 		if !strings.HasPrefix(auth, "Bearer ") {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing or invalid authorization header"})
+			abort(c, http.StatusUnauthorized, "missing or invalid authorization header")
 			return
 		}
 
 		key := strings.TrimPrefix(auth, "Bearer ")
 		if key == "" {
 			slog.Warn("auth middleware: got empty key in request header")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "empty api key"})
+			abort(c, http.StatusUnauthorized, "empty api key")
 			return
 		}
-		hash, err := hashKey(key)
-		if err == nil {
-			slog.Debug("auth middleware: got key from request", "hash", hash)
-		}
 
-		keys, err := r.List(c.Request.Context())
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "db error"})
+		if models.IsSessionToken(key) {
+			sessionAuth(c, a, key)
 			return
 		}
 
-		if len(keys) > 0 {
-			slog.Debug("auth middleware: got keys from store", "total", len(keys))
-			for _, k := range keys {
-				slog.Debug("auth middleware: key from store", "user_id", k.UserID, "hash", k.KeyHash)
+		u, k, err := a.Authenticate(c.Request.Context(), key)
+		if err != nil {
+			if errors.Is(err, models.ErrInvalidAPIKey) {
+				abort(c, http.StatusUnauthorized, "invalid api key")
+				return
 			}
-		} else {
-			slog.Debug("auth middleware: got no keys from store")
+
+			slog.Error("auth middleware: authenticating key", "error", err.Error())
+			abort(c, http.StatusInternalServerError, "db error")
+			return
 		}
 
-		var userID int
-		found := false
-		for _, k := range keys {
-			if bcrypt.CompareHashAndPassword(k.KeyHash, []byte(key)) == nil {
-				userID = k.UserID
-			slog.Info("authenticated user", "user_id", userID)
-				found = true
-				break
-			}
+		if err := a.RecordKeyUse(c.Request.Context(), k.ID, c.ClientIP()); err != nil {
+			// not worth failing the request over
+			slog.Warn("auth middleware: recording key use", "key_id", k.ID, "error", err.Error())
 		}
 
-		if !found {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid api key"})
+		slog.Debug("authenticated user", "user_id", u.ID, "key_id", k.ID)
+		c.Set("user_id", u.ID)
+		c.Set("user_role", u.Role)
+		c.Set("user_email", u.EmailAddress)
+		c.Set("api_key", k)
+		c.Request = c.Request.WithContext(models.WithActor(c.Request.Context(), u.ID))
+		c.Next()
+	}
+}
+
+// sessionAuth authenticates a request made with a login session token. Sessions aren't limited
+// by scopes, so no API key is set.
+func sessionAuth(c *gin.Context, a KeyAuthenticator, token string) {
+	u, err := a.AuthenticateSession(c.Request.Context(), token)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidAPIKey) {
+			abort(c, http.StatusUnauthorized, "invalid or expired session; log in again")
 			return
 		}
 
-		c.Set("user_id", userID)
-		c.Next()
+		slog.Error("auth middleware: authenticating session", "error", err.Error())
+		abort(c, http.StatusInternalServerError, "db error")
+		return
 	}
+
+	slog.Debug("authenticated user with session", "user_id", u.ID)
+	c.Set("user_id", u.ID)
+	c.Set("user_role", u.Role)
+	c.Set("user_email", u.EmailAddress)
+	c.Request = c.Request.WithContext(models.WithActor(c.Request.Context(), u.ID))
+	c.Next()
 }
 
-func hashKey(key string) ([]byte, error) {
-	return bcrypt.GenerateFromPassword([]byte(key), bcrypt.DefaultCost)
+// abort ends the request with the API's error body.
+func abort(c *gin.Context, status int, msg string) {
+	c.AbortWithStatusJSON(status, models.NewErrorResponse(status, msg))
 }
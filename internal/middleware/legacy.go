@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LegacyFields maps route prefixes, such as "/notifiers/forwards", to the JSON fields renamed on
+// them, from old name to new.
+type LegacyFields map[string]map[string]string
+
+// LegacyAlias is for the deprecated paths the API had before it moved under prefix. Responses
+// get a Deprecation header and a Link to the same path under prefix, and the first use of each
+// route is logged. On routes with renamed fields, request bodies may use the old names, and
+// responses have the old names alongside the new ones, so clients that haven't moved keep
+// working.
+func LegacyAlias(prefix string, fields LegacyFields) gin.HandlerFunc {
+	var logged sync.Map
+
+	return func(c *gin.Context) {
+		successor := prefix + c.Request.URL.Path
+		c.Header("Deprecation", "true")
+		c.Header("Link", "<"+successor+`>; rel="successor-version"`)
+
+		route := c.Request.Method + " " + c.FullPath()
+		if _, seen := logged.LoadOrStore(route, struct{}{}); !seen {
+			slog.Warn("legacy api path used; clients should move to the versioned path",
+				"route", route,
+				"successor", prefix+c.FullPath(),
+				"user_agent", c.Request.UserAgent(),
+			)
+		}
+
+		renames := fields.forRoute(c.FullPath())
+		if len(renames) == 0 {
+			c.Next()
+			return
+		}
+
+		if c.Request.Body != nil {
+			b, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				abort(c, http.StatusBadRequest, "reading request body")
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(renameFields(b, renames, true)))
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.buf.Len() == 0 {
+			c.Writer.WriteHeaderNow()
+			return
+		}
+
+		body := w.buf.Bytes()
+		if strings.HasPrefix(c.Writer.Header().Get("Content-Type"), "application/json") {
+			body = renameFields(body, renames, false)
+		}
+
+		if _, err := c.Writer.Write(body); err != nil {
+			slog.Error("writing legacy response", "route", route, "error", err.Error())
+		}
+	}
+}
+
+func (f LegacyFields) forRoute(route string) map[string]string {
+	for prefix, renames := range f {
+		if route == prefix || strings.HasPrefix(route, prefix+"/") {
+			return renames
+		}
+	}
+
+	return nil
+}
+
+// renameFields translates the fields of a JSON object, or of each object in a JSON array. Going
+// in, old names are replaced by new ones unless the new one is also given. Going out, the old
+// names are added next to the new ones. Bodies that aren't JSON objects are returned unchanged,
+// for the handler to reject as usual.
+func renameFields(body []byte, renames map[string]string, in bool) []byte {
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return body
+	}
+
+	var objs []map[string]any
+	switch v := v.(type) {
+	case map[string]any:
+		objs = append(objs, v)
+	case []any:
+		for _, e := range v {
+			if m, ok := e.(map[string]any); ok {
+				objs = append(objs, m)
+			}
+		}
+	}
+
+	if len(objs) == 0 {
+		return body
+	}
+
+	for _, m := range objs {
+		for oldName, newName := range renames {
+			if in {
+				if val, ok := m[oldName]; ok {
+					if _, ok := m[newName]; !ok {
+						m[newName] = val
+					}
+					delete(m, oldName)
+				}
+				continue
+			}
+
+			if val, ok := m[newName]; ok {
+				if _, ok := m[oldName]; !ok {
+					m[oldName] = val
+				}
+			}
+		}
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return out
+}
+
+// bufferedWriter holds the response body so it can be changed before it's sent.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.buf.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.buf.WriteString(s)
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testForward struct {
+	ID       int `json:"id"`
+	SourceID int `json:"source_id"`
+	DestID   int `json:"destination_id"`
+}
+
+func legacyEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	g := gin.New()
+	legacy := g.Group("", LegacyAlias("/v1", LegacyFields{
+		"/forwards": {"user_email": "source_id", "dest_email": "destination_id"},
+	}))
+
+	legacy.POST("forwards", func(c *gin.Context) {
+		var f testForward
+		if err := c.ShouldBindJSON(&f); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		f.ID = 7
+		c.JSON(http.StatusCreated, f)
+	})
+	legacy.GET("forwards", func(c *gin.Context) {
+		c.JSON(http.StatusOK, []testForward{{ID: 1, SourceID: 2, DestID: 3}})
+	})
+	legacy.DELETE("forwards/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	legacy.GET("other", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"source_id": 1})
+	})
+
+	return g
+}
+
+func serve(g *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	g.ServeHTTP(w, r)
+	return w
+}
+
+func TestLegacyAliasHeaders(t *testing.T) {
+	w := serve(legacyEngine(), http.MethodDelete, "/forwards/3", "")
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	if got := w.Header().Get("Deprecation"); got != "true" {
+		t.Errorf("Deprecation = %q, want true", got)
+	}
+
+	if got, want := w.Header().Get("Link"), `</v1/forwards/3>; rel="successor-version"`; got != want {
+		t.Errorf("Link = %q, want %q", got, want)
+	}
+}
+
+func TestLegacyAliasOldFieldNames(t *testing.T) {
+	g := legacyEngine()
+
+	tests := []struct {
+		name string
+		body string
+		want testForward
+	}{
+		{name: "old names", body: `{"user_email": 2, "dest_email": 3}`, want: testForward{ID: 7, SourceID: 2, DestID: 3}},
+		{name: "new names", body: `{"source_id": 2, "destination_id": 3}`, want: testForward{ID: 7, SourceID: 2, DestID: 3}},
+		{name: "new names win", body: `{"user_email": 9, "source_id": 2, "destination_id": 3}`, want: testForward{ID: 7, SourceID: 2, DestID: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(g, http.MethodPost, "/forwards", tt.body)
+			if w.Code != http.StatusCreated {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body)
+			}
+
+			var got map[string]int
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+
+			want := map[string]int{
+				"id":             tt.want.ID,
+				"source_id":      tt.want.SourceID,
+				"destination_id": tt.want.DestID,
+				"user_email":     tt.want.SourceID,
+				"dest_email":     tt.want.DestID,
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("%s = %d, want %d (body %s)", k, got[k], v, w.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestLegacyAliasRenamesLists(t *testing.T) {
+	w := serve(legacyEngine(), http.MethodGet, "/forwards", "")
+
+	var got []map[string]int
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 || got[0]["user_email"] != 2 || got[0]["source_id"] != 2 {
+		t.Errorf("got %s, want the old and new names", w.Body)
+	}
+}
+
+func TestLegacyAliasOtherRoutesUnchanged(t *testing.T) {
+	w := serve(legacyEngine(), http.MethodGet, "/other", "")
+
+	if got, want := strings.TrimSpace(w.Body.String()), `{"source_id":1}`; got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+var (
+	// AllRoles is every role, for routes any authenticated user may use.
+	AllRoles = models.Roles
+	// OperatorRoles may manage notifier rules and forwards.
+	OperatorRoles = []models.Role{models.RoleAdmin, models.RoleOperator}
+	// ForwardRoles may manage forwards, though self service users only their own.
+	ForwardRoles = []models.Role{models.RoleAdmin, models.RoleOperator, models.RoleSelfService}
+	// AdminRoles may do anything.
+	AdminRoles = []models.Role{models.RoleAdmin}
+)
+
+// RequireRole aborts with 403 unless the authenticated user has one of the roles. It must run
+// after APIKeyAuth.
+func RequireRole(roles ...models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !hasRole(c, roles) {
+			forbid(c)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RoleAccess lets users with a read role make GET requests, and users with a write role make
+// any request.
+func RoleAccess(read, write []models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		roles := write
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			roles = read
+		}
+
+		if !hasRole(c, roles) {
+			forbid(c)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ScopeAccess requires the API key to have the read scope for GET requests, and the write scope
+// for any other request. Keys without scopes and login sessions are unrestricted.
+func ScopeAccess(read, write string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope := write
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			scope = read
+		}
+
+		k := APIKey(c)
+		if k != nil && !k.HasScope(scope) {
+			slog.Warn("auth middleware: api key lacks scope for route",
+				"user_id", c.GetInt("user_id"), "scope", scope, "method", c.Request.Method, "path", c.FullPath())
+			abort(c, http.StatusForbidden, "api key is missing scope "+scope)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// APIKey returns the API key the request was authenticated with, set by APIKeyAuth. It is nil
+// for requests made with a login session.
+func APIKey(c *gin.Context) *models.APIKey {
+	k, _ := c.Get("api_key")
+	key, _ := k.(*models.APIKey)
+	return key
+}
+
+// UserRole returns the role of the authenticated user, set by APIKeyAuth.
+func UserRole(c *gin.Context) models.Role {
+	r, _ := c.Get("user_role")
+	role, _ := r.(models.Role)
+	return role
+}
+
+// UserEmail returns the email address of the authenticated user, set by APIKeyAuth.
+func UserEmail(c *gin.Context) string {
+	return c.GetString("user_email")
+}
+
+func hasRole(c *gin.Context, roles []models.Role) bool {
+	return slices.Contains(roles, UserRole(c))
+}
+
+func forbid(c *gin.Context) {
+	slog.Warn("auth middleware: user lacks role for route",
+		"user_id", c.GetInt("user_id"), "role", UserRole(c), "method", c.Request.Method, "path", c.FullPath())
+	abort(c, http.StatusForbidden, "your role does not allow this action")
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/thecoretg/ticketbot/pkg/webex"
+)
+
+// Fixtures are the rooms and people the fake Webex serves. In a file they are JSON in the same
+// shape as Webex API objects:
+//
+//	{
+//	  "rooms": [{"id": "room-1", "title": "Help Desk", "type": "group"}],
+//	  "people": [{"id": "person-1", "emails": ["jdoe@example.com"], "displayName": "Jane Doe"}]
+//	}
+type Fixtures struct {
+	Rooms  []webex.Room   `json:"rooms"`
+	People []webex.Person `json:"people"`
+}
+
+// LoadFixtures reads fixtures from a JSON file.
+func LoadFixtures(path string) (*Fixtures, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading fixtures file: %w", err)
+	}
+
+	f := &Fixtures{}
+	if err := json.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("unmarshaling fixtures: %w", err)
+	}
+
+	return f, nil
+}
@@ -1,39 +1,182 @@
+// Package mock provides an offline fake of the Webex API for running the server without
+// sending real messages.
 package mock
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/thecoretg/ticketbot/pkg/webex"
 )
 
-// This isn't ideal at all, but I need it short term just to get this up and running.
-// When I get time I will make a mock setup that doesn't actually call the Webex API.
-
+// WebexClient is an in-memory Webex that records posted messages instead of sending them, and
+// serves rooms and people from fixtures. It implements models.MessageSender, and Handler serves
+// the same data over HTTP so a webex.Client can be pointed at it.
 type WebexClient struct {
-	// real webex client used for listing rooms, but not posting messages
-	webexClient *webex.Client
+	mu       sync.Mutex
+	rooms    []webex.Room
+	people   []webex.Person
+	messages []SentMessage
+	webhooks []webex.Webhook
+	actions  map[string]webex.AttachmentAction
+	nextID   int
 }
 
-func NewWebexClient(token string) *WebexClient {
+// SentMessage is a message posted to the fake, with who it would have gone to.
+type SentMessage struct {
+	ID            string    `json:"id"`
+	RecipientType string    `json:"recipient_type"`
+	RecipientName string    `json:"recipient_name"`
+	RoomID        string    `json:"room_id,omitempty"`
+	ToPersonEmail string    `json:"to_person_email,omitempty"`
+	Markdown      string    `json:"markdown,omitempty"`
+	Text          string    `json:"text,omitempty"`
+	SentOn        time.Time `json:"sent_on"`
+}
+
+// NewWebexClient creates a fake Webex serving the rooms and people in f.
+func NewWebexClient(f *Fixtures) *WebexClient {
+	if f == nil {
+		f = &Fixtures{}
+	}
+
 	return &WebexClient{
-		webexClient: webex.NewClient(token),
+		rooms:   slices.Clone(f.Rooms),
+		people:  slices.Clone(f.People),
+		actions: make(map[string]webex.AttachmentAction),
+		nextID:  1,
 	}
 }
 
-func (w *WebexClient) GetMessage(id string, params map[string]string) (*webex.Message, error) {
-	return &webex.Message{}, nil
+func (w *WebexClient) GetMessageCtx(ctx context.Context, id string, params map[string]string) (*webex.Message, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, m := range w.messages {
+		if m.ID == id {
+			wm := m.webexMessage()
+			return &wm, nil
+		}
+	}
+
+	return nil, webex.ErrNotFound
 }
 
-func (w *WebexClient) GetAttachmentAction(messageID string) (*webex.AttachmentAction, error) {
-	return &webex.AttachmentAction{}, nil
+func (w *WebexClient) GetAttachmentActionCtx(ctx context.Context, messageID string) (*webex.AttachmentAction, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	a, ok := w.actions[messageID]
+	if !ok {
+		return nil, webex.ErrNotFound
+	}
+
+	return &a, nil
 }
 
-func (w *WebexClient) PostMessage(message *webex.Message) (*webex.Message, error) {
-	return message, nil
+// AddAttachmentAction stores an attachment action, such as a card submission, for
+// GetAttachmentActionCtx to return.
+func (w *WebexClient) AddAttachmentAction(a webex.AttachmentAction) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.actions[a.ID] = a
 }
 
-func (w *WebexClient) ListRooms(params map[string]string) ([]webex.Room, error) {
-	return w.webexClient.ListRooms(params)
+func (w *WebexClient) PostMessageCtx(ctx context.Context, message *webex.Message) (*webex.Message, error) {
+	if message.RoomID == "" && message.ToPersonEmail == "" && message.ToPersonID == "" {
+		return nil, fmt.Errorf("error response from Webex API: message has no room or person")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sm := SentMessage{
+		ID:            w.newID("msg"),
+		RecipientType: message.RecipientType,
+		RecipientName: message.RecipientName,
+		RoomID:        message.RoomID,
+		ToPersonEmail: message.ToPersonEmail,
+		Markdown:      message.Markdown,
+		Text:          message.Text,
+		SentOn:        time.Now(),
+	}
+
+	if sm.RecipientType == "" {
+		sm.RecipientType, sm.RecipientName = "room", message.RoomID
+		if message.RoomID == "" {
+			sm.RecipientType, sm.RecipientName = "person", message.ToPersonEmail
+		}
+	}
+
+	w.messages = append(w.messages, sm)
+
+	out := *message
+	out.ID = sm.ID
+	return &out, nil
+}
+
+func (w *WebexClient) ListRoomsCtx(ctx context.Context, params map[string]string) ([]webex.Room, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var rooms []webex.Room
+	for _, r := range w.rooms {
+		if t := params["type"]; t != "" && r.Type != t {
+			continue
+		}
+		rooms = append(rooms, r)
+	}
+
+	return rooms, nil
+}
+
+func (w *WebexClient) ListPeopleCtx(ctx context.Context, email string) ([]webex.Person, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var people []webex.Person
+	for _, p := range w.people {
+		if email == "" || slices.ContainsFunc(p.Emails, func(e string) bool { return strings.EqualFold(e, email) }) {
+			people = append(people, p)
+		}
+	}
+
+	return people, nil
+}
+
+// Messages returns every message posted so far, oldest first.
+func (w *WebexClient) Messages() []SentMessage {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return slices.Clone(w.messages)
+}
+
+// ClearMessages forgets all posted messages.
+func (w *WebexClient) ClearMessages() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = nil
+}
+
+func (m SentMessage) webexMessage() webex.Message {
+	return webex.Message{
+		ID:            m.ID,
+		RoomID:        m.RoomID,
+		Text:          m.Text,
+		Markdown:      m.Markdown,
+		PersonEmail:   m.ToPersonEmail,
+		RecipientType: m.RecipientType,
+		RecipientName: m.RecipientName,
+	}
 }
 
-func (w *WebexClient) ListPeople(email string) ([]webex.Person, error) {
-	return w.webexClient.ListPeople(email)
+// newID returns a unique ID for a new object. The caller must hold the lock.
+func (w *WebexClient) newID(prefix string) string {
+	id := fmt.Sprintf("mock-%s-%d", prefix, w.nextID)
+	w.nextID++
+	return id
 }
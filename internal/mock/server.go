@@ -0,0 +1,211 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/thecoretg/ticketbot/pkg/webex"
+)
+
+// Handler serves the fake over HTTP. The Webex endpoints the server uses are under /v1, so a
+// webex.Client can use it with webex.WithBaseURL, and GET /mock/messages lists what would have
+// been sent. DELETE /mock/messages clears them.
+func (w *WebexClient) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/messages", w.handleListMessages)
+	mux.HandleFunc("POST /v1/messages", w.handlePostMessage)
+	mux.HandleFunc("GET /v1/messages/{id}", w.handleGetMessage)
+	mux.HandleFunc("GET /v1/attachment/actions/{id}", w.handleGetAttachmentAction)
+	mux.HandleFunc("GET /v1/rooms", w.handleListRooms)
+	mux.HandleFunc("GET /v1/people", w.handleListPeople)
+	mux.HandleFunc("GET /v1/webhooks", w.handleListWebhooks)
+	mux.HandleFunc("POST /v1/webhooks", w.handleCreateWebhook)
+	mux.HandleFunc("GET /v1/webhooks/{id}", w.handleGetWebhook)
+	mux.HandleFunc("PUT /v1/webhooks/{id}", w.handlePutWebhook)
+	mux.HandleFunc("DELETE /v1/webhooks/{id}", w.handleDeleteWebhook)
+	mux.HandleFunc("GET /mock/messages", w.handleSentMessages)
+	mux.HandleFunc("DELETE /mock/messages", w.handleClearMessages)
+
+	return mux
+}
+
+func (w *WebexClient) handleListMessages(rw http.ResponseWriter, r *http.Request) {
+	roomID := r.URL.Query().Get("roomId")
+
+	var msgs []webex.Message
+	for _, m := range w.Messages() {
+		if roomID == "" || m.RoomID == roomID {
+			msgs = append(msgs, m.webexMessage())
+		}
+	}
+
+	writeItems(rw, msgs)
+}
+
+func (w *WebexClient) handlePostMessage(rw http.ResponseWriter, r *http.Request) {
+	m := &webex.Message{}
+	if err := json.NewDecoder(r.Body).Decode(m); err != nil {
+		writeError(rw, http.StatusBadRequest, err)
+		return
+	}
+
+	out, err := w.PostMessageCtx(r.Context(), m)
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, out)
+}
+
+func (w *WebexClient) handleGetMessage(rw http.ResponseWriter, r *http.Request) {
+	m, err := w.GetMessageCtx(r.Context(), r.PathValue("id"), nil)
+	if err != nil {
+		writeError(rw, http.StatusNotFound, err)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, m)
+}
+
+func (w *WebexClient) handleGetAttachmentAction(rw http.ResponseWriter, r *http.Request) {
+	a, err := w.GetAttachmentActionCtx(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(rw, http.StatusNotFound, err)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, a)
+}
+
+func (w *WebexClient) handleListRooms(rw http.ResponseWriter, r *http.Request) {
+	rooms, _ := w.ListRoomsCtx(r.Context(), map[string]string{"type": r.URL.Query().Get("type")})
+	writeItems(rw, rooms)
+}
+
+func (w *WebexClient) handleListPeople(rw http.ResponseWriter, r *http.Request) {
+	people, _ := w.ListPeopleCtx(r.Context(), r.URL.Query().Get("email"))
+	writeItems(rw, people)
+}
+
+func (w *WebexClient) handleListWebhooks(rw http.ResponseWriter, _ *http.Request) {
+	w.mu.Lock()
+	hooks := slices.Clone(w.webhooks)
+	w.mu.Unlock()
+
+	writeItems(rw, hooks)
+}
+
+func (w *WebexClient) handleCreateWebhook(rw http.ResponseWriter, r *http.Request) {
+	h := webex.Webhook{}
+	if err := json.NewDecoder(r.Body).Decode(&h); err != nil {
+		writeError(rw, http.StatusBadRequest, err)
+		return
+	}
+
+	w.mu.Lock()
+	h.ID = w.newID("webhook")
+	w.webhooks = append(w.webhooks, h)
+	w.mu.Unlock()
+
+	writeJSON(rw, http.StatusOK, h)
+}
+
+func (w *WebexClient) handleGetWebhook(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	i := w.webhookIndex(r.PathValue("id"))
+	var h webex.Webhook
+	if i >= 0 {
+		h = w.webhooks[i]
+	}
+	w.mu.Unlock()
+
+	if i < 0 {
+		writeError(rw, http.StatusNotFound, webex.ErrNotFound)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, h)
+}
+
+func (w *WebexClient) handlePutWebhook(rw http.ResponseWriter, r *http.Request) {
+	h := webex.Webhook{}
+	if err := json.NewDecoder(r.Body).Decode(&h); err != nil {
+		writeError(rw, http.StatusBadRequest, err)
+		return
+	}
+	h.ID = r.PathValue("id")
+
+	w.mu.Lock()
+	i := w.webhookIndex(h.ID)
+	if i >= 0 {
+		w.webhooks[i] = h
+	}
+	w.mu.Unlock()
+
+	if i < 0 {
+		writeError(rw, http.StatusNotFound, webex.ErrNotFound)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, h)
+}
+
+func (w *WebexClient) handleDeleteWebhook(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	i := w.webhookIndex(r.PathValue("id"))
+	if i >= 0 {
+		w.webhooks = slices.Delete(w.webhooks, i, i+1)
+	}
+	w.mu.Unlock()
+
+	if i < 0 {
+		writeError(rw, http.StatusNotFound, webex.ErrNotFound)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (w *WebexClient) handleSentMessages(rw http.ResponseWriter, _ *http.Request) {
+	msgs := w.Messages()
+	if msgs == nil {
+		msgs = []SentMessage{}
+	}
+
+	writeJSON(rw, http.StatusOK, msgs)
+}
+
+func (w *WebexClient) handleClearMessages(rw http.ResponseWriter, _ *http.Request) {
+	w.ClearMessages()
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// webhookIndex returns the index of the webhook with the ID, or -1. The caller must hold the lock.
+func (w *WebexClient) webhookIndex(id string) int {
+	return slices.IndexFunc(w.webhooks, func(h webex.Webhook) bool { return h.ID == id })
+}
+
+func writeItems[T any](rw http.ResponseWriter, items []T) {
+	if items == nil {
+		items = []T{}
+	}
+
+	writeJSON(rw, http.StatusOK, map[string][]T{"items": items})
+}
+
+func writeJSON(rw http.ResponseWriter, code int, v any) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(code)
+	_ = json.NewEncoder(rw).Encode(v)
+}
+
+func writeError(rw http.ResponseWriter, code int, err error) {
+	if errors.Is(err, webex.ErrNotFound) {
+		code = http.StatusNotFound
+	}
+
+	writeJSON(rw, code, map[string]string{"message": err.Error()})
+}
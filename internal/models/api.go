@@ -3,44 +3,171 @@ package models
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 )
 
-var ErrAPIKeyNotFound = errors.New("api key not found")
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrInvalidAPIKey  = errors.New("invalid api key")
+)
+
+// API key scopes, in the form area:action. A key with no scopes can do anything its user's role
+// allows. A write or run scope also grants read on the same area.
+const (
+	ScopeUsersRead      = "users:read"
+	ScopeUsersWrite     = "users:write"
+	ScopeConfigRead     = "config:read"
+	ScopeConfigWrite    = "config:write"
+	ScopeSyncRead       = "sync:read"
+	ScopeSyncRun        = "sync:run"
+	ScopeCWRead         = "cw:read"
+	ScopeWebexRead      = "webex:read"
+	ScopeNotifiersRead  = "notifiers:read"
+	ScopeNotifiersWrite = "notifiers:write"
+	ScopeForwardsRead   = "forwards:read"
+	ScopeForwardsWrite  = "forwards:write"
+	ScopeMetricsRead    = "metrics:read"
+	ScopeAuditRead      = "audit:read"
+)
+
+var APIKeyScopes = []string{
+	ScopeUsersRead, ScopeUsersWrite,
+	ScopeConfigRead, ScopeConfigWrite,
+	ScopeSyncRead, ScopeSyncRun,
+	ScopeCWRead,
+	ScopeWebexRead,
+	ScopeNotifiersRead, ScopeNotifiersWrite,
+	ScopeForwardsRead, ScopeForwardsWrite,
+	ScopeMetricsRead,
+	ScopeAuditRead,
+}
+
+// scopeFallbacks are scopes that also grant another. Forwards used to be covered by the
+// notifiers scopes, so keys made before they had their own keep working.
+var scopeFallbacks = map[string]string{
+	ScopeForwardsRead:  ScopeNotifiersRead,
+	ScopeForwardsWrite: ScopeNotifiersWrite,
+}
+
+func ValidScope(s string) bool {
+	return slices.Contains(APIKeyScopes, s)
+}
 
 type CreateAPIKeyPayload struct {
-	Email string `json:"email"`
+	Email     string     `json:"email"`
+	Name      *string    `json:"name,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
 }
 
 type CreateAPIKeyResponse struct {
+	ID    int    `json:"id"`
 	Email string `json:"email"`
 	Key   string `json:"key"`
 }
 
+// RotateAPIKeyPayload sets how long the old key keeps working after a rotation. If zero, the
+// default grace period is used.
+type RotateAPIKeyPayload struct {
+	GraceHours int `json:"grace_hours"`
+}
+
+type RotateAPIKeyResponse struct {
+	CreateAPIKeyResponse
+	OldKeyID        int       `json:"old_key_id"`
+	OldKeyExpiresOn time.Time `json:"old_key_expires_on"`
+}
+
 type APIKey struct {
-	ID        int       `json:"id"`
-	UserID    int       `json:"user_id"`
-	KeyHash   []byte    `json:"key_hash"`
-	KeyHint   *string   `json:"key_hint,omitempty"`
-	CreatedOn time.Time `json:"created_on"`
-	UpdatedOn time.Time `json:"updated_on"`
+	ID      int     `json:"id"`
+	UserID  int     `json:"user_id"`
+	KeyHash []byte  `json:"-"`
+	KeyHint *string `json:"key_hint,omitempty"`
+	// KeyID is the public part of the key, used to look it up. Keys created before key IDs
+	// existed have none, and only work until LegacyUntil.
+	KeyID       *string    `json:"key_id,omitempty"`
+	LegacyUntil *time.Time `json:"legacy_until,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	ExpiresOn   *time.Time `json:"expires_on,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP  *string    `json:"last_used_ip,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	UpdatedOn   time.Time  `json:"updated_on"`
+}
+
+// Expired reports whether the key has passed its expiry or legacy grace period.
+func (k *APIKey) Expired(now time.Time) bool {
+	if k.ExpiresOn != nil && !now.Before(*k.ExpiresOn) {
+		return true
+	}
+
+	return k.KeyID == nil && k.LegacyUntil != nil && !now.Before(*k.LegacyUntil)
+}
+
+// HasScope reports whether the key may act with the scope.
+func (k *APIKey) HasScope(scope string) bool {
+	if len(k.Scopes) == 0 || slices.Contains(k.Scopes, scope) {
+		return true
+	}
+
+	if fb, ok := scopeFallbacks[scope]; ok && k.HasScope(fb) {
+		return true
+	}
+
+	area, action, _ := strings.Cut(scope, ":")
+	if action != "read" {
+		return false
+	}
+
+	return slices.ContainsFunc(k.Scopes, func(s string) bool {
+		a, _, _ := strings.Cut(s, ":")
+		return a == area
+	})
 }
 
 type APIKeyRepository interface {
 	WithTx(tx pgx.Tx) APIKeyRepository
 	List(ctx context.Context) ([]*APIKey, error)
+	ListLegacy(ctx context.Context) ([]*APIKey, error)
 	Get(ctx context.Context, id int) (*APIKey, error)
+	GetByKeyID(ctx context.Context, keyID string) (*APIKey, error)
 	Insert(ctx context.Context, a *APIKey) (*APIKey, error)
+	SetExpiry(ctx context.Context, id int, expiresOn *time.Time) (*APIKey, error)
+	SetLastUsed(ctx context.Context, id int, ip string) error
 	Delete(ctx context.Context, id int) error
 }
 
 var ErrAPIUserNotFound = errors.New("api user not found")
 
+// Role controls what an API user can do.
+type Role string
+
+const (
+	// RoleAdmin can do everything, including managing users, keys, config, and syncs.
+	RoleAdmin Role = "admin"
+	// RoleOperator can view everything and manage notifier rules and forwards.
+	RoleOperator Role = "operator"
+	// RoleSelfService can view everything except other users' forwards, and manage only their own.
+	RoleSelfService Role = "self_service"
+	// RoleReadOnly can view everything but change nothing.
+	RoleReadOnly Role = "read_only"
+)
+
+var Roles = []Role{RoleAdmin, RoleOperator, RoleSelfService, RoleReadOnly}
+
+func (r Role) Valid() bool {
+	return slices.Contains(Roles, r)
+}
+
 type APIUser struct {
 	ID           int       `json:"id"`
 	EmailAddress string    `json:"email_address"`
+	Role         Role      `json:"role"`
 	CreatedOn    time.Time `json:"created_on"`
 	UpdatedOn    time.Time `json:"updated_on"`
 }
@@ -51,6 +178,7 @@ type APIUserRepository interface {
 	Get(ctx context.Context, id int) (*APIUser, error)
 	GetByEmail(ctx context.Context, email string) (*APIUser, error)
 	Exists(ctx context.Context, email string) (bool, error)
-	Insert(ctx context.Context, email string) (*APIUser, error)
+	Insert(ctx context.Context, email string, role Role) (*APIUser, error)
+	Update(ctx context.Context, u *APIUser) (*APIUser, error)
 	Delete(ctx context.Context, id int) error
 }
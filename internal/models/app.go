@@ -3,11 +3,15 @@ package models
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 )
 
-var ErrConfigNotFound = errors.New("config not found")
+var (
+	ErrConfigNotFound = errors.New("config not found")
+	ErrInvalidConfig  = errors.New("invalid config")
+)
 
 type Config struct {
 	// The app will only ever have one config in the config table, so this will always just be 1.
@@ -27,6 +31,14 @@ type Config struct {
 
 	// SkipLaunchSyncs is a flag to skip the automatic syncing of webex recipients and connectwise boards.
 	SkipLaunchSyncs bool `json:"skip_launch_syncs"`
+
+	// ReconcileIntervalMinutes is how often to check Connectwise for tickets whose webhooks never arrived.
+	// Set to 0 to disable reconciliation.
+	ReconcileIntervalMinutes int `json:"reconcile_interval_minutes"`
+
+	// ReconcileLookbackMinutes is how far back each reconcile looks for updated tickets. It should be longer
+	// than the interval, so a failed or skipped run is covered by the next one.
+	ReconcileLookbackMinutes int `json:"reconcile_lookback_minutes"`
 }
 
 var DefaultConfig = Config{
@@ -35,6 +47,31 @@ var DefaultConfig = Config{
 	MaxMessageLength:   300,
 	MaxConcurrentSyncs: 5,
 	SkipLaunchSyncs:    false,
+
+	ReconcileIntervalMinutes: 10,
+	ReconcileLookbackMinutes: 60,
+}
+
+// Validate checks that the config's values are usable. The error wraps ErrInvalidConfig.
+func (c *Config) Validate() error {
+	switch {
+	case c.MaxMessageLength <= 0:
+		return fmt.Errorf("%w: max message length must be at least 1", ErrInvalidConfig)
+	case c.MaxConcurrentSyncs <= 0:
+		return fmt.Errorf("%w: max concurrent syncs must be at least 1", ErrInvalidConfig)
+	case c.ReconcileIntervalMinutes < 0:
+		return fmt.Errorf("%w: reconcile interval can't be negative", ErrInvalidConfig)
+	case c.ReconcileLookbackMinutes < 0:
+		return fmt.Errorf("%w: reconcile lookback can't be negative", ErrInvalidConfig)
+	}
+
+	return nil
+}
+
+// ConfigSource gives services the current config. The returned config is shared and replaced
+// whole on every change, so it must not be modified, and should be read again rather than kept.
+type ConfigSource interface {
+	Current() *Config
 }
 
 type ConfigRepository interface {
@@ -42,4 +79,8 @@ type ConfigRepository interface {
 	Get(ctx context.Context) (*Config, error)
 	InsertDefault(ctx context.Context) (*Config, error)
 	Upsert(ctx context.Context, c *Config) (*Config, error)
+
+	// Listen blocks until the context is done or the connection fails, calling onChange every
+	// time the stored config is changed, by any replica.
+	Listen(ctx context.Context, onChange func()) error
 }
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+	AuditActionRotate AuditAction = "rotate"
+	AuditActionSync   AuditAction = "sync"
+)
+
+var AuditActions = []AuditAction{AuditActionCreate, AuditActionUpdate, AuditActionDelete, AuditActionRotate, AuditActionSync}
+
+// AuditEntity is the kind of thing an audit event is about.
+type AuditEntity string
+
+const (
+	AuditEntityNotifierRule    AuditEntity = "notifier_rule"
+	AuditEntityNotifierForward AuditEntity = "notifier_forward"
+	AuditEntityAPIUser         AuditEntity = "api_user"
+	AuditEntityAPIKey          AuditEntity = "api_key"
+	AuditEntityConfig          AuditEntity = "config"
+	AuditEntitySyncSchedule    AuditEntity = "sync_schedule"
+	AuditEntitySyncRun         AuditEntity = "sync_run"
+)
+
+var AuditEntities = []AuditEntity{
+	AuditEntityNotifierRule,
+	AuditEntityNotifierForward,
+	AuditEntityAPIUser,
+	AuditEntityAPIKey,
+	AuditEntityConfig,
+	AuditEntitySyncSchedule,
+	AuditEntitySyncRun,
+}
+
+// AuditEvent records a change made through the API. UserID is who made it, and is nil for
+// changes the server made itself, like scheduled syncs. Before is nil for creates and After is
+// nil for deletes.
+type AuditEvent struct {
+	ID         int             `json:"id"`
+	UserID     *int            `json:"user_id"`
+	Action     AuditAction     `json:"action"`
+	EntityType AuditEntity     `json:"entity_type"`
+	EntityID   *int            `json:"entity_id"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	CreatedOn  time.Time       `json:"created_on"`
+}
+
+// ChangedFields returns the top level fields that differ between the before and after states,
+// sorted by name. For creates and deletes, it is every field that was set.
+func (e *AuditEvent) ChangedFields() []string {
+	before, ok := auditState(e.Before)
+	if !ok {
+		return nil
+	}
+
+	after, ok := auditState(e.After)
+	if !ok {
+		return nil
+	}
+
+	var fields []string
+	for k, v := range after {
+		if b, ok := before[k]; !ok || !bytes.Equal(compactJSON(b), compactJSON(v)) {
+			fields = append(fields, k)
+		}
+	}
+
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+
+	// timestamps change on every update, and only add noise
+	fields = slices.DeleteFunc(fields, func(f string) bool {
+		return f == "created_on" || f == "updated_on"
+	})
+
+	slices.Sort(fields)
+	return fields
+}
+
+// auditState unmarshals a before or after state. It reports false if the state isn't an object.
+func auditState(b json.RawMessage) (map[string]json.RawMessage, bool) {
+	if len(b) == 0 {
+		return nil, true
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, false
+	}
+
+	return m, true
+}
+
+func compactJSON(b json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return b
+	}
+
+	return buf.Bytes()
+}
+
+// AuditFilter narrows an audit event listing. Nil fields are ignored. Results are newest first.
+type AuditFilter struct {
+	UserID     *int
+	Action     *AuditAction
+	EntityType *AuditEntity
+	EntityID   *int
+	Since      *time.Time
+	Until      *time.Time
+	Limit      int
+}
+
+type AuditEventRepository interface {
+	WithTx(tx pgx.Tx) AuditEventRepository
+	List(ctx context.Context, f AuditFilter) ([]*AuditEvent, error)
+	Insert(ctx context.Context, e *AuditEvent) (*AuditEvent, error)
+}
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the ID of the user making a request, for audit events.
+func WithActor(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+// ActorFromContext returns the user set by WithActor, or nil if there isn't one.
+func ActorFromContext(ctx context.Context) *int {
+	id, ok := ctx.Value(actorKey{}).(int)
+	if !ok {
+		return nil
+	}
+
+	return &id
+}
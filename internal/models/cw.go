@@ -40,6 +40,7 @@ type Company struct {
 type CompanyRepository interface {
 	WithTx(tx pgx.Tx) CompanyRepository
 	List(ctx context.Context) ([]*Company, error)
+	Search(ctx context.Context, name string) ([]*Company, error)
 	Get(ctx context.Context, id int) (*Company, error)
 	Upsert(ctx context.Context, c *Company) (*Company, error)
 	SoftDelete(ctx context.Context, id int) error
@@ -61,6 +62,7 @@ type Contact struct {
 type ContactRepository interface {
 	WithTx(tx pgx.Tx) ContactRepository
 	List(ctx context.Context) ([]*Contact, error)
+	Search(ctx context.Context, name string) ([]*Contact, error)
 	Get(ctx context.Context, id int) (*Contact, error)
 	Upsert(ctx context.Context, c *Contact) (*Contact, error)
 	SoftDelete(ctx context.Context, id int) error
@@ -85,6 +87,8 @@ type MemberRepository interface {
 	List(ctx context.Context) ([]*Member, error)
 	Get(ctx context.Context, id int) (*Member, error)
 	GetByIdentifier(ctx context.Context, identifier string) (*Member, error)
+	// GetActiveByEmail finds a member that isn't deleted by their primary email, ignoring case.
+	GetActiveByEmail(ctx context.Context, email string) (*Member, error)
 	Upsert(ctx context.Context, c *Member) (*Member, error)
 	SoftDelete(ctx context.Context, id int) error
 	Delete(ctx context.Context, id int) error
@@ -107,9 +111,24 @@ type Ticket struct {
 	Deleted   bool      `json:"deleted"`
 }
 
+// TicketFilter narrows a ticket query. Nil fields are ignored. Results are ordered by ID,
+// starting after Cursor.
+type TicketFilter struct {
+	BoardID      *int
+	StatusID     *int
+	OwnerID      *int
+	CompanyID    *int
+	UpdatedSince *time.Time
+	Closed       *bool
+	Cursor       int
+	Limit        int
+}
+
 type TicketRepository interface {
 	WithTx(tx pgx.Tx) TicketRepository
 	List(ctx context.Context) ([]*Ticket, error)
+	Query(ctx context.Context, f TicketFilter) ([]*Ticket, error)
+	Search(ctx context.Context, query string, limit int) ([]*TicketSearchResult, error)
 	Get(ctx context.Context, id int) (*Ticket, error)
 	Exists(ctx context.Context, id int) (bool, error)
 	Upsert(ctx context.Context, c *Ticket) (*Ticket, error)
@@ -117,15 +136,34 @@ type TicketRepository interface {
 	Delete(ctx context.Context, id int) error
 }
 
+// TicketSearchResult is a ticket matched by a full-text search of ticket summaries and notes.
+// Snippet is an excerpt of the best matching note with matched terms wrapped in **, and is
+// empty if only the summary matched.
+type TicketSearchResult struct {
+	ID          int       `json:"id"`
+	Summary     string    `json:"summary"`
+	BoardID     int       `json:"board_id"`
+	BoardName   string    `json:"board_name"`
+	StatusID    int       `json:"status_id"`
+	StatusName  string    `json:"status_name"`
+	Closed      bool      `json:"closed"`
+	CompanyID   int       `json:"company_id"`
+	CompanyName string    `json:"company_name"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Rank        float32   `json:"rank"`
+	Snippet     string    `json:"snippet"`
+}
+
 type FullTicket struct {
-	Ticket     Ticket
-	Board      Board
-	Status     TicketStatus
-	Company    Company
-	Contact    *Contact
-	Owner      *Member
-	LatestNote *FullTicketNote
-	Resources  []*Member
+	Ticket     Ticket            `json:"ticket"`
+	Board      Board             `json:"board"`
+	Status     TicketStatus      `json:"status"`
+	Company    Company           `json:"company"`
+	Contact    *Contact          `json:"contact"`
+	Owner      *Member           `json:"owner"`
+	LatestNote *FullTicketNote   `json:"latest_note"`
+	Resources  []*Member         `json:"resources"`
+	Notes      []*FullTicketNote `json:"notes,omitempty"`
 }
 
 var ErrTicketNoteNotFound = errors.New("ticket note not found")
@@ -153,8 +191,8 @@ type TicketNoteRepository interface {
 
 type FullTicketNote struct {
 	TicketNote
-	Member  *Member
-	Contact *Contact
+	Member  *Member  `json:"member"`
+	Contact *Contact `json:"contact"`
 }
 
 func TicketNoteToFullTicketNote(ctx context.Context, note *TicketNote, m MemberRepository, c ContactRepository) (*FullTicketNote, error) {
@@ -209,3 +247,115 @@ type TicketStatusRepository interface {
 	SoftDelete(ctx context.Context, id int) error
 	Delete(ctx context.Context, id int) error
 }
+
+var ErrBoardTypeNotFound = errors.New("board type not found")
+
+type BoardType struct {
+	ID          int       `json:"id"`
+	BoardID     int       `json:"board_id"`
+	Name        string    `json:"name"`
+	DefaultType bool      `json:"default_type"`
+	Inactive    bool      `json:"inactive"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	AddedOn     time.Time `json:"added_on"`
+	Deleted     bool      `json:"deleted"`
+}
+
+type BoardTypeRepository interface {
+	WithTx(tx pgx.Tx) BoardTypeRepository
+	List(ctx context.Context) ([]*BoardType, error)
+	ListByBoard(ctx context.Context, boardID int) ([]*BoardType, error)
+	Get(ctx context.Context, id int) (*BoardType, error)
+	Upsert(ctx context.Context, b *BoardType) (*BoardType, error)
+	SoftDelete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
+
+var ErrBoardSubtypeNotFound = errors.New("board subtype not found")
+
+type BoardSubtype struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	Inactive  bool      `json:"inactive"`
+	UpdatedOn time.Time `json:"updated_on"`
+	AddedOn   time.Time `json:"added_on"`
+	Deleted   bool      `json:"deleted"`
+}
+
+type BoardSubtypeRepository interface {
+	WithTx(tx pgx.Tx) BoardSubtypeRepository
+	List(ctx context.Context) ([]*BoardSubtype, error)
+	ListByBoard(ctx context.Context, boardID int) ([]*BoardSubtype, error)
+	Get(ctx context.Context, id int) (*BoardSubtype, error)
+	Upsert(ctx context.Context, b *BoardSubtype) (*BoardSubtype, error)
+	SoftDelete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
+
+var ErrBoardItemNotFound = errors.New("board item not found")
+
+type BoardItem struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	Inactive  bool      `json:"inactive"`
+	UpdatedOn time.Time `json:"updated_on"`
+	AddedOn   time.Time `json:"added_on"`
+	Deleted   bool      `json:"deleted"`
+}
+
+type BoardItemRepository interface {
+	WithTx(tx pgx.Tx) BoardItemRepository
+	List(ctx context.Context) ([]*BoardItem, error)
+	ListByBoard(ctx context.Context, boardID int) ([]*BoardItem, error)
+	Get(ctx context.Context, id int) (*BoardItem, error)
+	Upsert(ctx context.Context, b *BoardItem) (*BoardItem, error)
+	SoftDelete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
+
+var ErrBoardTeamNotFound = errors.New("board team not found")
+
+type BoardTeam struct {
+	ID          int       `json:"id"`
+	BoardID     int       `json:"board_id"`
+	Name        string    `json:"name"`
+	LeaderID    *int      `json:"leader_id"`
+	DefaultTeam bool      `json:"default_team"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	AddedOn     time.Time `json:"added_on"`
+	Deleted     bool      `json:"deleted"`
+}
+
+type BoardTeamRepository interface {
+	WithTx(tx pgx.Tx) BoardTeamRepository
+	List(ctx context.Context) ([]*BoardTeam, error)
+	ListByBoard(ctx context.Context, boardID int) ([]*BoardTeam, error)
+	Get(ctx context.Context, id int) (*BoardTeam, error)
+	Upsert(ctx context.Context, b *BoardTeam) (*BoardTeam, error)
+	SoftDelete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
+
+var ErrPriorityNotFound = errors.New("priority not found")
+
+type Priority struct {
+	ID              int       `json:"id"`
+	Name            string    `json:"name"`
+	Color           *string   `json:"color"`
+	SortOrder       *int      `json:"sort_order"`
+	DefaultPriority bool      `json:"default_priority"`
+	UpdatedOn       time.Time `json:"updated_on"`
+	AddedOn         time.Time `json:"added_on"`
+	Deleted         bool      `json:"deleted"`
+}
+
+type PriorityRepository interface {
+	WithTx(tx pgx.Tx) PriorityRepository
+	List(ctx context.Context) ([]*Priority, error)
+	Get(ctx context.Context, id int) (*Priority, error)
+	Upsert(ctx context.Context, pr *Priority) (*Priority, error)
+	SoftDelete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,23 @@
+package models
+
+import "context"
+
+// LockClass namespaces advisory locks by what they protect; the object ID picks the instance.
+// Every class is declared here so no two kinds of work can end up sharing a lock.
+type LockClass int
+
+const (
+	// LockClassSyncSchedule is held while a sync schedule runs; the object ID is the schedule ID.
+	LockClassSyncSchedule LockClass = 1
+
+	// LockClassReconciler is held while reconciling, so only one replica reconciles at a time. It
+	// has a single object, 0.
+	LockClassReconciler LockClass = 2
+)
+
+// LockRepository hands out Postgres advisory locks, so work can be limited to one replica at a time.
+type LockRepository interface {
+	// TryLock attempts to take the lock for the given key without waiting. If ok is true, the lock is held
+	// until release is called.
+	TryLock(ctx context.Context, class LockClass, objID int) (release func(), ok bool, err error)
+}
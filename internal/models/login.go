@@ -0,0 +1,102 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrDeviceLoginNotFound  = errors.New("device login not found")
+	ErrLoginSessionNotFound = errors.New("login session not found")
+	ErrLoginNotConfigured   = errors.New("login is not configured on this server")
+
+	// Device login polling errors, named as in RFC 8628 so clients can tell them apart
+	ErrAuthorizationPending = errors.New("authorization_pending")
+	ErrLoginExpired         = errors.New("expired_token")
+	ErrLoginDenied          = errors.New("access_denied")
+	ErrInvalidRefreshToken  = errors.New("invalid_grant")
+)
+
+// SessionTokenPrefix starts every session access token, which sets them apart from API keys.
+const SessionTokenPrefix = "tbs_"
+
+func IsSessionToken(token string) bool {
+	return strings.HasPrefix(token, SessionTokenPrefix)
+}
+
+// DeviceLoginResponse is returned when a CLI starts a login. The user opens the verification URI
+// in a browser and confirms the user code while the CLI polls with the device code.
+type DeviceLoginResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type DeviceTokenPayload struct {
+	DeviceCode string `json:"device_code"`
+}
+
+type RefreshTokenPayload struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// SessionTokens are issued once a device login is approved, and again on every refresh. The
+// refresh token is replaced each time it is used.
+type SessionTokens struct {
+	Email            string    `json:"email"`
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	ExpiresOn        time.Time `json:"expires_on"`
+	RefreshExpiresOn time.Time `json:"refresh_expires_on"`
+}
+
+type DeviceLogin struct {
+	ID             int       `json:"id"`
+	DeviceCodeHash []byte    `json:"device_code_hash"`
+	UserCode       string    `json:"user_code"`
+	State          string    `json:"state"`
+	UserID         *int      `json:"user_id"`
+	DeniedReason   *string   `json:"denied_reason"`
+	ExpiresOn      time.Time `json:"expires_on"`
+	CreatedOn      time.Time `json:"created_on"`
+}
+
+type DeviceLoginRepository interface {
+	WithTx(tx pgx.Tx) DeviceLoginRepository
+	GetByDeviceCode(ctx context.Context, hash []byte) (*DeviceLogin, error)
+	GetByUserCode(ctx context.Context, code string) (*DeviceLogin, error)
+	GetByState(ctx context.Context, state string) (*DeviceLogin, error)
+	Insert(ctx context.Context, d *DeviceLogin) (*DeviceLogin, error)
+	Approve(ctx context.Context, id, userID int) (*DeviceLogin, error)
+	Deny(ctx context.Context, id int, reason string) (*DeviceLogin, error)
+	Delete(ctx context.Context, id int) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type LoginSession struct {
+	ID               int       `json:"id"`
+	UserID           int       `json:"user_id"`
+	TokenHash        []byte    `json:"token_hash"`
+	RefreshHash      []byte    `json:"refresh_hash"`
+	ExpiresOn        time.Time `json:"expires_on"`
+	RefreshExpiresOn time.Time `json:"refresh_expires_on"`
+	CreatedOn        time.Time `json:"created_on"`
+	UpdatedOn        time.Time `json:"updated_on"`
+}
+
+type LoginSessionRepository interface {
+	WithTx(tx pgx.Tx) LoginSessionRepository
+	GetByTokenHash(ctx context.Context, hash []byte) (*LoginSession, error)
+	GetByRefreshHash(ctx context.Context, hash []byte) (*LoginSession, error)
+	Insert(ctx context.Context, s *LoginSession) (*LoginSession, error)
+	Refresh(ctx context.Context, s *LoginSession) (*LoginSession, error)
+	Delete(ctx context.Context, id int) error
+	DeleteExpired(ctx context.Context) error
+}
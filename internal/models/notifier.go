@@ -10,24 +10,29 @@ import (
 )
 
 type MessageSender interface {
-	GetMessage(id string, params map[string]string) (*webex.Message, error)
-	GetAttachmentAction(messageID string) (*webex.AttachmentAction, error)
-	PostMessage(message *webex.Message) (*webex.Message, error)
-	ListRooms(params map[string]string) ([]webex.Room, error)
-	ListPeople(email string) ([]webex.Person, error)
+	GetMessageCtx(ctx context.Context, id string, params map[string]string) (*webex.Message, error)
+	GetAttachmentActionCtx(ctx context.Context, messageID string) (*webex.AttachmentAction, error)
+	PostMessageCtx(ctx context.Context, message *webex.Message) (*webex.Message, error)
+	ListRoomsCtx(ctx context.Context, params map[string]string) ([]webex.Room, error)
+	ListPeopleCtx(ctx context.Context, email string) ([]webex.Person, error)
 }
 
-var ErrUserForwardNotFound = errors.New("forward rule not found")
+var (
+	ErrUserForwardNotFound = errors.New("forward rule not found")
+	// ErrForwardNotOwned is returned when a self service user acts on a forward whose source
+	// isn't their own webex recipient.
+	ErrForwardNotOwned = errors.New("you can only manage forwards from your own webex recipient")
+)
 
 type NotifierForward struct {
 	ID            int        `json:"id"`
-	SourceID      int        `json:"user_email"`
-	DestID        int        `json:"dest_email"`
+	SourceID      int        `json:"source_id"`
+	DestID        int        `json:"destination_id"`
 	StartDate     *time.Time `json:"start_date"`
 	EndDate       *time.Time `json:"end_date"`
 	Enabled       bool       `json:"enabled"`
 	UserKeepsCopy bool       `json:"user_keeps_copy"`
-	CreatedOn     time.Time  `json:"added_on"`
+	CreatedOn     time.Time  `json:"created_on"`
 	UpdatedOn     time.Time  `json:"updated_on"`
 }
 
@@ -53,6 +58,7 @@ type NotifierForwardRepository interface {
 	Get(ctx context.Context, id int) (*NotifierForward, error)
 	Exists(ctx context.Context, id int) (bool, error)
 	Insert(ctx context.Context, c *NotifierForward) (*NotifierForward, error)
+	Update(ctx context.Context, c *NotifierForward) (*NotifierForward, error)
 	Delete(ctx context.Context, id int) error
 }
 
@@ -60,9 +66,9 @@ var ErrNotifierNotFound = errors.New("notifier not found")
 
 type NotifierRule struct {
 	ID               int       `json:"id"`
-	CwBoardID        int       `json:"cw_board_id"`
-	WebexRecipientID int       `json:"webex_room_id"`
-	NotifyEnabled    bool      `json:"notify_enabled"`
+	CwBoardID        int       `json:"board_id"`
+	WebexRecipientID int       `json:"recipient_id"`
+	NotifyEnabled    bool      `json:"enabled"`
 	CreatedOn        time.Time `json:"created_on"`
 }
 
@@ -96,7 +102,7 @@ type TicketNotification struct {
 	ID              int       `json:"id"`
 	TicketID        int       `json:"ticket_id"`
 	TicketNoteID    *int      `json:"ticket_note_id"`
-	RecipientID     *int      `json:"webex_room_id"`
+	RecipientID     *int      `json:"recipient_id"`
 	ForwardedFromID *int      `json:"forwarded_from_id"`
 	Sent            bool      `json:"sent"`
 	Skipped         bool      `json:"skipped"`
@@ -114,3 +120,42 @@ type TicketNotificationRepository interface {
 	Insert(ctx context.Context, n *TicketNotification) (*TicketNotification, error)
 	Delete(ctx context.Context, id int) error
 }
+
+// Dispatch is a member being scheduled on a ticket in Connectwise. End is nil for entries
+// without an end time.
+type Dispatch struct {
+	ScheduleEntryID int
+	Ticket          *FullTicket
+	Member          *Member
+	Start           time.Time
+	End             *time.Time
+}
+
+var ErrDispatchNotificationClaimed = errors.New("dispatch notification already claimed")
+
+// DispatchNotification records a dispatch message to one recipient for a schedule entry, so the
+// same window isn't sent twice when Connectwise repeats a callback. A row is claimed before the
+// message is sent and only counts as notified once Sent is true.
+type DispatchNotification struct {
+	ID              int        `json:"id"`
+	ScheduleEntryID int        `json:"schedule_entry_id"`
+	TicketID        int        `json:"ticket_id"`
+	MemberID        int        `json:"member_id"`
+	DateStart       time.Time  `json:"date_start"`
+	DateEnd         *time.Time `json:"date_end"`
+	RecipientID     *int       `json:"recipient_id"`
+	ForwardedFromID *int       `json:"forwarded_from_id"`
+	Sent            bool       `json:"sent"`
+	CreatedOn       time.Time  `json:"created_on"`
+	ClaimedOn       *time.Time `json:"claimed_on"`
+}
+
+type DispatchNotificationRepository interface {
+	WithTx(tx pgx.Tx) DispatchNotificationRepository
+	// Claim records that n is about to be sent. It returns ErrDispatchNotificationClaimed if
+	// the recipient was already sent this window, or is being sent by another instance.
+	Claim(ctx context.Context, n *DispatchNotification) (*DispatchNotification, error)
+	MarkSent(ctx context.Context, id int) error
+	// Release gives up a claim after a failed send, so the next callback can try again.
+	Release(ctx context.Context, id int) error
+}
@@ -1,18 +1,30 @@
 package models
 
 type AllRepos struct {
-	APIKey              APIKeyRepository
-	APIUser             APIUserRepository
-	Config              ConfigRepository
-	TicketNotifications TicketNotificationRepository
-	NotifierForwards    NotifierForwardRepository
-	NotifierRules       NotifierRuleRepository
-	WebexRecipients     WebexRecipientRepository
-	CW                  CWRepos
+	APIKey                APIKeyRepository
+	APIUser               APIUserRepository
+	AuditEvents           AuditEventRepository
+	Config                ConfigRepository
+	DeviceLogins          DeviceLoginRepository
+	LoginSessions         LoginSessionRepository
+	TicketNotifications   TicketNotificationRepository
+	DispatchNotifications DispatchNotificationRepository
+	NotifierForwards      NotifierForwardRepository
+	NotifierRules         NotifierRuleRepository
+	SyncRuns              SyncRunRepository
+	SyncSchedules         SyncScheduleRepository
+	Locks                 LockRepository
+	WebexRecipients       WebexRecipientRepository
+	CW                    CWRepos
 }
 
 type CWRepos struct {
 	Board        BoardRepository
+	BoardType    BoardTypeRepository
+	BoardSubtype BoardSubtypeRepository
+	BoardItem    BoardItemRepository
+	BoardTeam    BoardTeamRepository
+	Priority     PriorityRepository
 	Company      CompanyRepository
 	Contact      ContactRepository
 	Member       MemberRepository
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ErrorResponse is the body of every error the API returns.
+type ErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// APIError says what went wrong. Code is stable for programs to check, and is the HTTP status
+// in snake case unless a route documents its own codes. Message is for people.
+type APIError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// NewErrorResponse builds the error body for an HTTP status, using the status as the code.
+func NewErrorResponse(status int, msg string) ErrorResponse {
+	return ErrorResponse{Error: APIError{Code: StatusCode(status), Message: msg}}
+}
+
+// StatusCode is the error code for an HTTP status, such as not_found for 404.
+func StatusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrSyncScheduleNotFound = errors.New("sync schedule not found")
+
+	// ErrSyncScheduleClaimed is returned when a schedule's run was already claimed, usually by another replica.
+	ErrSyncScheduleClaimed = errors.New("sync schedule run already claimed")
+)
+
+// SyncSchedule is a sync payload that the server runs on a cron schedule. Cron expressions use the standard
+// five fields (minute, hour, day of month, month, day of week) in the server's local time, or one of the
+// macros @hourly, @daily, @weekly, @monthly, or @yearly.
+type SyncSchedule struct {
+	ID        int         `json:"id"`
+	Name      string      `json:"name"`
+	Cron      string      `json:"cron"`
+	Payload   SyncPayload `json:"payload"`
+	Enabled   bool        `json:"enabled"`
+	LastRunOn *time.Time  `json:"last_run_on"`
+
+	// NextRunOn is calculated from the cron expression and is not stored.
+	NextRunOn *time.Time `json:"next_run_on"`
+	CreatedOn time.Time  `json:"created_on"`
+	UpdatedOn time.Time  `json:"updated_on"`
+}
+
+type SyncScheduleRepository interface {
+	WithTx(tx pgx.Tx) SyncScheduleRepository
+	List(ctx context.Context) ([]*SyncSchedule, error)
+	Get(ctx context.Context, id int) (*SyncSchedule, error)
+	Insert(ctx context.Context, s *SyncSchedule) (*SyncSchedule, error)
+	Update(ctx context.Context, s *SyncSchedule) (*SyncSchedule, error)
+	Delete(ctx context.Context, id int) error
+
+	// ClaimRun sets the schedule's last run time, but only if it is still prev. It returns
+	// ErrSyncScheduleClaimed if the run was claimed elsewhere first.
+	ClaimRun(ctx context.Context, id int, prev *time.Time, at time.Time) (*SyncSchedule, error)
+}
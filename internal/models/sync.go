@@ -1,9 +1,70 @@
 package models
 
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SyncPayload selects what a sync run covers. CWCompanies syncs companies and then their contacts.
 type SyncPayload struct {
 	WebexRecipients    bool  `json:"webex_recipients"`
 	CWBoards           bool  `json:"cw_boards"`
 	CWTickets          bool  `json:"cw_tickets"`
+	CWCompanies        bool  `json:"cw_companies"`
 	BoardIDs           []int `json:"board_ids"`
 	MaxConcurrentSyncs int   `json:"max_concurrent_syncs"`
 }
+
+var ErrSyncRunNotFound = errors.New("sync run not found")
+
+type SyncRunStatus string
+
+const (
+	SyncRunStatusRunning   SyncRunStatus = "running"
+	SyncRunStatusSucceeded SyncRunStatus = "succeeded"
+	SyncRunStatusFailed    SyncRunStatus = "failed"
+)
+
+type SyncTarget string
+
+const (
+	SyncTargetCWBoards        SyncTarget = "cw_boards"
+	SyncTargetWebexRecipients SyncTarget = "webex_recipients"
+	SyncTargetCWTickets       SyncTarget = "cw_tickets"
+	SyncTargetCWCompanies     SyncTarget = "cw_companies"
+	SyncTargetCWContacts      SyncTarget = "cw_contacts"
+)
+
+// SyncTargetResult holds the running counts for a single sync target. Total is the amount of
+// items received from the source of truth, if known.
+type SyncTargetResult struct {
+	Total    int `json:"total"`
+	Upserted int `json:"upserted"`
+	Deleted  int `json:"deleted"`
+	Failed   int `json:"failed"`
+}
+
+type SyncRun struct {
+	ID         int                              `json:"id"`
+	Payload    SyncPayload                      `json:"payload"`
+	Status     SyncRunStatus                    `json:"status"`
+	Results    map[SyncTarget]*SyncTargetResult `json:"results"`
+	Errors     []string                         `json:"errors"`
+	StartedOn  time.Time                        `json:"started_on"`
+	FinishedOn *time.Time                       `json:"finished_on"`
+}
+
+func (r *SyncRun) Finished() bool {
+	return r.Status != SyncRunStatusRunning
+}
+
+type SyncRunRepository interface {
+	WithTx(tx pgx.Tx) SyncRunRepository
+	List(ctx context.Context, limit int) ([]*SyncRun, error)
+	Get(ctx context.Context, id int) (*SyncRun, error)
+	Insert(ctx context.Context, r *SyncRun) (*SyncRun, error)
+	Update(ctx context.Context, r *SyncRun) (*SyncRun, error)
+}
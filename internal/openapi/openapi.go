@@ -0,0 +1,209 @@
+// Package openapi builds an OpenAPI 3 document from the routes as they are registered, so the
+// document always matches what the server serves.
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	version        = "3.0.3"
+	securityScheme = "bearer"
+	errorResponse  = "Error"
+)
+
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Servers    []Server              `json:"servers"`
+	Security   []map[string][]string `json:"security"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+
+	basePath string
+	opIDs    map[string]bool
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem holds a path's operations by lowercase method.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+
+	// Security is an empty list for routes reachable without a token, and nil for the default.
+	Security *[]map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	Responses       map[string]*Response      `json:"responses"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme"`
+	Description string `json:"description,omitempty"`
+}
+
+// Op describes a route for the document.
+type Op struct {
+	// ID is the operationId. It must be unique, and the SDK names its path constants after it.
+	ID      string
+	Summary string
+	Query   []Param
+	// Body and Response are zero values of the request and response types. A nil Response means
+	// the route returns no body.
+	Body     any
+	Response any
+	// Public routes don't need a token.
+	Public bool
+}
+
+// Param is a query parameter. Type is a JSON schema type such as integer or string.
+type Param struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// New starts a document for an API served under basePath. errBody is a zero value of the type
+// every error response has.
+func New(title, apiVersion, basePath string, errBody any) *Document {
+	d := &Document{
+		OpenAPI:  version,
+		Info:     Info{Title: title, Version: apiVersion},
+		Servers:  []Server{{URL: basePath}},
+		Security: []map[string][]string{{securityScheme: {}}},
+		Paths:    make(map[string]PathItem),
+		Components: Components{
+			Schemas:   make(map[string]*Schema),
+			Responses: make(map[string]*Response),
+			SecuritySchemes: map[string]SecurityScheme{
+				securityScheme: {Type: "http", Scheme: "bearer", Description: "An API key or login session token"},
+			},
+		},
+		basePath: strings.TrimSuffix(basePath, "/"),
+		opIDs:    make(map[string]bool),
+	}
+
+	d.Components.Responses[errorResponse] = &Response{
+		Description: "Error",
+		Content:     jsonContent(d.schemaFor(reflect.TypeOf(errBody))),
+	}
+
+	return d
+}
+
+// add documents a route. fullPath is the gin path, including the base path and :params. It
+// panics on a missing or repeated operation ID, since the routes are fixed at build time.
+func (d *Document) add(method, fullPath string, op Op) {
+	if op.ID == "" {
+		panic(fmt.Sprintf("openapi: %s %s has no operation id", method, fullPath))
+	}
+
+	if d.opIDs[op.ID] {
+		panic(fmt.Sprintf("openapi: operation id %s is used more than once", op.ID))
+	}
+	d.opIDs[op.ID] = true
+
+	o := &Operation{
+		OperationID: op.ID,
+		Summary:     op.Summary,
+		Responses:   make(map[string]*Response),
+	}
+
+	segs := strings.Split(strings.Trim(strings.TrimPrefix(fullPath, d.basePath), "/"), "/")
+	o.Tags = []string{segs[0]}
+	for i, s := range segs {
+		name, ok := strings.CutPrefix(s, ":")
+		if !ok {
+			continue
+		}
+
+		// every path parameter in this API is an id
+		segs[i] = "{" + name + "}"
+		o.Parameters = append(o.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "integer"},
+		})
+	}
+
+	for _, q := range op.Query {
+		o.Parameters = append(o.Parameters, Parameter{
+			Name:        q.Name,
+			In:          "query",
+			Description: q.Description,
+			Schema:      &Schema{Type: q.Type},
+		})
+	}
+
+	if op.Body != nil {
+		o.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(d.schemaFor(reflect.TypeOf(op.Body))),
+		}
+	}
+
+	ok := &Response{Description: "OK"}
+	if op.Response != nil {
+		ok.Content = jsonContent(d.schemaFor(reflect.TypeOf(op.Response)))
+	}
+	o.Responses["200"] = ok
+	o.Responses["default"] = &Response{Ref: "#/components/responses/" + errorResponse}
+
+	if op.Public {
+		o.Security = &[]map[string][]string{}
+	}
+
+	p := "/" + strings.Join(segs, "/")
+	if d.Paths[p] == nil {
+		d.Paths[p] = make(PathItem)
+	}
+	d.Paths[p][strings.ToLower(method)] = o
+}
+
+func jsonContent(s *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: s}}
+}
@@ -0,0 +1,70 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Router registers routes on a gin group and documents them as it goes.
+type Router struct {
+	group *gin.RouterGroup
+	doc   *Document
+
+	// alias, if set, gets every route too but is left out of the document
+	alias *gin.RouterGroup
+}
+
+// Router wraps the group the API is served from, which must be at the document's base path.
+func (d *Document) Router(g *gin.RouterGroup) *Router {
+	return &Router{group: g, doc: d}
+}
+
+// WithAlias returns a router that also serves every route it registers from g, such as the
+// paths the API had before it was versioned. The alias routes aren't documented.
+func (r *Router) WithAlias(g *gin.RouterGroup) *Router {
+	return &Router{group: r.group, doc: r.doc, alias: g}
+}
+
+func (r *Router) Group(path string, handlers ...gin.HandlerFunc) *Router {
+	g := &Router{group: r.group.Group(path, handlers...), doc: r.doc}
+	if r.alias != nil {
+		g.alias = r.alias.Group(path, handlers...)
+	}
+
+	return g
+}
+
+func (r *Router) GET(path string, h gin.HandlerFunc, op Op) {
+	r.handle(http.MethodGet, path, h, op)
+}
+
+func (r *Router) POST(path string, h gin.HandlerFunc, op Op) {
+	r.handle(http.MethodPost, path, h, op)
+}
+
+func (r *Router) PUT(path string, h gin.HandlerFunc, op Op) {
+	r.handle(http.MethodPut, path, h, op)
+}
+
+func (r *Router) PATCH(path string, h gin.HandlerFunc, op Op) {
+	r.handle(http.MethodPatch, path, h, op)
+}
+
+func (r *Router) DELETE(path string, h gin.HandlerFunc, op Op) {
+	r.handle(http.MethodDelete, path, h, op)
+}
+
+func (r *Router) handle(method, path string, h gin.HandlerFunc, op Op) {
+	r.group.Handle(method, path, h)
+	if r.alias != nil {
+		r.alias.Handle(method, path, h)
+	}
+
+	full := strings.TrimSuffix(r.group.BasePath(), "/")
+	if path != "" {
+		full += "/" + path
+	}
+	r.doc.add(method, full, op)
+}
@@ -7,15 +7,27 @@ import (
 
 func AllRepos(pool *pgxpool.Pool) *models.AllRepos {
 	return &models.AllRepos{
-		APIKey:              NewAPIKeyRepo(pool),
-		APIUser:             NewAPIUserRepo(pool),
-		Config:              NewConfigRepo(pool),
-		TicketNotifications: NewNotificationRepo(pool),
-		NotifierForwards:    NewUserForwardRepo(pool),
-		NotifierRules:       NewNotifierRuleRepo(pool),
-		WebexRecipients:     NewWebexRecipientRepo(pool),
+		APIKey:                NewAPIKeyRepo(pool),
+		APIUser:               NewAPIUserRepo(pool),
+		AuditEvents:           NewAuditEventRepo(pool),
+		Config:                NewConfigRepo(pool),
+		DeviceLogins:          NewDeviceLoginRepo(pool),
+		LoginSessions:         NewLoginSessionRepo(pool),
+		TicketNotifications:   NewNotificationRepo(pool),
+		DispatchNotifications: NewDispatchNotificationRepo(pool),
+		NotifierForwards:      NewUserForwardRepo(pool),
+		NotifierRules:         NewNotifierRuleRepo(pool),
+		SyncRuns:              NewSyncRunRepo(pool),
+		SyncSchedules:         NewSyncScheduleRepo(pool),
+		Locks:                 NewLockRepo(pool),
+		WebexRecipients:       NewWebexRecipientRepo(pool),
 		CW: models.CWRepos{
 			Board:        NewBoardRepo(pool),
+			BoardType:    NewBoardTypeRepo(pool),
+			BoardSubtype: NewBoardSubtypeRepo(pool),
+			BoardItem:    NewBoardItemRepo(pool),
+			BoardTeam:    NewBoardTeamRepo(pool),
+			Priority:     NewPriorityRepo(pool),
 			TicketStatus: NewTicketStatusRepo(pool),
 			Company:      NewCompanyRepo(pool),
 			Contact:      NewContactRepo(pool),
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -37,6 +38,33 @@ func (p *APIKeyRepo) List(ctx context.Context) ([]*models.APIKey, error) {
 	return k, nil
 }
 
+// ListLegacy lists keys without a key ID that are still in their grace period.
+func (p *APIKeyRepo) ListLegacy(ctx context.Context) ([]*models.APIKey, error) {
+	dk, err := p.queries.ListLegacyAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var k []*models.APIKey
+	for _, d := range dk {
+		k = append(k, keyFromPG(d))
+	}
+
+	return k, nil
+}
+
+func (p *APIKeyRepo) GetByKeyID(ctx context.Context, keyID string) (*models.APIKey, error) {
+	d, err := p.queries.GetAPIKeyByKeyID(ctx, &keyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return keyFromPG(d), nil
+}
+
 func (p *APIKeyRepo) Get(ctx context.Context, id int) (*models.APIKey, error) {
 	d, err := p.queries.GetAPIKey(ctx, id)
 	if err != nil {
@@ -58,6 +86,22 @@ func (p *APIKeyRepo) Insert(ctx context.Context, a *models.APIKey) (*models.APIK
 	return keyFromPG(d), nil
 }
 
+func (p *APIKeyRepo) SetExpiry(ctx context.Context, id int, expiresOn *time.Time) (*models.APIKey, error) {
+	d, err := p.queries.UpdateAPIKeyExpiry(ctx, db.UpdateAPIKeyExpiryParams{ID: id, ExpiresOn: expiresOn})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return keyFromPG(d), nil
+}
+
+func (p *APIKeyRepo) SetLastUsed(ctx context.Context, id int, ip string) error {
+	return p.queries.UpdateAPIKeyLastUsed(ctx, db.UpdateAPIKeyLastUsedParams{ID: id, LastUsedIp: &ip})
+}
+
 func (p *APIKeyRepo) Delete(ctx context.Context, id int) error {
 	if err := p.queries.DeleteAPIKey(ctx, id); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -70,20 +114,37 @@ func (p *APIKeyRepo) Delete(ctx context.Context, id int) error {
 }
 
 func insertParamsFromAPIKey(a *models.APIKey) db.InsertAPIKeyParams {
+	scopes := a.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
 	return db.InsertAPIKeyParams{
-		UserID:  a.UserID,
-		KeyHash: a.KeyHash,
-		KeyHint: a.KeyHint,
+		UserID:      a.UserID,
+		KeyHash:     a.KeyHash,
+		KeyHint:     a.KeyHint,
+		KeyID:       a.KeyID,
+		LegacyUntil: a.LegacyUntil,
+		Name:        a.Name,
+		ExpiresOn:   a.ExpiresOn,
+		Scopes:      scopes,
 	}
 }
 
 func keyFromPG(pg *db.ApiKey) *models.APIKey {
 	return &models.APIKey{
-		ID:        pg.ID,
-		UserID:    pg.UserID,
-		KeyHash:   pg.KeyHash,
-		KeyHint:   pg.KeyHint,
-		CreatedOn: pg.CreatedOn,
-		UpdatedOn: pg.UpdatedOn,
+		ID:          pg.ID,
+		UserID:      pg.UserID,
+		KeyHash:     pg.KeyHash,
+		KeyHint:     pg.KeyHint,
+		KeyID:       pg.KeyID,
+		LegacyUntil: pg.LegacyUntil,
+		Name:        pg.Name,
+		ExpiresOn:   pg.ExpiresOn,
+		Scopes:      pg.Scopes,
+		LastUsedAt:  pg.LastUsedAt,
+		LastUsedIP:  pg.LastUsedIp,
+		CreatedOn:   pg.CreatedOn,
+		UpdatedOn:   pg.UpdatedOn,
 	}
 }
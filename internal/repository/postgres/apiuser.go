@@ -65,8 +65,8 @@ func (p *APIUserRepo) Exists(ctx context.Context, email string) (bool, error) {
 	return p.queries.CheckUserExists(ctx, email)
 }
 
-func (p *APIUserRepo) Insert(ctx context.Context, email string) (*models.APIUser, error) {
-	d, err := p.queries.InsertUser(ctx, email)
+func (p *APIUserRepo) Insert(ctx context.Context, email string, role models.Role) (*models.APIUser, error) {
+	d, err := p.queries.InsertUser(ctx, db.InsertUserParams{EmailAddress: email, Role: string(role)})
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +101,7 @@ func apiUserToUpdateParams(u *models.APIUser) db.UpdateUserParams {
 	return db.UpdateUserParams{
 		ID:           u.ID,
 		EmailAddress: u.EmailAddress,
+		Role:         string(u.Role),
 	}
 }
 
@@ -108,6 +109,7 @@ func userFromPG(pg *db.ApiUser) *models.APIUser {
 	return &models.APIUser{
 		ID:           pg.ID,
 		EmailAddress: pg.EmailAddress,
+		Role:         models.Role(pg.Role),
 		CreatedOn:    pg.CreatedOn,
 		UpdatedOn:    pg.UpdatedOn,
 	}
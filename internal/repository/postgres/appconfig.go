@@ -10,19 +10,25 @@ import (
 	"github.com/thecoretg/ticketbot/internal/models"
 )
 
+// configChannel is notified by a trigger on app_config whenever the row is inserted or updated.
+const configChannel = "app_config_changed"
+
 type ConfigRepo struct {
 	queries *db.Queries
+	pool    *pgxpool.Pool
 }
 
 func NewConfigRepo(pool *pgxpool.Pool) *ConfigRepo {
 	return &ConfigRepo{
 		queries: db.New(pool),
+		pool:    pool,
 	}
 }
 
 func (p *ConfigRepo) WithTx(tx pgx.Tx) models.ConfigRepository {
 	return &ConfigRepo{
 		queries: db.New(tx),
+		pool:    p.pool,
 	}
 }
 
@@ -56,12 +62,40 @@ func (p *ConfigRepo) Upsert(ctx context.Context, c *models.Config) (*models.Conf
 	return configFromPG(d), nil
 }
 
+// Listen holds a connection out of the pool for as long as it listens, since notifications are
+// only delivered to the connection that asked for them.
+func (p *ConfigRepo) Listen(ctx context.Context, onChange func()) error {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// the connection is still listening, so it can't go back into the pool
+	defer conn.Hijack().Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+configChannel); err != nil {
+		return err
+	}
+
+	for {
+		if _, err := conn.Conn().WaitForNotification(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		onChange()
+	}
+}
+
 func configToUpsertParams(c *models.Config) db.UpsertAppConfigParams {
 	return db.UpsertAppConfigParams{
 		AttemptNotify:      c.AttemptNotify,
 		MaxMessageLength:   c.MaxMessageLength,
 		MaxConcurrentSyncs: c.MaxConcurrentSyncs,
 		SkipLaunchSyncs:    c.SkipLaunchSyncs,
+
+		ReconcileIntervalMinutes: c.ReconcileIntervalMinutes,
+		ReconcileLookbackMinutes: c.ReconcileLookbackMinutes,
 	}
 }
 
@@ -72,5 +106,8 @@ func configFromPG(pg *db.AppConfig) *models.Config {
 		MaxMessageLength:   pg.MaxMessageLength,
 		MaxConcurrentSyncs: pg.MaxConcurrentSyncs,
 		SkipLaunchSyncs:    pg.SkipLaunchSyncs,
+
+		ReconcileIntervalMinutes: pg.ReconcileIntervalMinutes,
+		ReconcileLookbackMinutes: pg.ReconcileLookbackMinutes,
 	}
 }
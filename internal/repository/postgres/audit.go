@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type AuditEventRepo struct {
+	queries *db.Queries
+}
+
+func NewAuditEventRepo(pool *pgxpool.Pool) *AuditEventRepo {
+	return &AuditEventRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *AuditEventRepo) WithTx(tx pgx.Tx) models.AuditEventRepository {
+	return &AuditEventRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *AuditEventRepo) List(ctx context.Context, f models.AuditFilter) ([]*models.AuditEvent, error) {
+	dm, err := p.queries.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		UserID:     f.UserID,
+		Action:     (*string)(f.Action),
+		EntityType: (*string)(f.EntityType),
+		EntityID:   f.EntityID,
+		Since:      f.Since,
+		Until:      f.Until,
+		RowLimit:   f.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var e []*models.AuditEvent
+	for _, d := range dm {
+		e = append(e, auditEventFromPG(d))
+	}
+
+	return e, nil
+}
+
+func (p *AuditEventRepo) Insert(ctx context.Context, e *models.AuditEvent) (*models.AuditEvent, error) {
+	d, err := p.queries.InsertAuditEvent(ctx, db.InsertAuditEventParams{
+		UserID:     e.UserID,
+		Action:     string(e.Action),
+		EntityType: string(e.EntityType),
+		EntityID:   e.EntityID,
+		Before:     e.Before,
+		After:      e.After,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEventFromPG(d), nil
+}
+
+func auditEventFromPG(pg *db.AuditEvent) *models.AuditEvent {
+	return &models.AuditEvent{
+		ID:         pg.ID,
+		UserID:     pg.UserID,
+		Action:     models.AuditAction(pg.Action),
+		EntityType: models.AuditEntity(pg.EntityType),
+		EntityID:   pg.EntityID,
+		Before:     pg.Before,
+		After:      pg.After,
+		CreatedOn:  pg.CreatedOn,
+	}
+}
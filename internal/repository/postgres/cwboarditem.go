@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type BoardItemRepo struct {
+	queries *db.Queries
+}
+
+func NewBoardItemRepo(pool *pgxpool.Pool) *BoardItemRepo {
+	return &BoardItemRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *BoardItemRepo) WithTx(tx pgx.Tx) models.BoardItemRepository {
+	return &BoardItemRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *BoardItemRepo) List(ctx context.Context) ([]*models.BoardItem, error) {
+	dbs, err := p.queries.ListAllBoardItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardItem
+	for _, d := range dbs {
+		out = append(out, boardItemFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardItemRepo) ListByBoard(ctx context.Context, boardID int) ([]*models.BoardItem, error) {
+	dbs, err := p.queries.ListBoardItemsByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardItem
+	for _, d := range dbs {
+		out = append(out, boardItemFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardItemRepo) Get(ctx context.Context, id int) (*models.BoardItem, error) {
+	d, err := p.queries.GetBoardItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrBoardItemNotFound
+		}
+		return nil, err
+	}
+
+	return boardItemFromPG(d), nil
+}
+
+func (p *BoardItemRepo) Upsert(ctx context.Context, b *models.BoardItem) (*models.BoardItem, error) {
+	d, err := p.queries.UpsertBoardItem(ctx, boardItemToUpsertParams(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return boardItemFromPG(d), nil
+}
+
+func (p *BoardItemRepo) SoftDelete(ctx context.Context, id int) error {
+	return p.queries.SoftDeleteBoardItem(ctx, id)
+}
+
+func (p *BoardItemRepo) Delete(ctx context.Context, id int) error {
+	if err := p.queries.DeleteBoardItem(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrBoardItemNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func boardItemToUpsertParams(b *models.BoardItem) db.UpsertBoardItemParams {
+	return db.UpsertBoardItemParams{
+		ID:       b.ID,
+		BoardID:  b.BoardID,
+		Name:     b.Name,
+		Inactive: b.Inactive,
+	}
+}
+
+func boardItemFromPG(pg *db.CwBoardItem) *models.BoardItem {
+	return &models.BoardItem{
+		ID:        pg.ID,
+		BoardID:   pg.BoardID,
+		Name:      pg.Name,
+		Inactive:  pg.Inactive,
+		UpdatedOn: pg.UpdatedOn,
+		AddedOn:   pg.AddedOn,
+		Deleted:   pg.Deleted,
+	}
+}
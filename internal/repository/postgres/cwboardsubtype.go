@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type BoardSubtypeRepo struct {
+	queries *db.Queries
+}
+
+func NewBoardSubtypeRepo(pool *pgxpool.Pool) *BoardSubtypeRepo {
+	return &BoardSubtypeRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *BoardSubtypeRepo) WithTx(tx pgx.Tx) models.BoardSubtypeRepository {
+	return &BoardSubtypeRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *BoardSubtypeRepo) List(ctx context.Context) ([]*models.BoardSubtype, error) {
+	dbs, err := p.queries.ListAllBoardSubtypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardSubtype
+	for _, d := range dbs {
+		out = append(out, boardSubtypeFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardSubtypeRepo) ListByBoard(ctx context.Context, boardID int) ([]*models.BoardSubtype, error) {
+	dbs, err := p.queries.ListBoardSubtypesByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardSubtype
+	for _, d := range dbs {
+		out = append(out, boardSubtypeFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardSubtypeRepo) Get(ctx context.Context, id int) (*models.BoardSubtype, error) {
+	d, err := p.queries.GetBoardSubtype(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrBoardSubtypeNotFound
+		}
+		return nil, err
+	}
+
+	return boardSubtypeFromPG(d), nil
+}
+
+func (p *BoardSubtypeRepo) Upsert(ctx context.Context, b *models.BoardSubtype) (*models.BoardSubtype, error) {
+	d, err := p.queries.UpsertBoardSubtype(ctx, boardSubtypeToUpsertParams(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return boardSubtypeFromPG(d), nil
+}
+
+func (p *BoardSubtypeRepo) SoftDelete(ctx context.Context, id int) error {
+	return p.queries.SoftDeleteBoardSubtype(ctx, id)
+}
+
+func (p *BoardSubtypeRepo) Delete(ctx context.Context, id int) error {
+	if err := p.queries.DeleteBoardSubtype(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrBoardSubtypeNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func boardSubtypeToUpsertParams(b *models.BoardSubtype) db.UpsertBoardSubtypeParams {
+	return db.UpsertBoardSubtypeParams{
+		ID:       b.ID,
+		BoardID:  b.BoardID,
+		Name:     b.Name,
+		Inactive: b.Inactive,
+	}
+}
+
+func boardSubtypeFromPG(pg *db.CwBoardSubtype) *models.BoardSubtype {
+	return &models.BoardSubtype{
+		ID:        pg.ID,
+		BoardID:   pg.BoardID,
+		Name:      pg.Name,
+		Inactive:  pg.Inactive,
+		UpdatedOn: pg.UpdatedOn,
+		AddedOn:   pg.AddedOn,
+		Deleted:   pg.Deleted,
+	}
+}
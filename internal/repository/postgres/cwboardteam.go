@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type BoardTeamRepo struct {
+	queries *db.Queries
+}
+
+func NewBoardTeamRepo(pool *pgxpool.Pool) *BoardTeamRepo {
+	return &BoardTeamRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *BoardTeamRepo) WithTx(tx pgx.Tx) models.BoardTeamRepository {
+	return &BoardTeamRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *BoardTeamRepo) List(ctx context.Context) ([]*models.BoardTeam, error) {
+	dbs, err := p.queries.ListAllBoardTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardTeam
+	for _, d := range dbs {
+		out = append(out, boardTeamFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardTeamRepo) ListByBoard(ctx context.Context, boardID int) ([]*models.BoardTeam, error) {
+	dbs, err := p.queries.ListBoardTeamsByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardTeam
+	for _, d := range dbs {
+		out = append(out, boardTeamFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardTeamRepo) Get(ctx context.Context, id int) (*models.BoardTeam, error) {
+	d, err := p.queries.GetBoardTeam(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrBoardTeamNotFound
+		}
+		return nil, err
+	}
+
+	return boardTeamFromPG(d), nil
+}
+
+func (p *BoardTeamRepo) Upsert(ctx context.Context, b *models.BoardTeam) (*models.BoardTeam, error) {
+	d, err := p.queries.UpsertBoardTeam(ctx, boardTeamToUpsertParams(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return boardTeamFromPG(d), nil
+}
+
+func (p *BoardTeamRepo) SoftDelete(ctx context.Context, id int) error {
+	return p.queries.SoftDeleteBoardTeam(ctx, id)
+}
+
+func (p *BoardTeamRepo) Delete(ctx context.Context, id int) error {
+	if err := p.queries.DeleteBoardTeam(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrBoardTeamNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func boardTeamToUpsertParams(b *models.BoardTeam) db.UpsertBoardTeamParams {
+	return db.UpsertBoardTeamParams{
+		ID:          b.ID,
+		BoardID:     b.BoardID,
+		Name:        b.Name,
+		LeaderID:    b.LeaderID,
+		DefaultTeam: b.DefaultTeam,
+	}
+}
+
+func boardTeamFromPG(pg *db.CwBoardTeam) *models.BoardTeam {
+	return &models.BoardTeam{
+		ID:          pg.ID,
+		BoardID:     pg.BoardID,
+		Name:        pg.Name,
+		LeaderID:    pg.LeaderID,
+		DefaultTeam: pg.DefaultTeam,
+		UpdatedOn:   pg.UpdatedOn,
+		AddedOn:     pg.AddedOn,
+		Deleted:     pg.Deleted,
+	}
+}
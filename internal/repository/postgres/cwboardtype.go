@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type BoardTypeRepo struct {
+	queries *db.Queries
+}
+
+func NewBoardTypeRepo(pool *pgxpool.Pool) *BoardTypeRepo {
+	return &BoardTypeRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *BoardTypeRepo) WithTx(tx pgx.Tx) models.BoardTypeRepository {
+	return &BoardTypeRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *BoardTypeRepo) List(ctx context.Context) ([]*models.BoardType, error) {
+	dbs, err := p.queries.ListAllBoardTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardType
+	for _, d := range dbs {
+		out = append(out, boardTypeFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardTypeRepo) ListByBoard(ctx context.Context, boardID int) ([]*models.BoardType, error) {
+	dbs, err := p.queries.ListBoardTypesByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.BoardType
+	for _, d := range dbs {
+		out = append(out, boardTypeFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *BoardTypeRepo) Get(ctx context.Context, id int) (*models.BoardType, error) {
+	d, err := p.queries.GetBoardType(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrBoardTypeNotFound
+		}
+		return nil, err
+	}
+
+	return boardTypeFromPG(d), nil
+}
+
+func (p *BoardTypeRepo) Upsert(ctx context.Context, b *models.BoardType) (*models.BoardType, error) {
+	d, err := p.queries.UpsertBoardType(ctx, boardTypeToUpsertParams(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return boardTypeFromPG(d), nil
+}
+
+func (p *BoardTypeRepo) SoftDelete(ctx context.Context, id int) error {
+	return p.queries.SoftDeleteBoardType(ctx, id)
+}
+
+func (p *BoardTypeRepo) Delete(ctx context.Context, id int) error {
+	if err := p.queries.DeleteBoardType(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrBoardTypeNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func boardTypeToUpsertParams(b *models.BoardType) db.UpsertBoardTypeParams {
+	return db.UpsertBoardTypeParams{
+		ID:          b.ID,
+		BoardID:     b.BoardID,
+		Name:        b.Name,
+		DefaultType: b.DefaultType,
+		Inactive:    b.Inactive,
+	}
+}
+
+func boardTypeFromPG(pg *db.CwBoardType) *models.BoardType {
+	return &models.BoardType{
+		ID:          pg.ID,
+		BoardID:     pg.BoardID,
+		Name:        pg.Name,
+		DefaultType: pg.DefaultType,
+		Inactive:    pg.Inactive,
+		UpdatedOn:   pg.UpdatedOn,
+		AddedOn:     pg.AddedOn,
+		Deleted:     pg.Deleted,
+	}
+}
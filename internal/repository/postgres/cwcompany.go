@@ -39,6 +39,20 @@ func (p *CompanyRepo) List(ctx context.Context) ([]*models.Company, error) {
 	return b, nil
 }
 
+func (p *CompanyRepo) Search(ctx context.Context, name string) ([]*models.Company, error) {
+	dbs, err := p.queries.SearchCompanies(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var b []*models.Company
+	for _, d := range dbs {
+		b = append(b, companyFromPG(d))
+	}
+
+	return b, nil
+}
+
 func (p *CompanyRepo) Get(ctx context.Context, id int) (*models.Company, error) {
 	d, err := p.queries.GetCompany(ctx, id)
 	if err != nil {
@@ -39,6 +39,20 @@ func (p *ContactRepo) List(ctx context.Context) ([]*models.Contact, error) {
 	return b, nil
 }
 
+func (p *ContactRepo) Search(ctx context.Context, name string) ([]*models.Contact, error) {
+	dbs, err := p.queries.SearchContacts(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var b []*models.Contact
+	for _, d := range dbs {
+		b = append(b, contactFromPG(d))
+	}
+
+	return b, nil
+}
+
 func (p *ContactRepo) Get(ctx context.Context, id int) (*models.Contact, error) {
 	d, err := p.queries.GetContact(ctx, id)
 	if err != nil {
@@ -63,6 +63,18 @@ func (p *MemberRepo) GetByIdentifier(ctx context.Context, identifier string) (*m
 	return memberFromPG(d), nil
 }
 
+func (p *MemberRepo) GetActiveByEmail(ctx context.Context, email string) (*models.Member, error) {
+	d, err := p.queries.GetActiveMemberByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrMemberNotFound
+		}
+		return nil, err
+	}
+
+	return memberFromPG(d), nil
+}
+
 func (p *MemberRepo) Upsert(ctx context.Context, b *models.Member) (*models.Member, error) {
 	d, err := p.queries.UpsertMember(ctx, memberToUpsertParams(b))
 	if err != nil {
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type PriorityRepo struct {
+	queries *db.Queries
+}
+
+func NewPriorityRepo(pool *pgxpool.Pool) *PriorityRepo {
+	return &PriorityRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *PriorityRepo) WithTx(tx pgx.Tx) models.PriorityRepository {
+	return &PriorityRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *PriorityRepo) List(ctx context.Context) ([]*models.Priority, error) {
+	dbs, err := p.queries.ListAllPriorities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*models.Priority
+	for _, d := range dbs {
+		out = append(out, priorityFromPG(d))
+	}
+
+	return out, nil
+}
+
+func (p *PriorityRepo) Get(ctx context.Context, id int) (*models.Priority, error) {
+	d, err := p.queries.GetPriority(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrPriorityNotFound
+		}
+		return nil, err
+	}
+
+	return priorityFromPG(d), nil
+}
+
+func (p *PriorityRepo) Upsert(ctx context.Context, pr *models.Priority) (*models.Priority, error) {
+	d, err := p.queries.UpsertPriority(ctx, priorityToUpsertParams(pr))
+	if err != nil {
+		return nil, err
+	}
+
+	return priorityFromPG(d), nil
+}
+
+func (p *PriorityRepo) SoftDelete(ctx context.Context, id int) error {
+	return p.queries.SoftDeletePriority(ctx, id)
+}
+
+func (p *PriorityRepo) Delete(ctx context.Context, id int) error {
+	if err := p.queries.DeletePriority(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrPriorityNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func priorityToUpsertParams(pr *models.Priority) db.UpsertPriorityParams {
+	return db.UpsertPriorityParams{
+		ID:              pr.ID,
+		Name:            pr.Name,
+		Color:           pr.Color,
+		SortOrder:       pr.SortOrder,
+		DefaultPriority: pr.DefaultPriority,
+	}
+}
+
+func priorityFromPG(pg *db.CwPriority) *models.Priority {
+	return &models.Priority{
+		ID:              pg.ID,
+		Name:            pg.Name,
+		Color:           pg.Color,
+		SortOrder:       pg.SortOrder,
+		DefaultPriority: pg.DefaultPriority,
+		UpdatedOn:       pg.UpdatedOn,
+		AddedOn:         pg.AddedOn,
+		Deleted:         pg.Deleted,
+	}
+}
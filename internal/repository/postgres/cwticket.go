@@ -40,6 +40,59 @@ func (p *TicketRepo) List(ctx context.Context) ([]*models.Ticket, error) {
 	return b, nil
 }
 
+func (p *TicketRepo) Query(ctx context.Context, f models.TicketFilter) ([]*models.Ticket, error) {
+	dm, err := p.queries.QueryTickets(ctx, db.QueryTicketsParams{
+		Cursor:       f.Cursor,
+		BoardID:      f.BoardID,
+		StatusID:     f.StatusID,
+		OwnerID:      f.OwnerID,
+		CompanyID:    f.CompanyID,
+		UpdatedSince: f.UpdatedSince,
+		Closed:       f.Closed,
+		PageSize:     f.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var b []*models.Ticket
+	for _, d := range dm {
+		b = append(b, ticketFromPG(d))
+	}
+
+	return b, nil
+}
+
+func (p *TicketRepo) Search(ctx context.Context, query string, limit int) ([]*models.TicketSearchResult, error) {
+	dm, err := p.queries.SearchTickets(ctx, db.SearchTicketsParams{
+		Query:      query,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var r []*models.TicketSearchResult
+	for _, d := range dm {
+		r = append(r, &models.TicketSearchResult{
+			ID:          d.ID,
+			Summary:     d.Summary,
+			BoardID:     d.BoardID,
+			BoardName:   d.BoardName,
+			StatusID:    d.StatusID,
+			StatusName:  d.StatusName,
+			Closed:      d.Closed,
+			CompanyID:   d.CompanyID,
+			CompanyName: d.CompanyName,
+			UpdatedOn:   d.UpdatedOn,
+			Rank:        d.Rank,
+			Snippet:     d.Snippet,
+		})
+	}
+
+	return r, nil
+}
+
 func (p *TicketRepo) Get(ctx context.Context, id int) (*models.Ticket, error) {
 	d, err := p.queries.GetTicket(ctx, id)
 	if err != nil {
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type DeviceLoginRepo struct {
+	queries *db.Queries
+}
+
+func NewDeviceLoginRepo(pool *pgxpool.Pool) *DeviceLoginRepo {
+	return &DeviceLoginRepo{queries: db.New(pool)}
+}
+
+func (p *DeviceLoginRepo) WithTx(tx pgx.Tx) models.DeviceLoginRepository {
+	return &DeviceLoginRepo{queries: db.New(tx)}
+}
+
+func (p *DeviceLoginRepo) GetByDeviceCode(ctx context.Context, hash []byte) (*models.DeviceLogin, error) {
+	return deviceLoginOrNotFound(p.queries.GetDeviceLoginByDeviceCode(ctx, hash))
+}
+
+func (p *DeviceLoginRepo) GetByUserCode(ctx context.Context, code string) (*models.DeviceLogin, error) {
+	return deviceLoginOrNotFound(p.queries.GetDeviceLoginByUserCode(ctx, code))
+}
+
+func (p *DeviceLoginRepo) GetByState(ctx context.Context, state string) (*models.DeviceLogin, error) {
+	return deviceLoginOrNotFound(p.queries.GetDeviceLoginByState(ctx, state))
+}
+
+func (p *DeviceLoginRepo) Insert(ctx context.Context, d *models.DeviceLogin) (*models.DeviceLogin, error) {
+	dl, err := p.queries.InsertDeviceLogin(ctx, db.InsertDeviceLoginParams{
+		DeviceCodeHash: d.DeviceCodeHash,
+		UserCode:       d.UserCode,
+		State:          d.State,
+		ExpiresOn:      d.ExpiresOn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deviceLoginFromPG(dl), nil
+}
+
+// Approve links a pending login to the user. It returns models.ErrDeviceLoginNotFound if the
+// login doesn't exist or was already approved or denied.
+func (p *DeviceLoginRepo) Approve(ctx context.Context, id, userID int) (*models.DeviceLogin, error) {
+	return deviceLoginOrNotFound(p.queries.ApproveDeviceLogin(ctx, db.ApproveDeviceLoginParams{ID: id, UserID: &userID}))
+}
+
+// Deny marks a pending login as denied. It returns models.ErrDeviceLoginNotFound if the login
+// doesn't exist or was already approved or denied.
+func (p *DeviceLoginRepo) Deny(ctx context.Context, id int, reason string) (*models.DeviceLogin, error) {
+	return deviceLoginOrNotFound(p.queries.DenyDeviceLogin(ctx, db.DenyDeviceLoginParams{ID: id, DeniedReason: &reason}))
+}
+
+func (p *DeviceLoginRepo) Delete(ctx context.Context, id int) error {
+	return p.queries.DeleteDeviceLogin(ctx, id)
+}
+
+func (p *DeviceLoginRepo) DeleteExpired(ctx context.Context) error {
+	return p.queries.DeleteExpiredDeviceLogins(ctx)
+}
+
+func deviceLoginOrNotFound(d *db.DeviceLogin, err error) (*models.DeviceLogin, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrDeviceLoginNotFound
+		}
+		return nil, err
+	}
+
+	return deviceLoginFromPG(d), nil
+}
+
+func deviceLoginFromPG(pg *db.DeviceLogin) *models.DeviceLogin {
+	return &models.DeviceLogin{
+		ID:             pg.ID,
+		DeviceCodeHash: pg.DeviceCodeHash,
+		UserCode:       pg.UserCode,
+		State:          pg.State,
+		UserID:         pg.UserID,
+		DeniedReason:   pg.DeniedReason,
+		ExpiresOn:      pg.ExpiresOn,
+		CreatedOn:      pg.CreatedOn,
+	}
+}
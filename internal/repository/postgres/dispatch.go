@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type DispatchNotificationRepo struct {
+	queries *db.Queries
+}
+
+func NewDispatchNotificationRepo(pool *pgxpool.Pool) *DispatchNotificationRepo {
+	return &DispatchNotificationRepo{queries: db.New(pool)}
+}
+
+func (p *DispatchNotificationRepo) WithTx(tx pgx.Tx) models.DispatchNotificationRepository {
+	return &DispatchNotificationRepo{queries: db.New(tx)}
+}
+
+func (p *DispatchNotificationRepo) Claim(ctx context.Context, n *models.DispatchNotification) (*models.DispatchNotification, error) {
+	d, err := p.queries.ClaimDispatchNotification(ctx, db.ClaimDispatchNotificationParams{
+		ScheduleEntryID: n.ScheduleEntryID,
+		TicketID:        n.TicketID,
+		MemberID:        n.MemberID,
+		DateStart:       n.DateStart.UTC(),
+		DateEnd:         utcPtr(n.DateEnd),
+		RecipientID:     n.RecipientID,
+		ForwardedFromID: n.ForwardedFromID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrDispatchNotificationClaimed
+		}
+		return nil, err
+	}
+
+	return dispatchNotificationFromPG(d), nil
+}
+
+func (p *DispatchNotificationRepo) MarkSent(ctx context.Context, id int) error {
+	return p.queries.MarkDispatchNotificationSent(ctx, id)
+}
+
+func (p *DispatchNotificationRepo) Release(ctx context.Context, id int) error {
+	return p.queries.ReleaseDispatchNotification(ctx, id)
+}
+
+func dispatchNotificationFromPG(pg *db.DispatchNotification) *models.DispatchNotification {
+	return &models.DispatchNotification{
+		ID:              pg.ID,
+		ScheduleEntryID: pg.ScheduleEntryID,
+		TicketID:        pg.TicketID,
+		MemberID:        pg.MemberID,
+		DateStart:       pg.DateStart,
+		DateEnd:         pg.DateEnd,
+		RecipientID:     pg.RecipientID,
+		ForwardedFromID: pg.ForwardedFromID,
+		Sent:            pg.Sent,
+		CreatedOn:       pg.CreatedOn,
+		ClaimedOn:       pg.ClaimedOn,
+	}
+}
+
+// utcPtr converts an optional time to UTC, since schedule times are stored without a zone.
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	u := t.UTC()
+	return &u
+}
@@ -93,6 +93,26 @@ func (p *UserForwardRepo) Insert(ctx context.Context, b *models.NotifierForward)
 	return forwardFromPG(d), nil
 }
 
+func (p *UserForwardRepo) Update(ctx context.Context, b *models.NotifierForward) (*models.NotifierForward, error) {
+	d, err := p.queries.UpdateNotifierForward(ctx, db.UpdateNotifierForwardParams{
+		ID:            b.ID,
+		SourceID:      b.SourceID,
+		DestinationID: b.DestID,
+		StartDate:     b.StartDate,
+		EndDate:       b.EndDate,
+		Enabled:       b.Enabled,
+		UserKeepsCopy: b.UserKeepsCopy,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrUserForwardNotFound
+		}
+		return nil, err
+	}
+
+	return forwardFromPG(d), nil
+}
+
 func (p *UserForwardRepo) Delete(ctx context.Context, id int) error {
 	if err := p.queries.DeleteNotifierForward(ctx, id); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// LockRepo takes session-level advisory locks. Since those belong to a connection rather than a
+// transaction, each held lock keeps its own connection out of the pool until it is released.
+type LockRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewLockRepo(pool *pgxpool.Pool) *LockRepo {
+	return &LockRepo{
+		pool: pool,
+	}
+}
+
+func (p *LockRepo) TryLock(ctx context.Context, class models.LockClass, objID int) (func(), bool, error) {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	q := db.New(conn)
+	params := db.TryAdvisoryLockParams{ClassID: int(class), ObjID: objID}
+	ok, err := q.TryAdvisoryLock(ctx, params)
+	if err != nil || !ok {
+		conn.Release()
+		return nil, false, err
+	}
+
+	release := func() {
+		// unlock even if the caller's context is done, or the lock would go back into the pool with the conn
+		ctx := context.WithoutCancel(ctx)
+		if _, err := q.AdvisoryUnlock(ctx, db.AdvisoryUnlockParams(params)); err != nil {
+			slog.Error("releasing advisory lock; closing connection", "class_id", class, "obj_id", objID, "error", err.Error())
+			conn.Hijack().Close(ctx)
+			return
+		}
+		conn.Release()
+	}
+
+	return release, true, nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type LoginSessionRepo struct {
+	queries *db.Queries
+}
+
+func NewLoginSessionRepo(pool *pgxpool.Pool) *LoginSessionRepo {
+	return &LoginSessionRepo{queries: db.New(pool)}
+}
+
+func (p *LoginSessionRepo) WithTx(tx pgx.Tx) models.LoginSessionRepository {
+	return &LoginSessionRepo{queries: db.New(tx)}
+}
+
+func (p *LoginSessionRepo) GetByTokenHash(ctx context.Context, hash []byte) (*models.LoginSession, error) {
+	return loginSessionOrNotFound(p.queries.GetLoginSessionByTokenHash(ctx, hash))
+}
+
+func (p *LoginSessionRepo) GetByRefreshHash(ctx context.Context, hash []byte) (*models.LoginSession, error) {
+	return loginSessionOrNotFound(p.queries.GetLoginSessionByRefreshHash(ctx, hash))
+}
+
+func (p *LoginSessionRepo) Insert(ctx context.Context, s *models.LoginSession) (*models.LoginSession, error) {
+	d, err := p.queries.InsertLoginSession(ctx, db.InsertLoginSessionParams{
+		UserID:           s.UserID,
+		TokenHash:        s.TokenHash,
+		RefreshHash:      s.RefreshHash,
+		ExpiresOn:        s.ExpiresOn,
+		RefreshExpiresOn: s.RefreshExpiresOn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loginSessionFromPG(d), nil
+}
+
+// Refresh replaces the session's tokens and expiry times.
+func (p *LoginSessionRepo) Refresh(ctx context.Context, s *models.LoginSession) (*models.LoginSession, error) {
+	return loginSessionOrNotFound(p.queries.RefreshLoginSession(ctx, db.RefreshLoginSessionParams{
+		ID:               s.ID,
+		TokenHash:        s.TokenHash,
+		RefreshHash:      s.RefreshHash,
+		ExpiresOn:        s.ExpiresOn,
+		RefreshExpiresOn: s.RefreshExpiresOn,
+	}))
+}
+
+func (p *LoginSessionRepo) Delete(ctx context.Context, id int) error {
+	return p.queries.DeleteLoginSession(ctx, id)
+}
+
+func (p *LoginSessionRepo) DeleteExpired(ctx context.Context) error {
+	return p.queries.DeleteExpiredLoginSessions(ctx)
+}
+
+func loginSessionOrNotFound(d *db.LoginSession, err error) (*models.LoginSession, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrLoginSessionNotFound
+		}
+		return nil, err
+	}
+
+	return loginSessionFromPG(d), nil
+}
+
+func loginSessionFromPG(pg *db.LoginSession) *models.LoginSession {
+	return &models.LoginSession{
+		ID:               pg.ID,
+		UserID:           pg.UserID,
+		TokenHash:        pg.TokenHash,
+		RefreshHash:      pg.RefreshHash,
+		ExpiresOn:        pg.ExpiresOn,
+		RefreshExpiresOn: pg.RefreshExpiresOn,
+		CreatedOn:        pg.CreatedOn,
+		UpdatedOn:        pg.UpdatedOn,
+	}
+}
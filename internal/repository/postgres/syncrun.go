@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type SyncRunRepo struct {
+	queries *db.Queries
+}
+
+func NewSyncRunRepo(pool *pgxpool.Pool) *SyncRunRepo {
+	return &SyncRunRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *SyncRunRepo) WithTx(tx pgx.Tx) models.SyncRunRepository {
+	return &SyncRunRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *SyncRunRepo) List(ctx context.Context, limit int) ([]*models.SyncRun, error) {
+	dr, err := p.queries.ListSyncRuns(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var r []*models.SyncRun
+	for _, d := range dr {
+		run, err := syncRunFromPG(d)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, run)
+	}
+
+	return r, nil
+}
+
+func (p *SyncRunRepo) Get(ctx context.Context, id int) (*models.SyncRun, error) {
+	d, err := p.queries.GetSyncRun(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrSyncRunNotFound
+		}
+		return nil, err
+	}
+
+	return syncRunFromPG(d)
+}
+
+func (p *SyncRunRepo) Insert(ctx context.Context, r *models.SyncRun) (*models.SyncRun, error) {
+	payload, err := json.Marshal(r.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling sync payload: %w", err)
+	}
+
+	d, err := p.queries.InsertSyncRun(ctx, db.InsertSyncRunParams{
+		Payload: payload,
+		Status:  string(r.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return syncRunFromPG(d)
+}
+
+func (p *SyncRunRepo) Update(ctx context.Context, r *models.SyncRun) (*models.SyncRun, error) {
+	results, err := json.Marshal(r.Results)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling sync results: %w", err)
+	}
+
+	errs := r.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+
+	d, err := p.queries.UpdateSyncRun(ctx, db.UpdateSyncRunParams{
+		ID:         r.ID,
+		Status:     string(r.Status),
+		Results:    results,
+		Errors:     errs,
+		FinishedOn: r.FinishedOn,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrSyncRunNotFound
+		}
+		return nil, err
+	}
+
+	return syncRunFromPG(d)
+}
+
+func syncRunFromPG(pg *db.SyncRun) (*models.SyncRun, error) {
+	r := &models.SyncRun{
+		ID:         pg.ID,
+		Status:     models.SyncRunStatus(pg.Status),
+		Errors:     pg.Errors,
+		StartedOn:  pg.StartedOn,
+		FinishedOn: pg.FinishedOn,
+	}
+
+	if err := json.Unmarshal(pg.Payload, &r.Payload); err != nil {
+		return nil, fmt.Errorf("unmarshaling sync payload for run %d: %w", pg.ID, err)
+	}
+
+	if err := json.Unmarshal(pg.Results, &r.Results); err != nil {
+		return nil, fmt.Errorf("unmarshaling sync results for run %d: %w", pg.ID, err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/db"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type SyncScheduleRepo struct {
+	queries *db.Queries
+}
+
+func NewSyncScheduleRepo(pool *pgxpool.Pool) *SyncScheduleRepo {
+	return &SyncScheduleRepo{
+		queries: db.New(pool),
+	}
+}
+
+func (p *SyncScheduleRepo) WithTx(tx pgx.Tx) models.SyncScheduleRepository {
+	return &SyncScheduleRepo{
+		queries: db.New(tx),
+	}
+}
+
+func (p *SyncScheduleRepo) List(ctx context.Context) ([]*models.SyncSchedule, error) {
+	ds, err := p.queries.ListSyncSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s []*models.SyncSchedule
+	for _, d := range ds {
+		sched, err := syncScheduleFromPG(d)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, sched)
+	}
+
+	return s, nil
+}
+
+func (p *SyncScheduleRepo) Get(ctx context.Context, id int) (*models.SyncSchedule, error) {
+	d, err := p.queries.GetSyncSchedule(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrSyncScheduleNotFound
+		}
+		return nil, err
+	}
+
+	return syncScheduleFromPG(d)
+}
+
+func (p *SyncScheduleRepo) Insert(ctx context.Context, s *models.SyncSchedule) (*models.SyncSchedule, error) {
+	payload, err := json.Marshal(s.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling sync payload: %w", err)
+	}
+
+	d, err := p.queries.InsertSyncSchedule(ctx, db.InsertSyncScheduleParams{
+		Name:    s.Name,
+		Cron:    s.Cron,
+		Payload: payload,
+		Enabled: s.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return syncScheduleFromPG(d)
+}
+
+func (p *SyncScheduleRepo) Update(ctx context.Context, s *models.SyncSchedule) (*models.SyncSchedule, error) {
+	payload, err := json.Marshal(s.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling sync payload: %w", err)
+	}
+
+	d, err := p.queries.UpdateSyncSchedule(ctx, db.UpdateSyncScheduleParams{
+		ID:      s.ID,
+		Name:    s.Name,
+		Cron:    s.Cron,
+		Payload: payload,
+		Enabled: s.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrSyncScheduleNotFound
+		}
+		return nil, err
+	}
+
+	return syncScheduleFromPG(d)
+}
+
+func (p *SyncScheduleRepo) Delete(ctx context.Context, id int) error {
+	return p.queries.DeleteSyncSchedule(ctx, id)
+}
+
+func (p *SyncScheduleRepo) ClaimRun(ctx context.Context, id int, prev *time.Time, at time.Time) (*models.SyncSchedule, error) {
+	d, err := p.queries.ClaimSyncScheduleRun(ctx, db.ClaimSyncScheduleRunParams{
+		LastRunOn: &at,
+		ID:        id,
+		PrevRunOn: prev,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrSyncScheduleClaimed
+		}
+		return nil, err
+	}
+
+	return syncScheduleFromPG(d)
+}
+
+func syncScheduleFromPG(pg *db.SyncSchedule) (*models.SyncSchedule, error) {
+	s := &models.SyncSchedule{
+		ID:        pg.ID,
+		Name:      pg.Name,
+		Cron:      pg.Cron,
+		Enabled:   pg.Enabled,
+		LastRunOn: pg.LastRunOn,
+		CreatedOn: pg.CreatedOn,
+		UpdatedOn: pg.UpdatedOn,
+	}
+
+	if err := json.Unmarshal(pg.Payload, &s.Payload); err != nil {
+		return nil, fmt.Errorf("unmarshaling sync payload for schedule %d: %w", pg.ID, err)
+	}
+
+	return s, nil
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/thecoretg/ticketbot/internal/mock"
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/user"
 	"github.com/thecoretg/ticketbot/pkg/psa"
 	"github.com/thecoretg/ticketbot/pkg/webex"
 )
@@ -21,6 +26,7 @@ type Creds struct {
 	WebexBotEmail     string
 	WebexHooksSecret  string
 	CWCreds           *psa.Creds
+	OAuth             *user.OAuthConfig
 }
 
 type TestFlags struct {
@@ -29,6 +35,13 @@ type TestFlags struct {
 	SkipHooks       bool
 	MockWebex       bool
 	StoreTTLSeconds int64
+
+	// MockWebexAddr is where the fake Webex API listens when MockWebex is set. If empty, it
+	// listens on a random local port.
+	MockWebexAddr string
+
+	// MockWebexFixtures is a JSON file of rooms and people for the fake Webex to serve.
+	MockWebexFixtures string
 }
 
 func getCreds() *Creds {
@@ -39,11 +52,25 @@ func getCreds() *Creds {
 		WebexAPISecret:    os.Getenv("WEBEX_SECRET"),
 		WebexBotEmail:     os.Getenv("WEBEX_BOT_EMAIL"),
 		WebexHooksSecret:  os.Getenv("WEBEX_HOOKS_SECRET"),
+		OAuth: &user.OAuthConfig{
+			Provider:     os.Getenv("OAUTH_PROVIDER"),
+			ClientID:     os.Getenv("OAUTH_CLIENT_ID"),
+			ClientSecret: os.Getenv("OAUTH_CLIENT_SECRET"),
+			Issuer:       os.Getenv("OAUTH_ISSUER"),
+			AuthURL:      os.Getenv("OAUTH_AUTH_URL"),
+			TokenURL:     os.Getenv("OAUTH_TOKEN_URL"),
+			UserInfoURL:  os.Getenv("OAUTH_USERINFO_URL"),
+			Scopes:       strings.Fields(os.Getenv("OAUTH_SCOPES")),
+			RootURL:      os.Getenv("ROOT_URL"),
+		},
 		CWCreds: &psa.Creds{
 			PublicKey:  os.Getenv("CW_PUB_KEY"),
 			PrivateKey: os.Getenv("CW_PRIV_KEY"),
 			ClientId:   os.Getenv("CW_CLIENT_ID"),
 			CompanyId:  os.Getenv("CW_COMPANY_ID"),
+			Site:       os.Getenv("CW_SITE"),
+			Codebase:   os.Getenv("CW_CODEBASE"),
+			APIVersion: os.Getenv("CW_API_VERSION"),
 		},
 	}
 }
@@ -79,10 +106,20 @@ func (c *Creds) validate(tf *TestFlags) error {
 		}
 	}
 
-	if c.WebexAPISecret == "" {
+	if c.WebexAPISecret == "" && !tf.MockWebex {
 		empty = append(empty, "WEBEX_SECRET")
 	}
 
+	if c.OAuth.Enabled() {
+		if c.OAuth.ClientSecret == "" {
+			empty = append(empty, "OAUTH_CLIENT_SECRET")
+		}
+
+		if c.RootURL == "" && !slices.Contains(empty, "ROOT_URL") {
+			empty = append(empty, "ROOT_URL")
+		}
+	}
+
 	for k, v := range cwVals {
 		if v == "" {
 			empty = append(empty, k)
@@ -105,11 +142,16 @@ func getStartupConfig(ctx context.Context, r models.ConfigRepository) (*models.C
 	cfg, _ := r.Get(ctx)
 	if cfg == nil {
 		slog.Info("no config found in store; using default")
-		cfg = &models.DefaultConfig
+		d := models.DefaultConfig
+		cfg = &d
 	}
 
 	// load any overrides from env, then upsert in store
 	cfg = loadEnvConfig(cfg)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
 	cfg, err = r.Upsert(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("upserting config: %w", err)
@@ -118,13 +160,39 @@ func getStartupConfig(ctx context.Context, r models.ConfigRepository) (*models.C
 	return cfg, nil
 }
 
-func makeMessageSender(mocking bool, webexSecret string) models.MessageSender {
-	if mocking {
-		slog.Info("running with webex mocking")
-		return mock.NewWebexClient(webexSecret)
+// startMockWebex starts the offline fake Webex API in the background and returns it along with
+// a webex client pointed at it.
+func startMockWebex(tf *TestFlags, webexSecret string) (*mock.WebexClient, *webex.Client, error) {
+	f := &mock.Fixtures{}
+	if tf.MockWebexFixtures != "" {
+		var err error
+		f, err = mock.LoadFixtures(tf.MockWebexFixtures)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	addr := tf.MockWebexAddr
+	if addr == "" {
+		addr = "127.0.0.1:0"
 	}
 
-	return webex.NewClient(webexSecret)
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listening on %s: %w", addr, err)
+	}
+
+	mw := mock.NewWebexClient(f)
+	go func() {
+		if err := http.Serve(ln, mw.Handler()); err != nil {
+			slog.Error("mock webex: server stopped", "error", err.Error())
+		}
+	}()
+
+	u := fmt.Sprintf("http://%s/v1", ln.Addr().String())
+	slog.Info("running with webex mocking", "url", u, "rooms", len(f.Rooms), "people", len(f.People))
+
+	return mw, webex.NewClient(webexSecret, webex.WithBaseURL(u)), nil
 }
 
 // loadEnvConfig takes any explicitly set config values from env variables
@@ -137,6 +205,8 @@ func loadEnvConfig(current *models.Config) *models.Config {
 		maxLen          *int
 		maxConSyncs     *int
 		skipLaunchSyncs *bool
+		reconInterval   *int
+		reconLookback   *int
 	)
 
 	switch os.Getenv("ATTEMPT_NOTIFY") {
@@ -169,6 +239,18 @@ func loadEnvConfig(current *models.Config) *models.Config {
 		maxConSyncs = &v
 	}
 
+	riInt, err := strconv.Atoi(os.Getenv("RECONCILE_INTERVAL_MINUTES"))
+	if err == nil {
+		v := riInt
+		reconInterval = &v
+	}
+
+	rlInt, err := strconv.Atoi(os.Getenv("RECONCILE_LOOKBACK_MINUTES"))
+	if err == nil {
+		v := rlInt
+		reconLookback = &v
+	}
+
 	if skipLaunchSyncs != nil {
 		slog.Info("SKIP_LAUNCH_SYNCS set via env", "value", *skipLaunchSyncs)
 		current.SkipLaunchSyncs = *skipLaunchSyncs
@@ -189,6 +271,16 @@ func loadEnvConfig(current *models.Config) *models.Config {
 		current.MaxConcurrentSyncs = *maxConSyncs
 	}
 
+	if reconInterval != nil {
+		slog.Info("RECONCILE_INTERVAL_MINUTES set via env", "value", *reconInterval)
+		current.ReconcileIntervalMinutes = *reconInterval
+	}
+
+	if reconLookback != nil {
+		slog.Info("RECONCILE_LOOKBACK_MINUTES set via env", "value", *reconLookback)
+		current.ReconcileLookbackMinutes = *reconLookback
+	}
+
 	return current
 }
 
@@ -212,10 +304,12 @@ func getTestFlags() *TestFlags {
 	}
 
 	return &TestFlags{
-		APIKey:          apiKey,
-		SkipAuth:        os.Getenv("SKIP_AUTH") == "true",
-		SkipHooks:       os.Getenv("SKIP_HOOKS") == "true",
-		MockWebex:       os.Getenv("MOCK_WEBEX") == "true",
-		StoreTTLSeconds: ttl,
+		APIKey:            apiKey,
+		SkipAuth:          os.Getenv("SKIP_AUTH") == "true",
+		SkipHooks:         os.Getenv("SKIP_HOOKS") == "true",
+		MockWebex:         os.Getenv("MOCK_WEBEX") == "true",
+		StoreTTLSeconds:   ttl,
+		MockWebexAddr:     os.Getenv("MOCK_WEBEX_ADDR"),
+		MockWebexFixtures: os.Getenv("MOCK_WEBEX_FIXTURES"),
 	}
 }
@@ -0,0 +1,430 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/thecoretg/ticketbot/cmd/common"
+	"github.com/thecoretg/ticketbot/internal/mock"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/repository/postgres"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
+	"github.com/thecoretg/ticketbot/internal/service/config"
+	"github.com/thecoretg/ticketbot/internal/service/cwsvc"
+	"github.com/thecoretg/ticketbot/internal/service/notifier"
+	"github.com/thecoretg/ticketbot/internal/service/syncsvc"
+	"github.com/thecoretg/ticketbot/internal/service/ticketbot"
+	"github.com/thecoretg/ticketbot/internal/service/webexsvc"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+	"github.com/thecoretg/ticketbot/pkg/psa/psatest"
+	"github.com/thecoretg/ticketbot/pkg/webex"
+)
+
+// The end to end tests run the services against the fake Connectwise and Webex APIs and a real
+// Postgres database. They are skipped unless this is set to the DSN of a database the tests can
+// create schemas in; each test migrates its own schema and drops it when done.
+const testDSNEnv = "TICKETBOT_TEST_POSTGRES_DSN"
+
+const (
+	testRoomID      = "room-1"
+	testMemberEmail = "jdoe@example.com"
+)
+
+type testApp struct {
+	CW        *psatest.Server
+	Webex     *mock.WebexClient
+	Repos     *models.AllRepos
+	CWSvc     *cwsvc.Service
+	Notifier  *notifier.Service
+	Sync      *syncsvc.Service
+	Ticketbot *ticketbot.Service
+}
+
+// testPool connects to the test database with a fresh, migrated schema as the search path.
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s not set; skipping end to end test", testDSNEnv)
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("ticketbot_test_%d", time.Now().UnixNano())
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connecting to test database: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("creating schema: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(ctx, dsn)
+		if err != nil {
+			t.Errorf("connecting to drop schema: %v", err)
+			return
+		}
+		defer conn.Close(ctx)
+
+		if _, err := conn.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("dropping schema: %v", err)
+		}
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("parsing dsn: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["timezone"] = "UTC"
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("creating pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	if err := GooseMigrate(stdlib.OpenDBFromPool(pool), common.GooseMigrationVersion); err != nil {
+		t.Fatalf("migrating: %v", err)
+	}
+
+	return pool
+}
+
+// newTestApp wires the services the same way NewApp does, with notifications enabled.
+func newTestApp(t *testing.T) *testApp {
+	t.Helper()
+	pool := testPool(t)
+
+	cw := psatest.NewServer()
+	t.Cleanup(cw.Close)
+
+	wx := mock.NewWebexClient(&mock.Fixtures{
+		Rooms:  []webex.Room{{ID: testRoomID, Title: "Help Desk", Type: "group"}},
+		People: []webex.Person{{ID: "person-1", Emails: []string{testMemberEmail}, DisplayName: "Jane Doe"}},
+	})
+
+	cfg := models.DefaultConfig
+	cfg.AttemptNotify = true
+
+	r := postgres.AllRepos(pool)
+	cl := cw.Client()
+	as := audit.New(r.AuditEvents)
+	cs := config.New(r.Config, &cfg, as)
+	cws := cwsvc.New(pool, r.CW, cl, defaultStoreTTL)
+	ws := webexsvc.New(pool, r.WebexRecipients, wx, "bot@example.com")
+	ns := notifier.New(notifier.SvcParams{
+		Cfg:           cs,
+		WebexSvc:      ws,
+		NotifierRules: r.NotifierRules,
+		Notifications: r.TicketNotifications,
+		Dispatches:    r.DispatchNotifications,
+		Forwards:      r.NotifierForwards,
+		Pool:          pool,
+		MessageSender: wx,
+		CWClient:      cl,
+		Audit:         as,
+	})
+
+	return &testApp{
+		CW:        cw,
+		Webex:     wx,
+		Repos:     r,
+		CWSvc:     cws,
+		Notifier:  ns,
+		Sync:      syncsvc.New(pool, cs, cws, ws, ns, r.SyncRuns, as),
+		Ticketbot: ticketbot.New(cs, cws, ns),
+	}
+}
+
+// seedBoard adds a board with a status to the fake and syncs it, and adds a rule sending its
+// new tickets to the test room. It returns the board and status IDs.
+func (a *testApp) seedBoard(t *testing.T, name string) (int, int) {
+	t.Helper()
+	ctx := context.Background()
+
+	b := a.CW.AddBoard(psa.Board{Name: name})
+	st := a.CW.AddBoardStatus(b.ID, psa.BoardStatus{Name: "New"})
+
+	if err := a.Sync.Sync(ctx, &models.SyncPayload{CWBoards: true}); err != nil {
+		t.Fatalf("syncing boards: %v", err)
+	}
+
+	room, err := a.Repos.WebexRecipients.Upsert(ctx, &models.WebexRecipient{
+		WebexID: testRoomID,
+		Name:    "Help Desk",
+		Type:    models.RecipientTypeRoom,
+	})
+	if err != nil {
+		t.Fatalf("adding room: %v", err)
+	}
+
+	if _, err := a.Repos.NotifierRules.Insert(ctx, &models.NotifierRule{
+		CwBoardID:        b.ID,
+		WebexRecipientID: room.ID,
+		NotifyEnabled:    true,
+	}); err != nil {
+		t.Fatalf("adding notifier rule: %v", err)
+	}
+
+	return b.ID, st.ID
+}
+
+func (a *testApp) addTicket(boardID, statusID int, summary string) *psa.Ticket {
+	co := a.CW.AddCompany(psa.Company{Identifier: "acme", Name: "Acme"})
+
+	tk := psa.Ticket{Summary: summary}
+	tk.Board.ID = boardID
+	tk.Status.ID = statusID
+	tk.Company.ID = co.Id
+	return a.CW.AddTicket(tk)
+}
+
+func TestE2ESyncBoards(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+
+	keep := a.CW.AddBoard(psa.Board{Name: "Help Desk"})
+	gone := a.CW.AddBoard(psa.Board{Name: "Projects"})
+	a.CW.AddBoardStatus(keep.ID, psa.BoardStatus{Name: "New"})
+	a.CW.AddBoardStatus(keep.ID, psa.BoardStatus{Name: "Closed", ClosedStatus: true})
+
+	if err := a.Sync.Sync(ctx, &models.SyncPayload{CWBoards: true}); err != nil {
+		t.Fatalf("first sync: %v", err)
+	}
+
+	boards, err := a.Repos.CW.Board.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(boards) != 2 {
+		t.Fatalf("store has %d boards, want 2", len(boards))
+	}
+
+	statuses, err := a.Repos.CW.TicketStatus.ListByBoard(ctx, keep.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(statuses) != 2 {
+		t.Errorf("store has %d statuses for board %d, want 2", len(statuses), keep.ID)
+	}
+
+	a.CW.Delete(psatest.BoardsEndpoint, gone.ID)
+	if err := a.Sync.Sync(ctx, &models.SyncPayload{CWBoards: true}); err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+
+	b, err := a.Repos.CW.Board.Get(ctx, gone.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !b.Deleted {
+		t.Errorf("board %d was removed from connectwise but isn't deleted in the store", gone.ID)
+	}
+
+	runs, err := a.Sync.ListRuns(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runs) != 1 || runs[0].Status != models.SyncRunStatusSucceeded {
+		t.Errorf("latest run = %+v, want a succeeded run", runs)
+	}
+}
+
+func TestE2ETicketNotifications(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	boardID, statusID := a.seedBoard(t, "Help Desk")
+
+	tk := a.addTicket(boardID, statusID, "Printer on fire")
+	a.CW.AddTicketNote(tk.ID, psa.ServiceTicketNote{Text: "It's really on fire"})
+
+	// a new ticket goes to the board's room
+	if err := a.Ticketbot.ProcessTicket(ctx, tk.ID); err != nil {
+		t.Fatalf("processing new ticket: %v", err)
+	}
+
+	msgs := a.Webex.Messages()
+	if len(msgs) != 1 || msgs[0].RoomID != testRoomID {
+		t.Fatalf("messages after new ticket = %+v, want one to %s", msgs, testRoomID)
+	}
+
+	stored, err := a.CWSvc.GetFullTicket(ctx, tk.ID)
+	if err != nil {
+		t.Fatalf("getting stored ticket: %v", err)
+	}
+
+	if stored.Ticket.Summary != tk.Summary || stored.Board.ID != boardID {
+		t.Errorf("stored ticket = %+v, want %q on board %d", stored.Ticket, tk.Summary, boardID)
+	}
+
+	// the same webhook again doesn't notify twice
+	if err := a.Ticketbot.ProcessTicket(ctx, tk.ID); err != nil {
+		t.Fatalf("processing ticket again: %v", err)
+	}
+
+	if n := len(a.Webex.Messages()); n != 1 {
+		t.Errorf("processing an unchanged ticket sent %d more messages", n-1)
+	}
+
+	// a new note goes to the ticket's resources, not the room
+	a.CW.AddMember(psa.Member{Identifier: "jdoe", FirstName: "Jane", LastName: "Doe", PrimaryEmail: testMemberEmail})
+	if _, err := a.CW.Put(fmt.Sprintf("%s/%d", psatest.TicketsEndpoint, tk.ID), func() psa.Ticket {
+		updated := *tk
+		updated.Resources = "jdoe"
+		return updated
+	}()); err != nil {
+		t.Fatal(err)
+	}
+	a.CW.AddTicketNote(tk.ID, psa.ServiceTicketNote{Text: "Now it's out"})
+
+	if err := a.Ticketbot.ProcessTicket(ctx, tk.ID); err != nil {
+		t.Fatalf("processing updated ticket: %v", err)
+	}
+
+	msgs = a.Webex.Messages()
+	if len(msgs) != 2 || msgs[1].ToPersonEmail != testMemberEmail {
+		t.Errorf("messages after new note = %+v, want a second one to %s", msgs, testMemberEmail)
+	}
+}
+
+func TestE2EScheduleEntry(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	boardID, statusID := a.seedBoard(t, "Help Desk")
+	m := a.CW.AddMember(psa.Member{Identifier: "jdoe", FirstName: "Jane", LastName: "Doe", PrimaryEmail: testMemberEmail})
+
+	schedule := func(ticketID int) int {
+		e := psa.ScheduleEntry{ObjectID: ticketID, DateStart: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}
+		e.Type.Identifier = psa.ScheduleTypeServiceTicket
+		e.Member.ID = m.ID
+		return a.CW.AddScheduleEntry(e).ID
+	}
+
+	// a ticket scheduled before its own webhook arrives is still announced as new, once
+	first := a.addTicket(boardID, statusID, "Scheduled right away")
+	if err := a.Ticketbot.ProcessScheduleEntry(ctx, schedule(first.ID)); err != nil {
+		t.Fatalf("processing schedule entry: %v", err)
+	}
+
+	if err := a.Ticketbot.ProcessTicket(ctx, first.ID); err != nil {
+		t.Fatalf("processing ticket: %v", err)
+	}
+
+	var toRoom, toMember int
+	for _, msg := range a.Webex.Messages() {
+		switch {
+		case msg.RoomID == testRoomID:
+			toRoom++
+		case msg.ToPersonEmail == testMemberEmail:
+			toMember++
+		}
+	}
+
+	if toRoom != 1 || toMember != 1 {
+		t.Errorf("sent %d new ticket and %d dispatch messages, want 1 of each", toRoom, toMember)
+	}
+
+	// scheduling a stored ticket reads it from the store rather than storing a newer copy
+	second := a.addTicket(boardID, statusID, "Original summary")
+	if err := a.Ticketbot.ProcessTicket(ctx, second.ID); err != nil {
+		t.Fatalf("processing ticket: %v", err)
+	}
+
+	if _, err := a.CW.Put(fmt.Sprintf("%s/%d", psatest.TicketsEndpoint, second.ID), func() psa.Ticket {
+		updated := *second
+		updated.Summary = "Changed in connectwise"
+		return updated
+	}()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Ticketbot.ProcessScheduleEntry(ctx, schedule(second.ID)); err != nil {
+		t.Fatalf("processing schedule entry: %v", err)
+	}
+
+	stored, err := a.Repos.CW.Ticket.Get(ctx, second.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stored.Summary != "Original summary" {
+		t.Errorf("stored summary = %q, want the schedule entry to leave it alone", stored.Summary)
+	}
+}
+
+// flakySender fails every message while down is set.
+type flakySender struct {
+	models.MessageSender
+	down atomic.Bool
+}
+
+func (f *flakySender) PostMessageCtx(ctx context.Context, message *webex.Message) (*webex.Message, error) {
+	if f.down.Load() {
+		return nil, errors.New("webex is down")
+	}
+
+	return f.MessageSender.PostMessageCtx(ctx, message)
+}
+
+func TestE2EDispatchRetriedAfterFailedSend(t *testing.T) {
+	ctx := context.Background()
+	a := newTestApp(t)
+	boardID, statusID := a.seedBoard(t, "Help Desk")
+	m := a.CW.AddMember(psa.Member{Identifier: "jdoe", FirstName: "Jane", LastName: "Doe", PrimaryEmail: testMemberEmail})
+
+	tk := a.addTicket(boardID, statusID, "Scheduled while webex is down")
+	if err := a.Ticketbot.ProcessTicket(ctx, tk.ID); err != nil {
+		t.Fatalf("processing ticket: %v", err)
+	}
+
+	e := psa.ScheduleEntry{ObjectID: tk.ID, DateStart: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}
+	e.Type.Identifier = psa.ScheduleTypeServiceTicket
+	e.Member.ID = m.ID
+	entryID := a.CW.AddScheduleEntry(e).ID
+
+	toMember := func() int {
+		n := 0
+		for _, msg := range a.Webex.Messages() {
+			if msg.ToPersonEmail == testMemberEmail {
+				n++
+			}
+		}
+		return n
+	}
+
+	sender := &flakySender{MessageSender: a.Webex}
+	a.Notifier.MessageSender = sender
+	sender.down.Store(true)
+
+	if err := a.Ticketbot.ProcessScheduleEntry(ctx, entryID); err == nil {
+		t.Fatal("expected an error while webex is down")
+	}
+
+	// the failed send doesn't count as notified, so the repeated callback sends it once
+	sender.down.Store(false)
+	for range 2 {
+		if err := a.Ticketbot.ProcessScheduleEntry(ctx, entryID); err != nil {
+			t.Fatalf("processing schedule entry: %v", err)
+		}
+	}
+
+	if n := toMember(); n != 1 {
+		t.Errorf("sent %d dispatch messages, want 1", n)
+	}
+}
@@ -1,93 +1,258 @@
 package server
 
 import (
+	"expvar"
+
 	"github.com/gin-gonic/gin"
 	"github.com/thecoretg/ticketbot/internal/handlers"
 	"github.com/thecoretg/ticketbot/internal/middleware"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/openapi"
 )
 
-func AddRoutes(a *App, g *gin.Engine) {
-	auth := middleware.APIKeyAuth(a.Svc.User.Keys)
+// apiVersion prefixes every API route. Health checks, metrics, webhooks, and the browser login
+// pages are outside it, since other systems have their URLs configured.
+const apiVersion = "v1"
+
+// AddRoutes registers every route and returns the API document built from them, which is also
+// served at /openapi.json.
+func AddRoutes(a *App, g *gin.Engine) *openapi.Document {
+	auth := middleware.APIKeyAuth(a.Svc.User)
+	adminWrites := middleware.RoleAccess(middleware.AllRoles, middleware.AdminRoles)
 
+	g.NoRoute(handlers.HandleNoRoute)
 	g.GET("healthcheck", handlers.HandleHealthCheck) // authless ping for lightsail health checks
-	g.GET("authtest", auth, handlers.HandleHealthCheck)
+	g.GET("metrics", auth, adminWrites, middleware.ScopeAccess(models.ScopeMetricsRead, models.ScopeMetricsRead), gin.WrapH(expvar.Handler()))
+
+	if a.MockWebex != nil {
+		// lets QA see what would have been sent to webex without reaching the fake's own port
+		g.GET("mock/messages", auth, adminWrites, gin.WrapH(a.MockWebex.Handler()))
+		g.DELETE("mock/messages", auth, adminWrites, gin.WrapH(a.MockWebex.Handler()))
+	}
+
+	doc := openapi.New("Ticketbot API", apiVersion, "/"+apiVersion, models.ErrorResponse{})
+	g.GET("openapi.json", handlers.HandleOpenAPI(doc))
+	// the unversioned paths are deprecated aliases, kept for clients that haven't moved to /v1
+	legacy := g.Group("", middleware.LegacyAlias("/"+apiVersion, legacyFields))
+	api := doc.Router(g.Group(apiVersion)).WithAlias(legacy)
 
+	api.Group("", auth).GET("authtest", handlers.HandleHealthCheck, openapi.Op{
+		ID: "authTest", Summary: "Check that the token is valid", Response: handlers.ResultOutput{},
+	})
+
+	s := api.Group("sync", auth, adminWrites, middleware.ScopeAccess(models.ScopeSyncRead, models.ScopeSyncRun))
 	sh := handlers.NewSyncHandler(a.Svc.Sync)
-	g.POST("sync", auth, sh.HandleSync)
+	registerSyncRoutes(s, sh)
+
+	// login routes are reached before the user has a token
+	lh := handlers.NewLoginHandler(a.Svc.User)
+	registerLoginRoutes(api.Group("auth"), g.Group("auth"), lh)
 
-	u := g.Group("users", auth)
+	u := api.Group("users", auth)
 	uh := handlers.NewUserHandler(a.Svc.User)
 	registerUserRoutes(u, uh)
 
-	c := g.Group("config", auth)
+	c := api.Group("config", auth, adminWrites, middleware.ScopeAccess(models.ScopeConfigRead, models.ScopeConfigWrite))
 	ch := handlers.NewConfigHandler(a.Svc.Config)
 	registerConfigRoutes(c, ch)
 
-	cw := g.Group("cw", auth)
+	sch := handlers.NewScheduleHandler(a.Svc.Scheduler)
+	registerScheduleRoutes(c.Group("schedules"), sch)
+
+	cw := api.Group("cw", auth, adminWrites, middleware.ScopeAccess(models.ScopeCWRead, models.ScopeCWRead))
 	cwh := handlers.NewCWHandler(a.Svc.CW)
 	registerCWRoutes(cw, cwh)
 
-	wx := g.Group("webex", auth)
+	wx := api.Group("webex", auth, adminWrites, middleware.ScopeAccess(models.ScopeWebexRead, models.ScopeWebexRead))
 	wh := handlers.NewWebexHandler(a.Svc.Webex)
 	registerWebexRoutes(wx, wh)
 
-	n := g.Group("notifiers", auth)
+	ad := api.Group("audit", auth, middleware.RequireRole(middleware.AdminRoles...), middleware.ScopeAccess(models.ScopeAuditRead, models.ScopeAuditRead))
+	adh := handlers.NewAuditHandler(a.Svc.Audit)
+	registerAuditRoutes(ad, adh)
+
+	n := api.Group("notifiers", auth)
 	nh := handlers.NewNotifierHandler(a.Svc.Notifier)
 	registerNotifierRoutes(n, nh)
 
 	tb := handlers.NewTicketbotHandler(a.Svc.Ticketbot)
+	bh := handlers.NewBotHandler(a.Svc.Bot)
 	hh := g.Group("hooks")
-	registerHookRoutes(hh, tb)
+	registerHookRoutes(hh, tb, bh, a.Creds.WebexHooksSecret)
+
+	return doc
+}
+
+// OpenAPI builds the API document without a running app, for generating the SDK's paths.
+func OpenAPI() *openapi.Document {
+	return AddRoutes(&App{Creds: &Creds{}, Svc: &Services{}}, gin.New())
 }
 
-func registerUserRoutes(r *gin.RouterGroup, h *handlers.UserHandler) {
-	r.GET("", h.ListUsers)
-	r.GET("me", h.GetCurrentUser)
-	r.GET(":id", h.GetUser)
-	r.POST("", h.CreateUser)
-	r.DELETE(":id", h.DeleteUser)
+func registerUserRoutes(r *openapi.Router, h *handlers.UserHandler) {
+	// any user can see themselves, but only admins can manage users and keys
+	r.GET("me", h.GetCurrentUser, openapi.Op{ID: "getCurrentUser", Summary: "Get the authenticated user", Response: models.APIUser{}})
+
+	r = r.Group("", middleware.RequireRole(middleware.AdminRoles...), middleware.ScopeAccess(models.ScopeUsersRead, models.ScopeUsersWrite))
+	r.GET("", h.ListUsers, openapi.Op{ID: "listUsers", Summary: "List users", Response: []models.APIUser{}})
+	r.GET(":id", h.GetUser, openapi.Op{ID: "getUser", Summary: "Get a user", Response: models.APIUser{}})
+	r.POST("", h.CreateUser, openapi.Op{ID: "createUser", Summary: "Create a user", Body: models.APIUser{}, Response: models.APIUser{}})
+	r.PUT(":id", h.UpdateUser, openapi.Op{ID: "updateUser", Summary: "Replace a user", Body: models.APIUser{}, Response: models.APIUser{}})
+	r.PATCH(":id", h.UpdateUser, openapi.Op{ID: "patchUser", Summary: "Change some of a user's fields", Body: models.APIUser{}, Response: models.APIUser{}})
+	r.DELETE(":id", h.DeleteUser, openapi.Op{ID: "deleteUser", Summary: "Delete a user"})
 
 	k := r.Group("keys")
-	k.GET("", h.ListAPIKeys)
-	k.GET(":id", h.GetAPIKey)
-	k.POST("", h.AddAPIKey)
-	k.DELETE(":id", h.DeleteAPIKey)
+	k.GET("", h.ListAPIKeys, openapi.Op{ID: "listAPIKeys", Summary: "List API keys", Response: []models.APIKey{}})
+	k.GET(":id", h.GetAPIKey, openapi.Op{ID: "getAPIKey", Summary: "Get an API key", Response: models.APIKey{}})
+	k.POST("", h.AddAPIKey, openapi.Op{ID: "createAPIKey", Summary: "Create an API key", Body: models.CreateAPIKeyPayload{}, Response: models.CreateAPIKeyResponse{}})
+	k.POST(":id/rotate", h.RotateAPIKey, openapi.Op{ID: "rotateAPIKey", Summary: "Replace an API key", Body: models.RotateAPIKeyPayload{}, Response: models.RotateAPIKeyResponse{}})
+	k.DELETE(":id", h.DeleteAPIKey, openapi.Op{ID: "deleteAPIKey", Summary: "Delete an API key"})
 }
 
-func registerConfigRoutes(r *gin.RouterGroup, h *handlers.ConfigHandler) {
-	r.GET("", h.Get)
-	r.PUT("", h.Update)
+// registerLoginRoutes registers the CLI's login API and the browser pages it sends the user to.
+func registerLoginRoutes(r *openapi.Router, pages *gin.RouterGroup, h *handlers.LoginHandler) {
+	r.POST("device", h.StartDeviceLogin, openapi.Op{ID: "startDeviceLogin", Summary: "Start a device login", Response: models.DeviceLoginResponse{}, Public: true})
+	r.POST("token", h.PollDeviceLogin, openapi.Op{ID: "pollDeviceLogin", Summary: "Check whether a device login is done", Body: models.DeviceTokenPayload{}, Response: models.SessionTokens{}, Public: true})
+	r.POST("refresh", h.RefreshSession, openapi.Op{ID: "refreshSession", Summary: "Refresh a login session", Body: models.RefreshTokenPayload{}, Response: models.SessionTokens{}, Public: true})
+	r.POST("logout", h.Logout, openapi.Op{ID: "logout", Summary: "End a login session", Body: models.RefreshTokenPayload{}, Public: true})
+
+	pages.GET("device", h.DevicePage)
+	pages.GET("device/authorize", h.AuthorizeDevice)
+	pages.GET("callback", h.Callback)
+}
+
+func registerSyncRoutes(r *openapi.Router, h *handlers.SyncHandler) {
+	r.POST("", h.HandleSync, openapi.Op{ID: "startSync", Summary: "Start a sync", Body: models.SyncPayload{}, Response: models.SyncRun{}})
+
+	ru := r.Group("runs")
+	ru.GET("", h.ListRuns, openapi.Op{ID: "listSyncRuns", Summary: "List recent sync runs", Query: []openapi.Param{limitParam}, Response: []models.SyncRun{}})
+	ru.GET(":id", h.GetRun, openapi.Op{ID: "getSyncRun", Summary: "Get a sync run", Response: models.SyncRun{}})
 }
 
-func registerCWRoutes(r *gin.RouterGroup, h *handlers.CWHandler) {
+func registerConfigRoutes(r *openapi.Router, h *handlers.ConfigHandler) {
+	r.GET("", h.Get, openapi.Op{ID: "getConfig", Summary: "Get the app config", Response: models.Config{}})
+	r.PUT("", h.Update, openapi.Op{ID: "updateConfig", Summary: "Replace the app config", Body: models.Config{}, Response: models.Config{}})
+}
+
+func registerScheduleRoutes(r *openapi.Router, h *handlers.ScheduleHandler) {
+	r.GET("", h.ListSchedules, openapi.Op{ID: "listSchedules", Summary: "List sync schedules", Response: []models.SyncSchedule{}})
+	r.GET(":id", h.GetSchedule, openapi.Op{ID: "getSchedule", Summary: "Get a sync schedule", Response: models.SyncSchedule{}})
+	r.POST("", h.AddSchedule, openapi.Op{ID: "createSchedule", Summary: "Create a sync schedule", Body: models.SyncSchedule{}, Response: models.SyncSchedule{}})
+	r.PUT(":id", h.UpdateSchedule, openapi.Op{ID: "updateSchedule", Summary: "Replace a sync schedule", Body: models.SyncSchedule{}, Response: models.SyncSchedule{}})
+	r.DELETE(":id", h.DeleteSchedule, openapi.Op{ID: "deleteSchedule", Summary: "Delete a sync schedule"})
+}
+
+func registerAuditRoutes(r *openapi.Router, h *handlers.AuditHandler) {
+	r.GET("", h.ListEvents, openapi.Op{
+		ID:      "listAuditEvents",
+		Summary: "List audit events, newest first",
+		Query: []openapi.Param{
+			{Name: "user_id", Type: "integer", Description: "Only events by this user"},
+			{Name: "action", Type: "string", Description: "One of create, update, delete, rotate, sync"},
+			{Name: "entity_type", Type: "string", Description: "Only events on this kind of entity"},
+			{Name: "entity_id", Type: "integer", Description: "Only events on this entity"},
+			{Name: "since", Type: "string", Description: "RFC 3339 time or date"},
+			{Name: "until", Type: "string", Description: "RFC 3339 time or date"},
+			limitParam,
+		},
+		Response: []models.AuditEvent{},
+	})
+}
+
+func registerCWRoutes(r *openapi.Router, h *handlers.CWHandler) {
 	b := r.Group("boards")
-	b.GET("", h.ListBoards)
-	b.GET(":id", h.GetBoard)
+	b.GET("", h.ListBoards, openapi.Op{ID: "listBoards", Summary: "List boards", Response: []models.Board{}})
+	b.GET(":id", h.GetBoard, openapi.Op{ID: "getBoard", Summary: "Get a board", Response: models.Board{}})
 
 	m := r.Group("members")
-	m.GET("", h.ListMembers)
+	m.GET("", h.ListMembers, openapi.Op{ID: "listMembers", Summary: "List members", Response: []models.Member{}})
+
+	co := r.Group("companies")
+	co.GET("", h.ListCompanies, openapi.Op{ID: "listCompanies", Summary: "List companies", Query: []openapi.Param{nameParam}, Response: []models.Company{}})
+	co.GET(":id", h.GetCompany, openapi.Op{ID: "getCompany", Summary: "Get a company", Response: models.Company{}})
+
+	ct := r.Group("contacts")
+	ct.GET("", h.ListContacts, openapi.Op{ID: "listContacts", Summary: "List contacts", Query: []openapi.Param{nameParam}, Response: []models.Contact{}})
+	ct.GET(":id", h.GetContact, openapi.Op{ID: "getContact", Summary: "Get a contact", Response: models.Contact{}})
+
+	t := r.Group("tickets")
+	t.GET("", h.ListTickets, openapi.Op{
+		ID:      "listTickets",
+		Summary: "List tickets a page at a time; the Link header has the next page",
+		Query: []openapi.Param{
+			{Name: "board_id", Type: "integer"},
+			{Name: "status_id", Type: "integer"},
+			{Name: "owner_id", Type: "integer"},
+			{Name: "company_id", Type: "integer"},
+			{Name: "updated_since", Type: "string", Description: "RFC 3339 time or date"},
+			{Name: "state", Type: "string", Description: "open or closed"},
+			{Name: "cursor", Type: "integer", Description: "From the Link header of the previous page"},
+			limitParam,
+		},
+		Response: []models.Ticket{},
+	})
+	t.GET(":id", h.GetTicket, openapi.Op{ID: "getTicket", Summary: "Get a ticket with its board, status, company, and notes", Response: models.FullTicket{}})
+
+	r.GET("search", h.SearchTickets, openapi.Op{
+		ID:       "searchTickets",
+		Summary:  "Search tickets by summary and notes",
+		Query:    []openapi.Param{{Name: "q", Type: "string", Description: "Search text"}, limitParam},
+		Response: []models.TicketSearchResult{},
+	})
 }
 
-func registerWebexRoutes(r *gin.RouterGroup, h *handlers.WebexHandler) {
+func registerWebexRoutes(r *openapi.Router, h *handlers.WebexHandler) {
 	ro := r.Group("rooms")
-	ro.GET("", h.ListRecipients)
-	ro.GET(":id", h.GetRoom)
+	ro.GET("", h.ListRecipients, openapi.Op{ID: "listRecipients", Summary: "List webex recipients", Response: []models.WebexRecipient{}})
+	ro.GET(":id", h.GetRoom, openapi.Op{ID: "getRecipient", Summary: "Get a webex recipient", Response: models.WebexRecipient{}})
 }
 
-func registerNotifierRoutes(r *gin.RouterGroup, h *handlers.NotifierHandler) {
-	ru := r.Group("rules")
-	ru.GET("", h.ListNotifierRules)
-	ru.GET(":id", h.GetNotifierRule)
-	ru.POST("", h.AddNotifierRule)
-	ru.DELETE(":id", h.DeleteNotifierRule)
-
-	fw := r.Group("forwards")
-	fw.GET("", h.ListForwards)
-	fw.GET(":id", h.GetForward)
-	fw.POST("", h.AddUserForward)
-	fw.DELETE(":id", h.DeleteUserForward)
+func registerNotifierRoutes(r *openapi.Router, h *handlers.NotifierHandler) {
+	ru := r.Group("rules",
+		middleware.RoleAccess(middleware.AllRoles, middleware.OperatorRoles),
+		middleware.ScopeAccess(models.ScopeNotifiersRead, models.ScopeNotifiersWrite),
+	)
+	ru.GET("", h.ListNotifierRules, openapi.Op{ID: "listNotifierRules", Summary: "List notifier rules", Response: []models.NotifierRuleFull{}})
+	ru.GET(":id", h.GetNotifierRule, openapi.Op{ID: "getNotifierRule", Summary: "Get a notifier rule", Response: models.NotifierRule{}})
+	ru.POST("", h.AddNotifierRule, openapi.Op{ID: "createNotifierRule", Summary: "Create a notifier rule", Body: models.NotifierRule{}, Response: models.NotifierRule{}})
+	ru.PUT(":id", h.UpdateNotifierRule, openapi.Op{ID: "updateNotifierRule", Summary: "Replace a notifier rule", Body: models.NotifierRule{}, Response: models.NotifierRule{}})
+	ru.PATCH(":id", h.UpdateNotifierRule, openapi.Op{ID: "patchNotifierRule", Summary: "Change some of a notifier rule's fields", Body: models.NotifierRule{}, Response: models.NotifierRule{}})
+	ru.DELETE(":id", h.DeleteNotifierRule, openapi.Op{ID: "deleteNotifierRule", Summary: "Delete a notifier rule"})
+
+	// self service users may only manage forwards from themselves, which the handlers check
+	fw := r.Group("forwards",
+		middleware.RoleAccess(middleware.AllRoles, middleware.ForwardRoles),
+		middleware.ScopeAccess(models.ScopeForwardsRead, models.ScopeForwardsWrite),
+	)
+	fw.GET("", h.ListForwards, openapi.Op{ID: "listForwards", Summary: "List forwards", Response: []models.NotifierForwardFull{}})
+	fw.GET(":id", h.GetForward, openapi.Op{ID: "getForward", Summary: "Get a forward", Response: models.NotifierForward{}})
+	fw.POST("", h.AddUserForward, openapi.Op{ID: "createForward", Summary: "Create a forward", Body: models.NotifierForward{}, Response: models.NotifierForward{}})
+	fw.PUT(":id", h.UpdateUserForward, openapi.Op{ID: "updateForward", Summary: "Replace a forward", Body: models.NotifierForward{}, Response: models.NotifierForward{}})
+	fw.PATCH(":id", h.UpdateUserForward, openapi.Op{ID: "patchForward", Summary: "Change some of a forward's fields", Body: models.NotifierForward{}, Response: models.NotifierForward{}})
+	fw.DELETE(":id", h.DeleteUserForward, openapi.Op{ID: "deleteForward", Summary: "Delete a forward"})
 }
 
-func registerHookRoutes(r *gin.RouterGroup, tb *handlers.TicketbotHandler) {
+func registerHookRoutes(r *gin.RouterGroup, tb *handlers.TicketbotHandler, bh *handlers.BotHandler, wxSecret string) {
 	r.POST("cw/tickets", middleware.RequireConnectwiseSignature(), tb.ProcessTicket)
+	r.POST("cw/schedules", middleware.RequireConnectwiseSignature(), tb.ProcessScheduleEntry)
+	r.POST("webex/messages", middleware.RequireWebexSignature(wxSecret), bh.HandleMessage)
 }
+
+// legacyFields are the fields renamed when the API was versioned, which the legacy paths still
+// accept and return.
+var legacyFields = middleware.LegacyFields{
+	"/notifiers/forwards": {
+		"user_email": "source_id",
+		"dest_email": "destination_id",
+		"added_on":   "created_on",
+	},
+	"/notifiers/rules": {
+		"cw_board_id":    "board_id",
+		"webex_room_id":  "recipient_id",
+		"notify_enabled": "enabled",
+	},
+}
+
+var (
+	limitParam = openapi.Param{Name: "limit", Type: "integer", Description: "Maximum number of results"}
+	nameParam  = openapi.Param{Name: "name", Type: "string", Description: "Only those whose name contains this"}
+)
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLegacyRoutesAliasVersioned(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	g := gin.New()
+	doc := AddRoutes(&App{Creds: &Creds{}, Svc: &Services{}}, g)
+
+	if len(doc.Paths) == 0 {
+		t.Fatal("document has no paths")
+	}
+
+	registered := make(map[string]bool)
+	for _, r := range g.Routes() {
+		registered[r.Method+" "+r.Path] = true
+	}
+
+	for path, item := range doc.Paths {
+		for method := range item {
+			versioned := strings.ToUpper(method) + " /" + apiVersion + toGinPath(path)
+			legacy := strings.ToUpper(method) + " " + toGinPath(path)
+			if !registered[versioned] {
+				t.Errorf("%s is documented but not registered", versioned)
+			}
+			if !registered[legacy] {
+				t.Errorf("%s has no legacy alias", versioned)
+			}
+		}
+
+		if strings.HasPrefix(path, "/"+apiVersion) {
+			t.Errorf("document path %s should be relative to the server url", path)
+		}
+	}
+}
+
+// toGinPath turns a document path like /users/{id} into gin's /users/:id.
+func toGinPath(path string) string {
+	path = strings.ReplaceAll(path, "{", ":")
+	return strings.ReplaceAll(path, "}", "")
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+)
+
+type sdkOperation struct {
+	id, method, path string
+}
+
+// SDKPaths renders pkg/sdk/paths_gen.go from the API document: a constant per operation, so the
+// SDK stops compiling if it calls an operation the server no longer has.
+func SDKPaths() ([]byte, error) {
+	doc := OpenAPI()
+
+	var ops []sdkOperation
+	for p, item := range doc.Paths {
+		for method, op := range item {
+			ops = append(ops, sdkOperation{id: op.OperationID, method: strings.ToUpper(method), path: p})
+		}
+	}
+
+	slices.SortFunc(ops, func(a, b sdkOperation) int {
+		return strings.Compare(a.path+" "+a.method, b.path+" "+b.method)
+	})
+
+	base := strings.Trim(doc.Servers[0].URL, "/")
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by openapi-gen from the server's routes. DO NOT EDIT.\n\n")
+	buf.WriteString("package sdk\n\n")
+	buf.WriteString("// API paths by operation ID, relative to the server's root URL. Paths with an id take it\n")
+	buf.WriteString("// with fmt.Sprintf.\n")
+	buf.WriteString("const (\n")
+	for _, op := range ops {
+		p := base + op.path
+		for {
+			start := strings.Index(p, "{")
+			if start == -1 {
+				break
+			}
+			end := strings.Index(p[start:], "}")
+			p = p[:start] + "%d" + p[start+end+1:]
+		}
+
+		fmt.Fprintf(&buf, "\t// %s %s\n", op.method, op.path)
+		fmt.Fprintf(&buf, "\tpath%s = %q\n", strings.ToUpper(op.id[:1])+op.id[1:], p)
+	}
+	buf.WriteString(")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
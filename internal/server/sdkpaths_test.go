@@ -0,0 +1,25 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSDKPathsUpToDate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	want, err := SDKPaths()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile("../../pkg/sdk/paths_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("pkg/sdk/paths_gen.go is out of date with the routes; run go generate ./pkg/sdk")
+	}
+}
@@ -2,14 +2,21 @@ package server
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/mock"
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
+	"github.com/thecoretg/ticketbot/internal/service/botcmd"
 	"github.com/thecoretg/ticketbot/internal/service/config"
 	"github.com/thecoretg/ticketbot/internal/service/cwsvc"
 	"github.com/thecoretg/ticketbot/internal/service/notifier"
+	"github.com/thecoretg/ticketbot/internal/service/reconciler"
+	"github.com/thecoretg/ticketbot/internal/service/scheduler"
 	"github.com/thecoretg/ticketbot/internal/service/syncsvc"
 	"github.com/thecoretg/ticketbot/internal/service/ticketbot"
 	"github.com/thecoretg/ticketbot/internal/service/user"
@@ -25,25 +32,38 @@ type App struct {
 	Stores                  *models.AllRepos
 	CWClient                *psa.Client
 	MessageSender           models.MessageSender
+	MockWebex               *mock.WebexClient
 	Pool                    *pgxpool.Pool
-	Config                  *models.Config
 	Svc                     *Services
 	CurrentMigrationVersion int64
 }
 
 type Services struct {
-	Config    *config.Service
-	User      *user.Service
-	CW        *cwsvc.Service
-	Hooks     *webhooks.Service
-	Webex     *webexsvc.Service
-	Sync      *syncsvc.Service
-	Notifier  *notifier.Service
-	Ticketbot *ticketbot.Service
+	Config     *config.Service
+	User       *user.Service
+	Audit      *audit.Service
+	CW         *cwsvc.Service
+	Hooks      *webhooks.Service
+	Webex      *webexsvc.Service
+	Sync       *syncsvc.Service
+	Notifier   *notifier.Service
+	Ticketbot  *ticketbot.Service
+	Scheduler  *scheduler.Service
+	Reconciler *reconciler.Service
+	Bot        *botcmd.Service
 }
 
 const defaultStoreTTL = int64(900)
 
+const (
+	// autoCodebase as CW_CODEBASE looks up the site's codebase at startup instead of using
+	// psa.DefaultCodebase.
+	autoCodebase = "auto"
+
+	// codebaseDiscoveryTimeout bounds the lookup so an unreachable site can't hold up startup.
+	codebaseDiscoveryTimeout = 10 * time.Second
+)
+
 func NewApp(ctx context.Context, migVersion int64) (*App, error) {
 	cr := getCreds()
 	tf := getTestFlags()
@@ -57,9 +77,37 @@ func NewApp(ctx context.Context, migVersion int64) (*App, error) {
 	}
 	slog.Info("using TTL", "ttl", ttl)
 
+	discover := cr.CWCreds.Codebase == autoCodebase
+	if discover {
+		cr.CWCreds.Codebase = ""
+	}
+
 	cw := psa.NewClient(cr.CWCreds)
+	if discover {
+		dctx, cancel := context.WithTimeout(ctx, codebaseDiscoveryTimeout)
+		cb, err := cw.DiscoverCodebase(dctx)
+		cancel()
+		if err != nil {
+			slog.Warn("couldn't discover connectwise codebase; using default", "codebase", cw.Codebase(), "error", err.Error())
+		} else {
+			slog.Info("discovered connectwise codebase", "codebase", cb)
+		}
+	}
+	expvar.Publish("psa_client", expvar.Func(func() any { return cw.Stats() }))
 	wx := webex.NewClient(cr.WebexAPISecret)
-	ms := makeMessageSender(tf.MockWebex, cr.WebexAPISecret)
+	var (
+		ms models.MessageSender = wx
+		mw *mock.WebexClient
+	)
+
+	if tf.MockWebex {
+		var err error
+		mw, wx, err = startMockWebex(tf, cr.WebexAPISecret)
+		if err != nil {
+			return nil, fmt.Errorf("starting mock webex: %w", err)
+		}
+		ms = mw
+	}
 
 	s, err := CreateStores(ctx, cr, migVersion)
 	if err != nil {
@@ -72,40 +120,58 @@ func NewApp(ctx context.Context, migVersion int64) (*App, error) {
 		return nil, fmt.Errorf("getting initial config: %w", err)
 	}
 
+	as := audit.New(r.AuditEvents)
+	cs := config.New(r.Config, cfg, as)
 	cws := cwsvc.New(s.Pool, r.CW, cw, ttl)
 	ws := webexsvc.New(s.Pool, r.WebexRecipients, ms, cr.WebexBotEmail)
 
 	nr := notifier.SvcParams{
-		Cfg:              cfg,
-		WebexSvc:         ws,
-		NotifierRules:    r.NotifierRules,
-		Notifications:    r.TicketNotifications,
-		Forwards:         r.NotifierForwards,
-		Pool:             s.Pool,
-		MessageSender:    ms,
-		CWCompanyID:      cr.CWCreds.CompanyId,
-		MaxMessageLength: cfg.MaxMessageLength,
+		Cfg:           cs,
+		WebexSvc:      ws,
+		NotifierRules: r.NotifierRules,
+		Notifications: r.TicketNotifications,
+		Dispatches:    r.DispatchNotifications,
+		Forwards:      r.NotifierForwards,
+		Pool:          s.Pool,
+		MessageSender: ms,
+		CWClient:      cw,
+		Audit:         as,
+	}
+
+	us := user.New(r.APIUser, r.APIKey, r.DeviceLogins, r.LoginSessions, as)
+	if cr.OAuth.Enabled() {
+		if err := us.EnableLogin(ctx, cr.OAuth); err != nil {
+			return nil, fmt.Errorf("enabling login: %w", err)
+		}
+	} else {
+		slog.Info("OAUTH_CLIENT_ID is empty; login is disabled and only api keys work")
 	}
 
 	ns := notifier.New(nr)
+	ss := syncsvc.New(s.Pool, cs, cws, ws, ns, r.SyncRuns, as)
+	tb := ticketbot.New(cs, cws, ns)
 
 	return &App{
 		Creds:         cr,
-		Config:        cfg,
 		TestFlags:     tf,
 		Stores:        r,
 		Pool:          s.Pool,
 		CWClient:      cw,
-		MessageSender: wx,
+		MessageSender: ms,
+		MockWebex:     mw,
 		Svc: &Services{
-			Config:    config.New(r.Config, cfg),
-			User:      user.New(r.APIUser, r.APIKey),
-			Hooks:     webhooks.New(cw, wx, cr.WebexHooksSecret, cr.RootURL),
-			CW:        cwsvc.New(s.Pool, r.CW, cw, ttl),
-			Webex:     webexsvc.New(s.Pool, r.WebexRecipients, ms, cr.WebexBotEmail),
-			Sync:      syncsvc.New(s.Pool, cws, ws, ns),
-			Notifier:  notifier.New(nr),
-			Ticketbot: ticketbot.New(cfg, cws, ns),
+			Config:     cs,
+			User:       us,
+			Audit:      as,
+			Hooks:      webhooks.New(cw, wx, cr.WebexHooksSecret, cr.RootURL),
+			CW:         cwsvc.New(s.Pool, r.CW, cw, ttl),
+			Webex:      webexsvc.New(s.Pool, r.WebexRecipients, ms, cr.WebexBotEmail),
+			Sync:       ss,
+			Notifier:   ns,
+			Ticketbot:  tb,
+			Scheduler:  scheduler.New(r.SyncSchedules, r.Locks, ss, as),
+			Reconciler: reconciler.New(cs, cws, tb, r.Locks),
+			Bot:        botcmd.New(cws, ws, ns, us),
 		},
 	}, nil
 }
@@ -28,7 +28,16 @@ func CreateStores(ctx context.Context, creds *Creds, targetMigVersion int64) (*S
 // InitPostgresStores verifies credentials are given, runs any needed migrations, and
 // provides all repositories
 func InitPostgresStores(ctx context.Context, creds *Creds, targetMigVersion int64) (*Stores, error) {
-	pool, err := pgxpool.New(ctx, creds.PostgresDSN)
+	cfg, err := pgxpool.ParseConfig(creds.PostgresDSN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing postgres dsn: %w", err)
+	}
+
+	// timestamp columns have no zone and are set with NOW(), so every session writes and reads
+	// them in UTC regardless of the server's default, the same as Connectwise's timestamps
+	cfg.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("creating pgx pool: %w", err)
 	}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+)
+
+type Service struct {
+	Events models.AuditEventRepository
+}
+
+func New(e models.AuditEventRepository) *Service {
+	return &Service{
+		Events: e,
+	}
+}
+
+func (s *Service) List(ctx context.Context, f models.AuditFilter) ([]*models.AuditEvent, error) {
+	switch {
+	case f.Limit <= 0:
+		f.Limit = defaultListLimit
+	case f.Limit > maxListLimit:
+		f.Limit = maxListLimit
+	}
+
+	return s.Events.List(ctx, f)
+}
+
+// Record saves an audit event for a change, attributed to the actor in the context. Pass a nil
+// before for creates and a nil after for deletes. The change has already happened by the time
+// this is called, so failures are logged rather than returned. It does nothing on a nil Service.
+func (s *Service) Record(ctx context.Context, action models.AuditAction, entity models.AuditEntity, id int, before, after any) {
+	if s == nil {
+		return
+	}
+
+	e := &models.AuditEvent{
+		UserID:     models.ActorFromContext(ctx),
+		Action:     action,
+		EntityType: entity,
+	}
+
+	if id != 0 {
+		e.EntityID = &id
+	}
+
+	var err error
+	if e.Before, err = marshalState(before); err != nil {
+		slog.Error("audit: marshaling before state", "action", action, "entity", entity, "id", id, "error", err.Error())
+	}
+
+	if e.After, err = marshalState(after); err != nil {
+		slog.Error("audit: marshaling after state", "action", action, "entity", entity, "id", id, "error", err.Error())
+	}
+
+	// the request may be finished by now, but the event should still be saved
+	if _, err := s.Events.Insert(context.WithoutCancel(ctx), e); err != nil {
+		slog.Error("audit: recording event", "action", action, "entity", entity, "id", id, "error", err.Error())
+	}
+}
+
+// marshalState returns nil for a missing state, so it is stored as null.
+func marshalState(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling %T: %w", v, err)
+	}
+
+	if string(b) == "null" {
+		return nil, nil
+	}
+
+	return b, nil
+}
@@ -0,0 +1,340 @@
+package botcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+const dateLayout = "2006-01-02"
+
+const forwardUsage = "**Forward commands:**\n" +
+	"- `forward list`: show your forwards\n" +
+	"- `forward add <email> [from YYYY-MM-DD] [until YYYY-MM-DD] [keep]`: forward your notifications to someone\n" +
+	"- `forward edit <id> [to <email>] [from YYYY-MM-DD|none] [until YYYY-MM-DD|none] [keep|nokeep] [on|off]`: change one of your forwards\n" +
+	"- `forward delete <id>`: delete one of your forwards\n\n" +
+	"`until` is the last day notifications are forwarded. With `keep`, you still get a copy."
+
+// forwardOpts are the optional settings in a forward add or edit command. Nil fields weren't given.
+type forwardOpts struct {
+	dest       string
+	start      *time.Time
+	end        *time.Time
+	clearStart bool
+	clearEnd   bool
+	keep       *bool
+	enabled    *bool
+}
+
+// forward lets users manage forwards of their own notifications. The sender's webex email is
+// their identity, so they can only ever act on forwards from their own recipient.
+func (s *Service) forward(ctx context.Context, email, args string) (string, error) {
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		return s.listForwards(ctx, email)
+	}
+
+	sub, rest := strings.ToLower(fields[0]), fields[1:]
+	switch sub {
+	case "list":
+		return s.listForwards(ctx, email)
+	case "add":
+		return s.addForward(ctx, email, rest)
+	case "edit":
+		return s.editForward(ctx, email, rest)
+	case "delete", "remove":
+		return s.deleteForward(ctx, email, rest)
+	default:
+		return forwardUsage, nil
+	}
+}
+
+func (s *Service) listForwards(ctx context.Context, email string) (string, error) {
+	fwds, err := s.Notifier.ListOwnForwards(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("listing forwards for %s: %w", email, err)
+	}
+
+	if len(fwds) == 0 {
+		return "You have no forwards. Add one with `forward add <email>`.", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("**Your forwards:**\n")
+	for _, f := range fwds {
+		fmt.Fprintf(&sb, "- `%d` to %s, %s%s\n", f.ID, f.DestinationName, dateRangeText(f.StartDate, f.EndDate), flagsText(f.UserKeepsCopy, f.Enabled))
+	}
+
+	return sb.String(), nil
+}
+
+func (s *Service) addForward(ctx context.Context, email string, args []string) (string, error) {
+	if len(args) == 0 {
+		return forwardUsage, nil
+	}
+
+	opts, err := parseForwardOpts(args[1:])
+	if err != nil {
+		return optsReply(err), nil
+	}
+	opts.dest = args[0]
+
+	src, err := s.Webex.EnsurePersonRecipientByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("getting recipient for sender %s: %w", email, err)
+	}
+
+	dest, reply, err := s.forwardDest(ctx, src, opts.dest)
+	if dest == nil {
+		return reply, err
+	}
+
+	f := &models.NotifierForward{
+		SourceID:  src.ID,
+		DestID:    dest.ID,
+		StartDate: opts.start,
+		EndDate:   opts.end,
+		Enabled:   true,
+	}
+
+	if opts.keep != nil {
+		f.UserKeepsCopy = *opts.keep
+	}
+
+	if opts.enabled != nil {
+		f.Enabled = *opts.enabled
+	}
+
+	f, err = s.Notifier.AddForward(ctx, f)
+	if err != nil {
+		return "", fmt.Errorf("adding forward: %w", err)
+	}
+
+	return fmt.Sprintf("Added forward `%d` to %s, %s%s", f.ID, dest.Name, dateRangeText(f.StartDate, f.EndDate), flagsText(f.UserKeepsCopy, f.Enabled)), nil
+}
+
+func (s *Service) editForward(ctx context.Context, email string, args []string) (string, error) {
+	if len(args) < 2 {
+		return forwardUsage, nil
+	}
+
+	f, reply, err := s.ownForward(ctx, email, args[0])
+	if f == nil {
+		return reply, err
+	}
+
+	opts, err := parseForwardOpts(args[1:])
+	if err != nil {
+		return optsReply(err), nil
+	}
+
+	if opts.dest != "" {
+		src, err := s.Webex.GetRecipient(ctx, f.SourceID)
+		if err != nil {
+			return "", fmt.Errorf("getting source recipient: %w", err)
+		}
+
+		dest, reply, err := s.forwardDest(ctx, src, opts.dest)
+		if dest == nil {
+			return reply, err
+		}
+		f.DestID = dest.ID
+	}
+
+	switch {
+	case opts.clearStart:
+		f.StartDate = nil
+	case opts.start != nil:
+		f.StartDate = opts.start
+	}
+
+	switch {
+	case opts.clearEnd:
+		f.EndDate = nil
+	case opts.end != nil:
+		f.EndDate = opts.end
+	}
+
+	if opts.keep != nil {
+		f.UserKeepsCopy = *opts.keep
+	}
+
+	if opts.enabled != nil {
+		f.Enabled = *opts.enabled
+	}
+
+	f, err = s.Notifier.UpdateForward(ctx, f)
+	if err != nil {
+		return "", fmt.Errorf("updating forward: %w", err)
+	}
+
+	dest, err := s.Webex.GetRecipient(ctx, f.DestID)
+	if err != nil {
+		return "", fmt.Errorf("getting destination recipient: %w", err)
+	}
+
+	return fmt.Sprintf("Updated forward `%d` to %s, %s%s", f.ID, dest.Name, dateRangeText(f.StartDate, f.EndDate), flagsText(f.UserKeepsCopy, f.Enabled)), nil
+}
+
+func (s *Service) deleteForward(ctx context.Context, email string, args []string) (string, error) {
+	if len(args) != 1 {
+		return forwardUsage, nil
+	}
+
+	f, reply, err := s.ownForward(ctx, email, args[0])
+	if f == nil {
+		return reply, err
+	}
+
+	if err := s.Notifier.DeleteForward(ctx, f.ID); err != nil {
+		return "", fmt.Errorf("deleting forward %d: %w", f.ID, err)
+	}
+
+	return fmt.Sprintf("Deleted forward `%d`", f.ID), nil
+}
+
+// ownForward gets a forward by the id the user typed, if it's theirs. If the forward is nil,
+// the reply explains why.
+func (s *Service) ownForward(ctx context.Context, email, idArg string) (*models.NotifierForward, string, error) {
+	id, err := strconv.Atoi(idArg)
+	if err != nil {
+		return nil, fmt.Sprintf("`%s` is not a forward id. See `forward list` for yours.", idArg), nil
+	}
+
+	// forwards that aren't theirs are reported the same as missing ones
+	notFound := fmt.Sprintf("You have no forward `%d`. See `forward list` for yours.", id)
+	f, err := s.Notifier.GetForward(ctx, id)
+	if err != nil {
+		if errors.Is(err, models.ErrUserForwardNotFound) {
+			return nil, notFound, nil
+		}
+		return nil, "", fmt.Errorf("getting forward %d: %w", id, err)
+	}
+
+	if err := s.Notifier.CheckForwardOwner(ctx, email, f.SourceID); err != nil {
+		if errors.Is(err, models.ErrForwardNotOwned) {
+			return nil, notFound, nil
+		}
+		return nil, "", err
+	}
+
+	return f, "", nil
+}
+
+// forwardDest finds the recipient to forward to. If it is nil, the reply explains why.
+func (s *Service) forwardDest(ctx context.Context, src *models.WebexRecipient, email string) (*models.WebexRecipient, string, error) {
+	email = strings.TrimPrefix(email, "mailto:")
+	if !strings.Contains(email, "@") {
+		return nil, fmt.Sprintf("`%s` is not an email address.", email), nil
+	}
+
+	if src.Email != nil && strings.EqualFold(*src.Email, email) {
+		return nil, "You can't forward notifications to yourself.", nil
+	}
+
+	dest, err := s.Webex.EnsurePersonRecipientByEmail(ctx, email)
+	if err != nil {
+		// most likely nobody in webex has the address
+		slog.Warn("botcmd: finding forward destination", "email", email, "error", err.Error())
+		return nil, fmt.Sprintf("Couldn't find a webex user for %s.", email), nil
+	}
+
+	return dest, "", nil
+}
+
+func parseForwardOpts(args []string) (*forwardOpts, error) {
+	opts := &forwardOpts{}
+	for i := 0; i < len(args); i++ {
+		word := strings.ToLower(args[i])
+		switch word {
+		case "keep", "nokeep":
+			keep := word == "keep"
+			opts.keep = &keep
+			continue
+		case "on", "off":
+			enabled := word == "on"
+			opts.enabled = &enabled
+			continue
+		case "to", "from", "until":
+		default:
+			return nil, fmt.Errorf("unknown option `%s`", args[i])
+		}
+
+		if i+1 >= len(args) {
+			return nil, fmt.Errorf("`%s` needs a value", word)
+		}
+		i++
+		val := args[i]
+
+		if word == "to" {
+			opts.dest = val
+			continue
+		}
+
+		if strings.EqualFold(val, "none") {
+			opts.clearStart = opts.clearStart || word == "from"
+			opts.clearEnd = opts.clearEnd || word == "until"
+			continue
+		}
+
+		d, err := time.ParseInLocation(dateLayout, val, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("`%s` is not a date like %s", val, dateLayout)
+		}
+
+		if word == "from" {
+			opts.start = &d
+		} else {
+			// the forward runs through the whole last day
+			end := d.AddDate(0, 0, 1)
+			opts.end = &end
+		}
+	}
+
+	if opts.start != nil && opts.end != nil && !opts.start.Before(*opts.end) {
+		return nil, errors.New("the `until` date can't be before the `from` date")
+	}
+
+	return opts, nil
+}
+
+// optsReply tells the user what was wrong with the options they gave, followed by the usage.
+func optsReply(err error) string {
+	return fmt.Sprintf("Couldn't read that: %s.\n\n%s", err, forwardUsage)
+}
+
+func dateRangeText(start, end *time.Time) string {
+	switch {
+	case start != nil && end != nil:
+		return fmt.Sprintf("%s through %s", start.Local().Format(dateLayout), end.Local().AddDate(0, 0, -1).Format(dateLayout))
+	case start != nil:
+		return fmt.Sprintf("from %s on", start.Local().Format(dateLayout))
+	case end != nil:
+		return fmt.Sprintf("through %s", end.Local().AddDate(0, 0, -1).Format(dateLayout))
+	default:
+		return "indefinitely"
+	}
+}
+
+func flagsText(keep, enabled bool) string {
+	var flags []string
+	if keep {
+		flags = append(flags, "you keep a copy")
+	}
+
+	if !enabled {
+		flags = append(flags, "disabled")
+	}
+
+	if len(flags) == 0 {
+		return ""
+	}
+
+	return " (" + strings.Join(flags, ", ") + ")"
+}
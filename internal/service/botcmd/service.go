@@ -0,0 +1,175 @@
+package botcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/cwsvc"
+	"github.com/thecoretg/ticketbot/internal/service/notifier"
+	"github.com/thecoretg/ticketbot/internal/service/user"
+	"github.com/thecoretg/ticketbot/internal/service/webexsvc"
+	"github.com/thecoretg/ticketbot/pkg/webex"
+)
+
+const maxSearchResults = 10
+
+type Service struct {
+	CW       *cwsvc.Service
+	Webex    *webexsvc.Service
+	Notifier *notifier.Service
+	Users    *user.Service
+}
+
+func New(cw *cwsvc.Service, wx *webexsvc.Service, ns *notifier.Service, us *user.Service) *Service {
+	return &Service{
+		CW:       cw,
+		Webex:    wx,
+		Notifier: ns,
+		Users:    us,
+	}
+}
+
+// HandleMessage runs the command in a message sent to the bot and replies in the same room.
+// Messages sent by the bot itself are ignored.
+func (s *Service) HandleMessage(ctx context.Context, payload *webex.MessageHookPayload) error {
+	msg, err := s.Webex.GetMessage(ctx, payload)
+	if err != nil {
+		if errors.Is(err, webexsvc.ErrMessageFromBot) {
+			return nil
+		}
+		return err
+	}
+
+	cmd, args := parseCommand(msg.Text)
+	slog.Debug("botcmd: received command", "command", cmd, "args", args, "from", msg.PersonEmail)
+
+	var reply string
+	switch cmd {
+	case "search":
+		ok, err := s.knownSender(ctx, msg.PersonEmail)
+		if err != nil {
+			return fmt.Errorf("checking search sender: %w", err)
+		}
+
+		if !ok {
+			slog.Warn("botcmd: refused search from unknown sender", "from", msg.PersonEmail)
+			reply = "Sorry, you don't have access to search tickets."
+			break
+		}
+
+		reply, err = s.search(ctx, args)
+		if err != nil {
+			return fmt.Errorf("running search command: %w", err)
+		}
+	case "forward":
+		// adding a forward creates recipients, so strangers can't use it to fill the store
+		ok, err := s.knownSender(ctx, msg.PersonEmail)
+		if err != nil {
+			return fmt.Errorf("checking forward sender: %w", err)
+		}
+
+		if !ok {
+			slog.Warn("botcmd: refused forward from unknown sender", "from", msg.PersonEmail)
+			reply = "Sorry, you don't have access to forward notifications."
+			break
+		}
+
+		reply, err = s.forward(ctx, msg.PersonEmail, args)
+		if err != nil {
+			return fmt.Errorf("running forward command: %w", err)
+		}
+	default:
+		reply = helpText()
+	}
+
+	if _, err := s.Webex.PostMessage(ctx, &webex.Message{RoomID: msg.RoomID, Markdown: reply}); err != nil {
+		return fmt.Errorf("posting reply: %w", err)
+	}
+
+	return nil
+}
+
+// knownSender reports whether the sender of a message may see ticket details or manage forwards.
+// Any webex user can message the bot, so only API users, who all have at least read only access,
+// and active Connectwise members are let through.
+func (s *Service) knownSender(ctx context.Context, email string) (bool, error) {
+	if email == "" {
+		return false, nil
+	}
+
+	_, err := s.Users.GetUserByEmail(ctx, email)
+	if err == nil {
+		return true, nil
+	}
+
+	if !errors.Is(err, models.ErrAPIUserNotFound) {
+		return false, fmt.Errorf("getting api user: %w", err)
+	}
+
+	_, err = s.CW.Members.GetActiveByEmail(ctx, email)
+	if err == nil {
+		return true, nil
+	}
+
+	if !errors.Is(err, models.ErrMemberNotFound) {
+		return false, fmt.Errorf("getting connectwise member: %w", err)
+	}
+
+	return false, nil
+}
+
+func (s *Service) search(ctx context.Context, query string) (string, error) {
+	results, err := s.CW.SearchTickets(ctx, query, maxSearchResults)
+	if err != nil {
+		if errors.Is(err, cwsvc.ErrEmptySearch) {
+			return "Usage: `search <terms>`", nil
+		}
+		return "", err
+	}
+
+	if len(results) == 0 {
+		return fmt.Sprintf("No tickets found for `%s`", query), nil
+	}
+
+	return s.searchResultsText(query, results), nil
+}
+
+func (s *Service) searchResultsText(query string, results []*models.TicketSearchResult) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**Tickets matching `%s`:**\n", query)
+	for _, r := range results {
+		fmt.Fprintf(&sb, "- %s %s (%s, %s)\n",
+			s.CW.CWClient.MarkdownInternalTicketLink(r.ID), r.Summary, r.CompanyName, r.StatusName)
+		if r.Snippet != "" {
+			fmt.Fprintf(&sb, "  > %s\n", strings.Join(strings.Fields(r.Snippet), " "))
+		}
+	}
+
+	return sb.String()
+}
+
+// parseCommand finds the first known command word in the message text and returns it with
+// the rest of the text as its arguments. Anything before the command, like the bot's name
+// when it's mentioned in a group room, is skipped.
+func parseCommand(text string) (string, string) {
+	fields := strings.Fields(text)
+	for i, f := range fields {
+		cmd := strings.ToLower(f)
+		if cmd == "search" || cmd == "forward" || cmd == "help" {
+			return cmd, strings.Join(fields[i+1:], " ")
+		}
+	}
+
+	return "", ""
+}
+
+func helpText() string {
+	return "**Commands:**\n" +
+		"- `search <terms>`: search ticket summaries and notes\n" +
+		"- `forward [list|add|edit|delete]`: manage forwards of your notifications; send `forward help` for details\n" +
+		"- `help`: show this message"
+}
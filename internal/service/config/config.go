@@ -4,15 +4,74 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/thecoretg/ticketbot/internal/models"
 )
 
+// listenRetryDelay is how long to wait before listening again after the connection fails.
+const listenRetryDelay = 10 * time.Second
+
+// Run keeps the current config in step with the store until the context is canceled, so changes
+// made through any replica apply to all of them.
+func (s *Service) Run(ctx context.Context) {
+	slog.Info("config: listening for changes")
+	for {
+		// changes may have been missed while not listening
+		if err := s.Reload(ctx); err != nil {
+			slog.Error("config: reloading", "error", err.Error())
+		}
+
+		err := s.Config.Listen(ctx, func() {
+			if err := s.Reload(ctx); err != nil {
+				slog.Error("config: reloading", "error", err.Error())
+			}
+		})
+		if err != nil {
+			slog.Error("config: listening for changes", "error", err.Error(), "retry_in", listenRetryDelay.String())
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("config: stopped listening")
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// Reload gets the config from the store and makes it current. An invalid stored config is
+// rejected, and the current one is kept.
+func (s *Service) Reload(ctx context.Context) error {
+	c, err := s.Config.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("getting config from store: %w", err)
+	}
+
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("keeping current config: %w", err)
+	}
+
+	if *c != *s.Current() {
+		slog.Info("config: reloaded", "config", c)
+	}
+	s.set(c)
+	return nil
+}
+
+// ensureConfig gets the config from the store and makes it current, inserting the default if
+// there isn't one. Like Reload, an invalid stored config is logged and the current one is kept.
 func (s *Service) ensureConfig(ctx context.Context) (*models.Config, error) {
 	c, err := s.Config.Get(ctx)
 	if err == nil {
-		s.applyChanges(c)
-		return s.ConfigRef, nil
+		if err := c.Validate(); err != nil {
+			slog.Error("config: stored config is invalid; keeping current config", "error", err.Error())
+			return s.Current(), nil
+		}
+
+		s.set(c)
+		return s.Current(), nil
 	}
 
 	if !errors.Is(err, models.ErrConfigNotFound) {
@@ -25,6 +84,6 @@ func (s *Service) ensureConfig(ctx context.Context) (*models.Config, error) {
 		return nil, fmt.Errorf("creating default config: %w", err)
 	}
 
-	s.applyChanges(c)
-	return s.ConfigRef, nil
+	s.set(c)
+	return s.Current(), nil
 }
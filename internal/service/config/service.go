@@ -3,20 +3,30 @@ package config
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
 )
 
 type Service struct {
-	Config    models.ConfigRepository
-	ConfigRef *models.Config
+	Config  models.ConfigRepository
+	Audit   *audit.Service
+	current atomic.Pointer[models.Config]
 }
 
-func New(c models.ConfigRepository, cfg *models.Config) *Service {
-	return &Service{
-		Config:    c,
-		ConfigRef: cfg,
+func New(c models.ConfigRepository, cfg *models.Config, a *audit.Service) *Service {
+	s := &Service{
+		Config: c,
+		Audit:  a,
 	}
+	s.set(cfg)
+	return s
+}
+
+// Current returns the config this replica is running with. It satisfies models.ConfigSource.
+func (s *Service) Current() *models.Config {
+	return s.current.Load()
 }
 
 func (s *Service) Get(ctx context.Context) (*models.Config, error) {
@@ -24,18 +34,24 @@ func (s *Service) Get(ctx context.Context) (*models.Config, error) {
 }
 
 func (s *Service) Update(ctx context.Context, p *models.Config) (*models.Config, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	before := s.Current()
 	updated, err := s.Config.Upsert(ctx, p)
 	if err != nil {
 		return nil, fmt.Errorf("upserting config in store: %w", err)
 	}
 
-	s.applyChanges(updated)
-	return s.ConfigRef, nil
+	// other replicas pick the change up from the store's notification
+	s.set(updated)
+	s.Audit.Record(ctx, models.AuditActionUpdate, models.AuditEntityConfig, updated.ID, before, updated)
+	return s.Current(), nil
 }
 
-func (s *Service) applyChanges(src *models.Config) {
-	cfg := s.ConfigRef
-	cfg.AttemptNotify = src.AttemptNotify
-	cfg.MaxConcurrentSyncs = src.MaxConcurrentSyncs
-	cfg.MaxMessageLength = src.MaxMessageLength
+// set swaps in a copy of the config, so readers of the old one are never affected.
+func (s *Service) set(cfg *models.Config) {
+	c := *cfg
+	s.current.Store(&c)
 }
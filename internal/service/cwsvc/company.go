@@ -0,0 +1,35 @@
+package cwsvc
+
+import (
+	"context"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// ListCompanies returns all companies in the store, or only active ones whose name contains
+// the search string if one is given.
+func (s *Service) ListCompanies(ctx context.Context, name string) ([]*models.Company, error) {
+	if name == "" {
+		return s.Companies.List(ctx)
+	}
+
+	return s.Companies.Search(ctx, name)
+}
+
+func (s *Service) GetCompany(ctx context.Context, id int) (*models.Company, error) {
+	return s.Companies.Get(ctx, id)
+}
+
+// ListContacts returns all contacts in the store, or only active ones whose full name contains
+// the search string if one is given.
+func (s *Service) ListContacts(ctx context.Context, name string) ([]*models.Contact, error) {
+	if name == "" {
+		return s.Contacts.List(ctx)
+	}
+
+	return s.Contacts.Search(ctx, name)
+}
+
+func (s *Service) GetContact(ctx context.Context, id int) (*models.Contact, error) {
+	return s.Contacts.Get(ctx, id)
+}
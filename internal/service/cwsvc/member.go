@@ -9,3 +9,9 @@ import (
 func (s *Service) ListMembers(ctx context.Context) ([]*models.Member, error) {
 	return s.Members.List(ctx)
 }
+
+// EnsureMember returns a member from the store, refreshing it from Connectwise if it is
+// missing or stale.
+func (s *Service) EnsureMember(ctx context.Context, id int) (*models.Member, error) {
+	return s.ensureMember(ctx, id)
+}
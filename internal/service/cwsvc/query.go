@@ -0,0 +1,155 @@
+package cwsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+const (
+	defaultTicketPageSize = 100
+	maxTicketPageSize     = 1000
+
+	defaultSearchResults = 20
+	maxSearchResults     = 100
+)
+
+var ErrEmptySearch = errors.New("search query is empty")
+
+// QueryTickets returns a page of stored tickets matching the filter, along with the cursor to
+// pass for the next page. The returned cursor is 0 when there are no more results.
+func (s *Service) QueryTickets(ctx context.Context, f models.TicketFilter) ([]*models.Ticket, int, error) {
+	switch {
+	case f.Limit <= 0:
+		f.Limit = defaultTicketPageSize
+	case f.Limit > maxTicketPageSize:
+		f.Limit = maxTicketPageSize
+	}
+
+	// fetch one extra row so we know whether another page exists
+	limit := f.Limit
+	f.Limit++
+
+	t, err := s.Tickets.Query(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	next := 0
+	if len(t) > limit {
+		t = t[:limit]
+		next = t[limit-1].ID
+	}
+
+	return t, next, nil
+}
+
+// SearchTickets runs a full-text search over ticket summaries and notes in the store, best
+// matches first. The query uses web search syntax, e.g. quoted phrases and -excluded words.
+func (s *Service) SearchTickets(ctx context.Context, query string, limit int) ([]*models.TicketSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptySearch
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultSearchResults
+	case limit > maxSearchResults:
+		limit = maxSearchResults
+	}
+
+	return s.Tickets.Search(ctx, query, limit)
+}
+
+// GetFullTicket builds a full ticket, including its note history, entirely from the store.
+// Unlike ProcessTicket, it never calls ConnectWise.
+func (s *Service) GetFullTicket(ctx context.Context, id int) (*models.FullTicket, error) {
+	t, err := s.Tickets.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.Boards.Get(ctx, t.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("getting board: %w", err)
+	}
+
+	status, err := s.Statuses.Get(ctx, t.StatusID)
+	if err != nil {
+		return nil, fmt.Errorf("getting status: %w", err)
+	}
+
+	company, err := s.Companies.Get(ctx, t.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("getting company: %w", err)
+	}
+
+	var contact *models.Contact
+	if t.ContactID != nil {
+		contact, err = s.Contacts.Get(ctx, *t.ContactID)
+		if err != nil {
+			return nil, fmt.Errorf("getting contact: %w", err)
+		}
+	}
+
+	var owner *models.Member
+	if t.OwnerID != nil {
+		owner, err = s.Members.Get(ctx, *t.OwnerID)
+		if err != nil {
+			return nil, fmt.Errorf("getting owner: %w", err)
+		}
+	}
+
+	var rsc []*models.Member
+	if t.Resources != nil && *t.Resources != "" {
+		for _, i := range resourceStringToSlice(*t.Resources) {
+			m, err := s.Members.GetByIdentifier(ctx, i)
+			if err != nil {
+				if errors.Is(err, models.ErrMemberNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("getting resource member %s: %w", i, err)
+			}
+			rsc = append(rsc, m)
+		}
+	}
+
+	notes, err := s.Notes.ListByTicketID(ctx, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("listing notes: %w", err)
+	}
+
+	var fullNotes []*models.FullTicketNote
+	for _, n := range notes {
+		if n.Deleted {
+			continue
+		}
+
+		fn, err := models.TicketNoteToFullTicketNote(ctx, n, s.Members, s.Contacts)
+		if err != nil {
+			return nil, fmt.Errorf("getting details for note %d: %w", n.ID, err)
+		}
+		fullNotes = append(fullNotes, fn)
+	}
+
+	var latest *models.FullTicketNote
+	if len(fullNotes) > 0 {
+		latest = fullNotes[len(fullNotes)-1]
+	}
+
+	return &models.FullTicket{
+		Ticket:     *t,
+		Board:      *board,
+		Status:     *status,
+		Company:    *company,
+		Contact:    contact,
+		Owner:      owner,
+		LatestNote: latest,
+		Resources:  rsc,
+		Notes:      fullNotes,
+	}, nil
+}
@@ -10,45 +10,60 @@ import (
 )
 
 type Service struct {
-	TTL       time.Duration
-	Boards    models.BoardRepository
-	Companies models.CompanyRepository
-	Contacts  models.ContactRepository
-	Members   models.MemberRepository
-	Tickets   models.TicketRepository
-	Statuses  models.TicketStatusRepository
-	Notes     models.TicketNoteRepository
-	pool      *pgxpool.Pool
-	CWClient  *psa.Client
+	TTL        time.Duration
+	Boards     models.BoardRepository
+	Types      models.BoardTypeRepository
+	Subtypes   models.BoardSubtypeRepository
+	Items      models.BoardItemRepository
+	Teams      models.BoardTeamRepository
+	Priorities models.PriorityRepository
+	Companies  models.CompanyRepository
+	Contacts   models.ContactRepository
+	Members    models.MemberRepository
+	Tickets    models.TicketRepository
+	Statuses   models.TicketStatusRepository
+	Notes      models.TicketNoteRepository
+	pool       *pgxpool.Pool
+	CWClient   *psa.Client
 }
 
 func New(pool *pgxpool.Pool, r models.CWRepos, cl *psa.Client, ttl int64) *Service {
 	t := time.Second * time.Duration(ttl)
 	return &Service{
-		TTL:       t,
-		Boards:    r.Board,
-		Statuses:  r.TicketStatus,
-		Companies: r.Company,
-		Contacts:  r.Contact,
-		Members:   r.Member,
-		Tickets:   r.Ticket,
-		Notes:     r.Note,
-		pool:      pool,
-		CWClient:  cl,
+		TTL:        t,
+		Boards:     r.Board,
+		Types:      r.BoardType,
+		Subtypes:   r.BoardSubtype,
+		Items:      r.BoardItem,
+		Teams:      r.BoardTeam,
+		Priorities: r.Priority,
+		Statuses:   r.TicketStatus,
+		Companies:  r.Company,
+		Contacts:   r.Contact,
+		Members:    r.Member,
+		Tickets:    r.Ticket,
+		Notes:      r.Note,
+		pool:       pool,
+		CWClient:   cl,
 	}
 }
 
 func (s *Service) WithTX(tx pgx.Tx) *Service {
 	return &Service{
-		TTL:       s.TTL,
-		Boards:    s.Boards.WithTx(tx),
-		Statuses:  s.Statuses.WithTx(tx),
-		Companies: s.Companies.WithTx(tx),
-		Contacts:  s.Contacts.WithTx(tx),
-		Members:   s.Members.WithTx(tx),
-		Tickets:   s.Tickets.WithTx(tx),
-		Notes:     s.Notes.WithTx(tx),
-		pool:      s.pool,
-		CWClient:  s.CWClient,
+		TTL:        s.TTL,
+		Boards:     s.Boards.WithTx(tx),
+		Types:      s.Types.WithTx(tx),
+		Subtypes:   s.Subtypes.WithTx(tx),
+		Items:      s.Items.WithTx(tx),
+		Teams:      s.Teams.WithTx(tx),
+		Priorities: s.Priorities.WithTx(tx),
+		Statuses:   s.Statuses.WithTx(tx),
+		Companies:  s.Companies.WithTx(tx),
+		Contacts:   s.Contacts.WithTx(tx),
+		Members:    s.Members.WithTx(tx),
+		Tickets:    s.Tickets.WithTx(tx),
+		Notes:      s.Notes.WithTx(tx),
+		pool:       s.pool,
+		CWClient:   s.CWClient,
 	}
 }
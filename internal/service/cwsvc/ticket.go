@@ -52,7 +52,7 @@ func (s *Service) processTicket(ctx context.Context, id int, caller string) (req
 		logRequest(req, err, logger)
 	}()
 
-	cd, err := s.getCwData(id)
+	cd, err := s.getCwData(ctx, id)
 	if err != nil {
 		if errors.Is(err, ErrTicketWasDeleted) {
 			req.NoProcReason = "ticket was deleted from connectwise"
@@ -163,8 +163,8 @@ func (s *Service) processTicket(ctx context.Context, id int, caller string) (req
 	return req, nil
 }
 
-func (s *Service) getCwData(ticketID int) (CWData, error) {
-	t, err := s.CWClient.GetTicket(ticketID, nil)
+func (s *Service) getCwData(ctx context.Context, ticketID int) (CWData, error) {
+	t, err := s.CWClient.GetTicketCtx(ctx, ticketID, nil)
 	if err != nil {
 		if errors.Is(err, psa.ErrNotFound) {
 			return CWData{}, ErrTicketWasDeleted
@@ -172,7 +172,7 @@ func (s *Service) getCwData(ticketID int) (CWData, error) {
 		return CWData{}, fmt.Errorf("getting ticket: %w", err)
 	}
 
-	n, err := s.CWClient.GetMostRecentTicketNote(ticketID)
+	n, err := s.CWClient.GetMostRecentTicketNoteCtx(ctx, ticketID)
 	if err != nil && !errors.Is(err, psa.ErrNotFound) {
 		return CWData{}, fmt.Errorf("getting most recent ticket note: %w", err)
 	}
@@ -190,7 +190,7 @@ func (s *Service) ensureBoard(ctx context.Context, id int) (*models.Board, error
 		return nil, fmt.Errorf("getting board from store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetBoard(id, nil)
+	cw, err := s.CWClient.GetBoardCtx(ctx, id, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting board from cw: %w", err)
 	}
@@ -221,7 +221,7 @@ func (s *Service) ensureStatus(ctx context.Context, id, boardID int) (*models.Ti
 		return nil, fmt.Errorf("ensuring status board in store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetBoardStatus(id, nil, boardID)
+	cw, err := s.CWClient.GetBoardStatusCtx(ctx, id, nil, boardID)
 	if err != nil {
 		return nil, fmt.Errorf("getting status from cw: %w", err)
 	}
@@ -252,7 +252,7 @@ func (s *Service) ensureCompany(ctx context.Context, id int) (*models.Company, e
 		return nil, fmt.Errorf("getting company from store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetCompany(id, nil)
+	cw, err := s.CWClient.GetCompanyCtx(ctx, id, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting company from cw: %w", err)
 	}
@@ -278,7 +278,7 @@ func (s *Service) ensureContact(ctx context.Context, id int) (*models.Contact, e
 		return nil, fmt.Errorf("getting contact from store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetContact(id, nil)
+	cw, err := s.CWClient.GetContactCtx(ctx, id, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting contact from cw: %w", err)
 	}
@@ -315,7 +315,7 @@ func (s *Service) ensureMemberByIdentifier(ctx context.Context, identifier strin
 		return nil, fmt.Errorf("getting member from store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetMemberByIdentifier(identifier)
+	cw, err := s.CWClient.GetMemberByIdentifierCtx(ctx, identifier)
 	if err != nil {
 		return nil, fmt.Errorf("getting member from cw by identifier: %w", err)
 	}
@@ -333,7 +333,7 @@ func (s *Service) ensureMember(ctx context.Context, id int) (*models.Member, err
 		return nil, fmt.Errorf("getting member from store: %w", err)
 	}
 
-	cw, err := s.CWClient.GetMember(id, nil)
+	cw, err := s.CWClient.GetMemberCtx(ctx, id, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting member from cw: %w", err)
 	}
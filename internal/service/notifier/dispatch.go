@@ -0,0 +1,130 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// NotifyDispatch sends a Webex DM to a member who was scheduled on a ticket, following their
+// forwards. Each recipient is only sent once per schedule entry, member, and time window, so a
+// rescheduled entry is sent again but a repeated callback is not. A recipient whose message
+// failed is tried again on the next callback.
+func (s *Service) NotifyDispatch(ctx context.Context, d *models.Dispatch) error {
+	if d == nil || d.Ticket == nil || d.Member == nil {
+		return errors.New("received incomplete dispatch")
+	}
+
+	logger := slog.Default().With("ticket_id", d.Ticket.Ticket.ID, "schedule_entry_id", d.ScheduleEntryID, "member_id", d.Member.ID)
+
+	if d.Member.PrimaryEmail == "" {
+		logger.Warn("dispatch: member has no email, not notifying")
+		return nil
+	}
+
+	r, err := s.WebexSvc.EnsurePersonRecipientByEmail(ctx, d.Member.PrimaryEmail)
+	if err != nil {
+		return fmt.Errorf("ensuring webex person for %s: %w", d.Member.PrimaryEmail, err)
+	}
+
+	recips := recipMap{r.ID: newRecip(r)}
+	fwdProcd, err := s.processAllFwds(ctx, recips)
+	if err != nil {
+		logger.Error("dispatch: forward processing failed; using original recipient", "error", err.Error())
+		fwdProcd = recips
+	}
+
+	errored := 0
+	for _, rd := range fwdProcd.toSlice() {
+		n := &models.DispatchNotification{
+			ScheduleEntryID: d.ScheduleEntryID,
+			TicketID:        d.Ticket.Ticket.ID,
+			MemberID:        d.Member.ID,
+			DateStart:       d.Start,
+			DateEnd:         d.End,
+			RecipientID:     &rd.recipient.ID,
+		}
+
+		if rd.forwardChain != nil {
+			n.ForwardedFromID = &rd.forwardChain[len(rd.forwardChain)-1].ID
+		}
+
+		// claiming first means concurrent callbacks, on this instance or another, can't both send
+		claimed, err := s.Dispatches.Claim(ctx, n)
+		if err != nil {
+			if errors.Is(err, models.ErrDispatchNotificationClaimed) {
+				logger.Debug("dispatch: recipient already notified for this window", "recipient", rd.recipient.Name)
+				continue
+			}
+
+			logger.Error("dispatch: claiming notification", "recipient", rd.recipient.Name, "error", err.Error())
+			errored++
+			continue
+		}
+
+		wm := newWebexMsg(rd.recipient, s.dispatchMessageBody(d, rd))
+		if _, err := s.MessageSender.PostMessageCtx(ctx, &wm); err != nil {
+			logger.Error("dispatch: sending webex message", "recipient", rd.recipient.Name, "error", err.Error())
+			errored++
+			if err := s.Dispatches.Release(ctx, claimed.ID); err != nil {
+				logger.Error("dispatch: releasing notification", "recipient", rd.recipient.Name, "error", err.Error())
+			}
+			continue
+		}
+
+		if err := s.Dispatches.MarkSent(ctx, claimed.ID); err != nil {
+			logger.Error("dispatch: marking notification sent", "recipient", rd.recipient.Name, "error", err.Error())
+		}
+	}
+
+	if errored > 0 {
+		return fmt.Errorf("errors occurred sending %d dispatch messages; see logs for details", errored)
+	}
+
+	logger.Info("dispatch: notification processed")
+	return nil
+}
+
+func (s *Service) dispatchMessageBody(d *models.Dispatch, r recipData) string {
+	var body string
+	to := "You"
+	if !r.isNaturalRecipient() {
+		body += fmt.Sprintf("%s\n", fwdChainStr(r.recipient, r.forwardChain))
+		to = fullName(d.Member.FirstName, &d.Member.LastName)
+	}
+
+	body += fmt.Sprintf("**Dispatched to %s:** %s %s", to, s.CWClient.MarkdownInternalTicketLink(d.Ticket.Ticket.ID), d.Ticket.Ticket.Summary)
+	if d.Ticket.Company.Name != "" {
+		body += fmt.Sprintf("\n**Company:** %s", d.Ticket.Company.Name)
+	}
+
+	body += fmt.Sprintf("\n**When:** %s", scheduleWindow(d.Start, d.End))
+	body += "\n\n---"
+	return body
+}
+
+// scheduleWindow formats a schedule entry's time window in the server's local time zone, only
+// repeating the date for the end time if it falls on a different day.
+func scheduleWindow(start time.Time, end *time.Time) string {
+	const (
+		dayLayout  = "Mon, Jan 2"
+		timeLayout = "3:04 PM"
+	)
+
+	start = start.Local()
+	s := fmt.Sprintf("%s %s", start.Format(dayLayout), start.Format(timeLayout))
+	if end == nil || !end.After(start) {
+		return fmt.Sprintf("%s %s", s, start.Format("MST"))
+	}
+
+	e := end.Local()
+	if e.YearDay() == start.YearDay() && e.Year() == start.Year() {
+		return fmt.Sprintf("%s - %s %s", s, e.Format(timeLayout), e.Format("MST"))
+	}
+
+	return fmt.Sprintf("%s - %s %s %s", s, e.Format(dayLayout), e.Format(timeLayout), e.Format("MST"))
+}
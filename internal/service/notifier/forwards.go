@@ -2,7 +2,9 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/thecoretg/ticketbot/internal/models"
@@ -25,20 +27,88 @@ func (s *Service) GetForward(ctx context.Context, id int) (*models.NotifierForwa
 }
 
 func (s *Service) AddForward(ctx context.Context, f *models.NotifierForward) (*models.NotifierForward, error) {
-	return s.Forwards.Insert(ctx, f)
+	f, err := s.Forwards.Insert(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Audit.Record(ctx, models.AuditActionCreate, models.AuditEntityNotifierForward, f.ID, nil, f)
+	return f, nil
+}
+
+func (s *Service) UpdateForward(ctx context.Context, f *models.NotifierForward) (*models.NotifierForward, error) {
+	before, err := s.Forwards.Get(ctx, f.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err = s.Forwards.Update(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Audit.Record(ctx, models.AuditActionUpdate, models.AuditEntityNotifierForward, f.ID, before, f)
+	return f, nil
+}
+
+// ListOwnForwards returns the forwards whose source is one of the webex recipients with the
+// email address.
+func (s *Service) ListOwnForwards(ctx context.Context, email string) ([]*models.NotifierForwardFull, error) {
+	recips, err := s.WebexSvc.ListRecipientsByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("listing recipients for email: %w", err)
+	}
+
+	owned := make(map[int]struct{}, len(recips))
+	for _, r := range recips {
+		owned[r.ID] = struct{}{}
+	}
+
+	all, err := s.Forwards.ListAllFull(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing forwards: %w", err)
+	}
+
+	var fwds []*models.NotifierForwardFull
+	for _, f := range all {
+		if _, ok := owned[f.SourceID]; ok {
+			fwds = append(fwds, f)
+		}
+	}
+
+	return fwds, nil
+}
+
+// CheckForwardOwner returns models.ErrForwardNotOwned unless the source recipient of a forward
+// has the email address.
+func (s *Service) CheckForwardOwner(ctx context.Context, email string, sourceID int) error {
+	r, err := s.WebexSvc.GetRecipient(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, models.ErrWebexRecipientNotFound) {
+			return models.ErrForwardNotOwned
+		}
+		return fmt.Errorf("getting source recipient: %w", err)
+	}
+
+	if email == "" || r.Email == nil || !strings.EqualFold(*r.Email, email) {
+		return models.ErrForwardNotOwned
+	}
+
+	return nil
 }
 
 func (s *Service) DeleteForward(ctx context.Context, id int) error {
-	exists, err := s.Forwards.Exists(ctx, id)
+	f, err := s.Forwards.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("checking if notifier exists: %w", err)
+		return err
 	}
 
-	if !exists {
-		return models.ErrUserForwardNotFound
+	if err := s.Forwards.Delete(ctx, id); err != nil {
+		return err
 	}
 
-	return s.Forwards.Delete(ctx, id)
+	s.Audit.Record(ctx, models.AuditActionDelete, models.AuditEntityNotifierForward, id, f, nil)
+	return nil
 }
 
 func (s *Service) processAllFwds(ctx context.Context, in recipMap) (recipMap, error) {
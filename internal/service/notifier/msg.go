@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/thecoretg/ticketbot/internal/models"
-	"github.com/thecoretg/ticketbot/pkg/psa"
 	"github.com/thecoretg/ticketbot/pkg/webex"
 )
 
@@ -42,7 +41,7 @@ func (s *Service) makeTicketMessages(t *models.FullTicket, recips []recipData, i
 		}
 
 		h += mainHeader
-		body := makeMessageBody(t, h, s.MaxMessageLength)
+		body := makeMessageBody(t, h, s.Cfg.Current().MaxMessageLength)
 
 		wm := newWebexMsg(r.recipient, body)
 		n := &models.TicketNotification{
@@ -82,10 +81,10 @@ func fwdChainStr(recip *models.WebexRecipient, fwdChain []*models.WebexRecipient
 
 func (s *Service) notificationHeader(t *models.FullTicket, isNew bool) string {
 	if isNew {
-		return fmt.Sprintf("**New Ticket:** %s %s", psa.MarkdownInternalTicketLink(t.Ticket.ID, s.CWCompanyID), t.Ticket.Summary)
+		return fmt.Sprintf("**New Ticket:** %s %s", s.CWClient.MarkdownInternalTicketLink(t.Ticket.ID), t.Ticket.Summary)
 	}
 
-	return fmt.Sprintf("**Ticket Updated:** %s %s", psa.MarkdownInternalTicketLink(t.Ticket.ID, s.CWCompanyID), t.Ticket.Summary)
+	return fmt.Sprintf("**Ticket Updated:** %s %s", s.CWClient.MarkdownInternalTicketLink(t.Ticket.ID), t.Ticket.Summary)
 }
 
 func newWebexMsg(r *models.WebexRecipient, body string) webex.Message {
@@ -19,16 +19,17 @@ func (s *Service) GetNotifierRule(ctx context.Context, id int) (*models.Notifier
 }
 
 func (s *Service) DeleteNotifierRule(ctx context.Context, id int) error {
-	exists, err := s.NotifierRules.Exists(ctx, id)
+	nr, err := s.NotifierRules.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("checking if notifier exists: %w", err)
+		return err
 	}
 
-	if !exists {
-		return models.ErrNotifierNotFound
+	if err := s.NotifierRules.Delete(ctx, id); err != nil {
+		return err
 	}
 
-	return s.NotifierRules.Delete(ctx, id)
+	s.Audit.Record(ctx, models.AuditActionDelete, models.AuditEntityNotifierRule, id, nr, nil)
+	return nil
 }
 
 func (s *Service) AddNotifierRule(ctx context.Context, nr *models.NotifierRule) (*models.NotifierRule, error) {
@@ -50,5 +51,38 @@ func (s *Service) AddNotifierRule(ctx context.Context, nr *models.NotifierRule)
 		return nil, fmt.Errorf("adding notifier rule: %w", err)
 	}
 
+	s.Audit.Record(ctx, models.AuditActionCreate, models.AuditEntityNotifierRule, n.ID, nil, n)
+	return n, nil
+}
+
+// UpdateNotifierRule changes a rule's board, recipient, or whether it's enabled. Moving it onto the
+// board and recipient of another rule is a conflict.
+func (s *Service) UpdateNotifierRule(ctx context.Context, nr *models.NotifierRule) (*models.NotifierRule, error) {
+	if nr == nil {
+		return nil, errors.New("got nil notifier rule")
+	}
+
+	before, err := s.NotifierRules.Get(ctx, nr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if nr.CwBoardID != before.CwBoardID || nr.WebexRecipientID != before.WebexRecipientID {
+		exists, err := s.NotifierRules.ExistsByBoardAndRecipient(ctx, nr.CwBoardID, nr.WebexRecipientID)
+		if err != nil {
+			return nil, fmt.Errorf("checking if notifier rule exists: %w", err)
+		}
+
+		if exists {
+			return nil, ErrNotifierConflict
+		}
+	}
+
+	n, err := s.NotifierRules.Update(ctx, nr)
+	if err != nil {
+		return nil, fmt.Errorf("updating notifier rule: %w", err)
+	}
+
+	s.Audit.Record(ctx, models.AuditActionUpdate, models.AuditEntityNotifierRule, n.ID, before, n)
 	return n, nil
 }
@@ -3,43 +3,48 @@ package notifier
 import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
 	"github.com/thecoretg/ticketbot/internal/service/webexsvc"
+	"github.com/thecoretg/ticketbot/pkg/psa"
 )
 
 type Service struct {
-	Cfg              *models.Config
-	WebexSvc         *webexsvc.Service
-	NotifierRules    models.NotifierRuleRepository
-	Notifications    models.TicketNotificationRepository
-	Forwards         models.NotifierForwardRepository
-	Pool             *pgxpool.Pool
-	MessageSender    models.MessageSender
-	CWCompanyID      string
-	MaxMessageLength int
+	Cfg           models.ConfigSource
+	WebexSvc      *webexsvc.Service
+	NotifierRules models.NotifierRuleRepository
+	Notifications models.TicketNotificationRepository
+	Dispatches    models.DispatchNotificationRepository
+	Forwards      models.NotifierForwardRepository
+	Pool          *pgxpool.Pool
+	MessageSender models.MessageSender
+	CWClient      *psa.Client
+	Audit         *audit.Service
 }
 
 type SvcParams struct {
-	Cfg              *models.Config
-	WebexSvc         *webexsvc.Service
-	NotifierRules    models.NotifierRuleRepository
-	Notifications    models.TicketNotificationRepository
-	Forwards         models.NotifierForwardRepository
-	Pool             *pgxpool.Pool
-	MessageSender    models.MessageSender
-	CWCompanyID      string
-	MaxMessageLength int
+	Cfg           models.ConfigSource
+	WebexSvc      *webexsvc.Service
+	NotifierRules models.NotifierRuleRepository
+	Notifications models.TicketNotificationRepository
+	Dispatches    models.DispatchNotificationRepository
+	Forwards      models.NotifierForwardRepository
+	Pool          *pgxpool.Pool
+	MessageSender models.MessageSender
+	CWClient      *psa.Client
+	Audit         *audit.Service
 }
 
 func New(p SvcParams) *Service {
 	return &Service{
-		Cfg:              p.Cfg,
-		WebexSvc:         p.WebexSvc,
-		NotifierRules:    p.NotifierRules,
-		Notifications:    p.Notifications,
-		Forwards:         p.Forwards,
-		Pool:             p.Pool,
-		MessageSender:    p.MessageSender,
-		CWCompanyID:      p.CWCompanyID,
-		MaxMessageLength: p.MaxMessageLength,
+		Cfg:           p.Cfg,
+		WebexSvc:      p.WebexSvc,
+		NotifierRules: p.NotifierRules,
+		Notifications: p.Notifications,
+		Dispatches:    p.Dispatches,
+		Forwards:      p.Forwards,
+		Pool:          p.Pool,
+		MessageSender: p.MessageSender,
+		CWClient:      p.CWClient,
+		Audit:         p.Audit,
 	}
 }
@@ -0,0 +1,164 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/cwsvc"
+	"github.com/thecoretg/ticketbot/internal/service/ticketbot"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+const (
+	// settleDelay skips tickets updated very recently, since their webhook may still be in flight.
+	settleDelay = 2 * time.Minute
+
+	// idleCheckInterval is how often to check the config again while reconciliation is disabled.
+	idleCheckInterval = time.Minute
+)
+
+// Metrics are published with expvar, and are served on the metrics route.
+var (
+	metricRuns      = expvar.NewInt("reconciler_runs")
+	metricChecked   = expvar.NewInt("reconciler_tickets_checked")
+	metricRecovered = expvar.NewInt("reconciler_tickets_recovered")
+	metricFailed    = expvar.NewInt("reconciler_tickets_failed")
+	metricLastRun   = expvar.NewString("reconciler_last_run")
+)
+
+type Service struct {
+	Cfg       models.ConfigSource
+	CW        *cwsvc.Service
+	Ticketbot *ticketbot.Service
+	Locks     models.LockRepository
+}
+
+// Result is the outcome of a single reconcile.
+type Result struct {
+	Checked   int
+	Recovered int
+	Failed    int
+}
+
+func New(cfg models.ConfigSource, cw *cwsvc.Service, tb *ticketbot.Service, locks models.LockRepository) *Service {
+	return &Service{
+		Cfg:       cfg,
+		CW:        cw,
+		Ticketbot: tb,
+		Locks:     locks,
+	}
+}
+
+// Run reconciles on the configured interval until the context is canceled. The interval is read
+// from the config each time, so changes apply after the current wait.
+func (s *Service) Run(ctx context.Context) {
+	slog.Info("reconciler: started")
+	for {
+		wait := idleCheckInterval
+		if iv := s.Cfg.Current().ReconcileIntervalMinutes; iv > 0 {
+			wait = time.Duration(iv) * time.Minute
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("reconciler: stopped")
+			return
+		case <-time.After(wait):
+		}
+
+		if s.Cfg.Current().ReconcileIntervalMinutes <= 0 {
+			continue
+		}
+
+		if _, err := s.Reconcile(ctx); err != nil {
+			slog.Error("reconciler: reconciling tickets", "error", err.Error())
+		}
+	}
+}
+
+// Reconcile finds tickets updated in Connectwise within the lookback window that the store hasn't
+// seen since, and sends them through ticketbot like a webhook would have. It does nothing if
+// another replica is already reconciling.
+func (s *Service) Reconcile(ctx context.Context) (*Result, error) {
+	release, ok, err := s.Locks.TryLock(ctx, models.LockClassReconciler, 0)
+	if err != nil {
+		return nil, fmt.Errorf("taking reconciler lock: %w", err)
+	}
+
+	if !ok {
+		slog.Debug("reconciler: locked by another replica, skipping")
+		return &Result{}, nil
+	}
+	defer release()
+
+	start := time.Now()
+	cfg := s.Cfg.Current()
+	lookback := time.Duration(max(cfg.ReconcileLookbackMinutes, cfg.ReconcileIntervalMinutes)) * time.Minute
+	from := start.Add(-lookback).UTC()
+	to := start.Add(-settleDelay).UTC()
+
+	q := psa.NewQuery().
+		Where(psa.Gt("lastUpdated", from), psa.Lt("lastUpdated", to)).
+		PageSize(100)
+
+	tix, err := s.CW.CWClient.ListTicketsCtx(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("listing recently updated tickets from connectwise: %w", err)
+	}
+
+	res := &Result{Checked: len(tix)}
+	for _, t := range tix {
+		missed, err := s.missed(ctx, t.ID, t.Info.LastUpdated)
+		if err != nil {
+			res.Failed++
+			slog.Error("reconciler: checking ticket", "ticket_id", t.ID, "error", err.Error())
+			continue
+		}
+
+		if !missed {
+			continue
+		}
+
+		slog.Info("reconciler: found ticket with missed update", "ticket_id", t.ID, "cw_last_updated", t.Info.LastUpdated)
+		if err := s.Ticketbot.ProcessTicket(ctx, t.ID); err != nil {
+			res.Failed++
+			continue
+		}
+		res.Recovered++
+	}
+
+	metricRuns.Add(1)
+	metricChecked.Add(int64(res.Checked))
+	metricRecovered.Add(int64(res.Recovered))
+	metricFailed.Add(int64(res.Failed))
+	metricLastRun.Set(start.UTC().Format(time.RFC3339))
+
+	slog.Info("reconciler: reconcile complete",
+		"checked", res.Checked,
+		"recovered", res.Recovered,
+		"failed", res.Failed,
+		"lookback_minutes", lookback.Minutes(),
+		"took_seconds", time.Since(start).Seconds(),
+	)
+
+	return res, nil
+}
+
+// missed reports whether Connectwise has a newer version of the ticket than the store. The pool
+// pins every session to UTC, so the store's zoneless updated_on is UTC, like Connectwise's.
+func (s *Service) missed(ctx context.Context, id int, cwUpdated time.Time) (bool, error) {
+	t, err := s.CW.Tickets.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, models.ErrTicketNotFound) {
+			return true, nil
+		}
+		return false, fmt.Errorf("getting ticket from store: %w", err)
+	}
+
+	return cwUpdated.UTC().After(t.UpdatedOn.UTC()), nil
+}
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1-x * * * *",
+		"@fortnightly",
+	}
+
+	for _, expr := range tests {
+		if _, err := parseCron(expr); err == nil {
+			t.Errorf("parseCron(%q) should have failed", expr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// a wednesday
+	from := time.Date(2025, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "* * * * *", want: time.Date(2025, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", want: time.Date(2025, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{expr: "5/20 * * * *", want: time.Date(2025, 1, 15, 10, 25, 0, 0, time.UTC)},
+		{expr: "0 * * * *", want: time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{expr: "@hourly", want: time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{expr: "30 2 * * *", want: time.Date(2025, 1, 16, 2, 30, 0, 0, time.UTC)},
+		{expr: "@DAILY", want: time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 9-17 * * 1-5", want: time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{expr: "0 8,12 * * *", want: time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 0 * * 0", want: time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		// 7 is sunday too
+		{expr: "0 0 * * 7", want: time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{expr: "@monthly", want: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "@yearly", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// when both day fields are set, either one matching is enough: the 20th, or a friday
+		{expr: "0 0 20 * 5", want: time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// never matches, so next gives up
+		{expr: "0 0 31 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := parseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("parseCron(%q): %v", tt.expr, err)
+			}
+
+			if got := c.next(from); !got.Equal(tt.want) {
+				t.Errorf("next(%s) = %s, want %s", from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextKeepsLocation(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	from := time.Date(2025, 1, 15, 22, 0, 0, 0, loc)
+
+	c, err := parseCron("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2025, 1, 16, 9, 0, 0, 0, loc)
+	if got := c.next(from); !got.Equal(want) || got.Location() != loc {
+		t.Errorf("next(%s) = %s, want %s", from, got, want)
+	}
+}
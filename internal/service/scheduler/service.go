@@ -0,0 +1,264 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
+	"github.com/thecoretg/ticketbot/internal/service/syncsvc"
+)
+
+const (
+	// pollInterval is how often schedules are checked. Cron only has minute resolution, so this just
+	// needs to be comfortably under a minute.
+	pollInterval = 20 * time.Second
+)
+
+var (
+	ErrInvalidSchedule  = errors.New("invalid sync schedule")
+	ErrScheduleConflict = errors.New("a sync schedule with that name already exists")
+)
+
+type Service struct {
+	Schedules models.SyncScheduleRepository
+	Locks     models.LockRepository
+	Sync      *syncsvc.Service
+	Audit     *audit.Service
+}
+
+func New(schedules models.SyncScheduleRepository, locks models.LockRepository, sync *syncsvc.Service, a *audit.Service) *Service {
+	return &Service{
+		Schedules: schedules,
+		Locks:     locks,
+		Sync:      sync,
+		Audit:     a,
+	}
+}
+
+func (s *Service) ListSchedules(ctx context.Context) ([]*models.SyncSchedule, error) {
+	scheds, err := s.Schedules.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sc := range scheds {
+		setNextRun(sc)
+	}
+
+	return scheds, nil
+}
+
+func (s *Service) GetSchedule(ctx context.Context, id int) (*models.SyncSchedule, error) {
+	sc, err := s.Schedules.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	setNextRun(sc)
+	return sc, nil
+}
+
+func (s *Service) AddSchedule(ctx context.Context, sc *models.SyncSchedule) (*models.SyncSchedule, error) {
+	if err := validateSchedule(sc); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNameConflict(ctx, sc); err != nil {
+		return nil, err
+	}
+
+	sc, err := s.Schedules.Insert(ctx, sc)
+	if err != nil {
+		return nil, fmt.Errorf("inserting schedule: %w", err)
+	}
+
+	setNextRun(sc)
+	s.Audit.Record(ctx, models.AuditActionCreate, models.AuditEntitySyncSchedule, sc.ID, nil, sc)
+	return sc, nil
+}
+
+func (s *Service) UpdateSchedule(ctx context.Context, sc *models.SyncSchedule) (*models.SyncSchedule, error) {
+	if err := validateSchedule(sc); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNameConflict(ctx, sc); err != nil {
+		return nil, err
+	}
+
+	before, err := s.Schedules.Get(ctx, sc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err = s.Schedules.Update(ctx, sc)
+	if err != nil {
+		return nil, fmt.Errorf("updating schedule: %w", err)
+	}
+
+	setNextRun(sc)
+	s.Audit.Record(ctx, models.AuditActionUpdate, models.AuditEntitySyncSchedule, sc.ID, before, sc)
+	return sc, nil
+}
+
+func (s *Service) DeleteSchedule(ctx context.Context, id int) error {
+	sc, err := s.Schedules.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Schedules.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.Audit.Record(ctx, models.AuditActionDelete, models.AuditEntitySyncSchedule, id, sc, nil)
+	return nil
+}
+
+// Run checks for due schedules until the context is canceled. Every replica runs this; the advisory
+// lock and run claim make sure each scheduled run only happens once.
+func (s *Service) Run(ctx context.Context) {
+	slog.Info("scheduler: started", "poll_interval", pollInterval.String())
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+
+	for {
+		s.runDue(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			slog.Info("scheduler: stopped")
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Service) runDue(ctx context.Context, now time.Time) {
+	scheds, err := s.Schedules.List(ctx)
+	if err != nil {
+		slog.Error("scheduler: listing schedules", "error", err.Error())
+		return
+	}
+
+	for _, sc := range scheds {
+		if !sc.Enabled {
+			continue
+		}
+
+		spec, err := parseCron(sc.Cron)
+		if err != nil {
+			slog.Error("scheduler: invalid cron expression", "schedule_id", sc.ID, "cron", sc.Cron, "error", err.Error())
+			continue
+		}
+
+		next := spec.next(lastRunBase(sc))
+		if next.IsZero() || next.After(now) {
+			continue
+		}
+
+		go s.runSchedule(ctx, sc)
+	}
+}
+
+func (s *Service) runSchedule(ctx context.Context, sc *models.SyncSchedule) {
+	release, ok, err := s.Locks.TryLock(ctx, models.LockClassSyncSchedule, sc.ID)
+	if err != nil {
+		slog.Error("scheduler: taking schedule lock", "schedule_id", sc.ID, "error", err.Error())
+		return
+	}
+
+	if !ok {
+		slog.Debug("scheduler: schedule locked elsewhere, skipping", "schedule_id", sc.ID)
+		return
+	}
+	defer release()
+
+	// the lock stops overlapping runs, but another replica may have already finished this one
+	if _, err := s.Schedules.ClaimRun(ctx, sc.ID, sc.LastRunOn, time.Now().UTC()); err != nil {
+		if errors.Is(err, models.ErrSyncScheduleClaimed) {
+			slog.Debug("scheduler: schedule run already claimed, skipping", "schedule_id", sc.ID)
+			return
+		}
+		slog.Error("scheduler: claiming schedule run", "schedule_id", sc.ID, "error", err.Error())
+		return
+	}
+
+	slog.Info("scheduler: running scheduled sync", "schedule_id", sc.ID, "name", sc.Name, "payload", sc.Payload)
+	if err := s.Sync.Sync(ctx, &sc.Payload); err != nil {
+		slog.Error("scheduler: scheduled sync", "schedule_id", sc.ID, "name", sc.Name, "error", err.Error())
+	}
+}
+
+func (s *Service) checkNameConflict(ctx context.Context, sc *models.SyncSchedule) error {
+	scheds, err := s.Schedules.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing schedules: %w", err)
+	}
+
+	for _, e := range scheds {
+		if e.ID != sc.ID && strings.EqualFold(e.Name, sc.Name) {
+			return ErrScheduleConflict
+		}
+	}
+
+	return nil
+}
+
+func validateSchedule(sc *models.SyncSchedule) error {
+	sc.Name = strings.TrimSpace(sc.Name)
+	if sc.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSchedule)
+	}
+
+	if _, err := parseCron(sc.Cron); err != nil {
+		return fmt.Errorf("%w: cron: %w", ErrInvalidSchedule, err)
+	}
+
+	p := &sc.Payload
+	if !p.CWBoards && !p.WebexRecipients && !p.CWTickets && !p.CWCompanies {
+		return fmt.Errorf("%w: at least one sync target must be set", ErrInvalidSchedule)
+	}
+
+	// zero is left as is, so each run uses the configured amount at the time
+	if p.MaxConcurrentSyncs < 0 {
+		return fmt.Errorf("%w: max concurrent syncs must be at least 1", ErrInvalidSchedule)
+	}
+
+	return nil
+}
+
+// lastRunBase is the time the next run is calculated from, in local time since that's what
+// cron expressions are written against. Timestamps are stored in UTC.
+func lastRunBase(sc *models.SyncSchedule) time.Time {
+	base := sc.CreatedOn
+	if sc.LastRunOn != nil {
+		base = *sc.LastRunOn
+	}
+
+	return base.In(time.Local)
+}
+
+func setNextRun(sc *models.SyncSchedule) {
+	sc.NextRunOn = nil
+	if !sc.Enabled {
+		return
+	}
+
+	spec, err := parseCron(sc.Cron)
+	if err != nil {
+		return
+	}
+
+	next := spec.next(lastRunBase(sc))
+	if next.IsZero() {
+		return
+	}
+
+	sc.NextRunOn = &next
+}
@@ -11,59 +11,152 @@ import (
 	"github.com/thecoretg/ticketbot/internal/models"
 )
 
+const defaultRunListLimit = 25
+
+var ErrInvalidPayload = errors.New("invalid sync payload")
+
+func (s *Service) ListRuns(ctx context.Context, limit int) ([]*models.SyncRun, error) {
+	if limit <= 0 {
+		limit = defaultRunListLimit
+	}
+
+	return s.Runs.List(ctx, limit)
+}
+
+func (s *Service) GetRun(ctx context.Context, id int) (*models.SyncRun, error) {
+	return s.Runs.Get(ctx, id)
+}
+
+// StartSync records a new sync run and executes it in the background. The returned run can be
+// polled by its ID to follow progress.
+func (s *Service) StartSync(ctx context.Context, payload *models.SyncPayload) (*models.SyncRun, error) {
+	run, err := s.createRun(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.execute(ctx, run); err != nil {
+			slog.Error("syncing", "run_id", run.ID, "error", err.Error())
+		}
+	}()
+
+	return run, nil
+}
+
+// Sync records a new sync run and blocks until it is complete.
 func (s *Service) Sync(ctx context.Context, payload *models.SyncPayload) error {
+	run, err := s.createRun(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	return s.execute(ctx, run)
+}
+
+func (s *Service) createRun(ctx context.Context, payload *models.SyncPayload) (*models.SyncRun, error) {
 	if payload == nil {
-		return errors.New("received nil payload")
+		return nil, fmt.Errorf("%w: received nil payload", ErrInvalidPayload)
+	}
+
+	// zero means the configured amount. The syncs share a semaphore of this size, so it can never be zero.
+	p := *payload
+	switch {
+	case p.MaxConcurrentSyncs == 0:
+		p.MaxConcurrentSyncs = s.Cfg.Current().MaxConcurrentSyncs
+	case p.MaxConcurrentSyncs < 0:
+		return nil, fmt.Errorf("%w: max concurrent syncs must be at least 1", ErrInvalidPayload)
+	}
+
+	run, err := s.Runs.Insert(ctx, &models.SyncRun{
+		Payload: p,
+		Status:  models.SyncRunStatusRunning,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("inserting sync run: %w", err)
 	}
 
-	errch := make(chan error, 3)
+	s.Audit.Record(ctx, models.AuditActionSync, models.AuditEntitySyncRun, run.ID, nil, run.Payload)
+	return run, nil
+}
+
+func (s *Service) execute(ctx context.Context, run *models.SyncRun) error {
+	payload := run.Payload
+	tr := newRunTracker(run, s.Runs)
+
+	stopWatch := tr.watch(ctx)
+
 	var wg sync.WaitGroup
 
 	start := time.Now()
-	errored := false
 	defer func() {
-		if errored {
-			slog.Error("sync complete with errors, see logs", "payload", payload, "took_seconds", time.Since(start).Seconds())
+		stopWatch()
+		r := tr.finish(ctx)
+		if r.Status == models.SyncRunStatusFailed {
+			slog.Error("sync complete with errors, see logs", "run_id", r.ID, "payload", payload, "took_seconds", time.Since(start).Seconds())
 		} else {
-			slog.Info("sync complete", "payload", payload, "took_seconds", time.Since(start).Seconds())
+			slog.Info("sync complete", "run_id", r.ID, "payload", payload, "took_seconds", time.Since(start).Seconds())
 		}
 	}()
 
 	if payload.CWBoards {
+		tr.start(models.SyncTargetCWBoards)
 		wg.Go(func() {
-			if err := s.SyncBoards(ctx); err != nil {
-				errch <- fmt.Errorf("syncing connectwise boards: %w", err)
+			if err := s.SyncBoards(ctx, tr); err != nil {
+				tr.addError(fmt.Errorf("syncing connectwise boards: %w", err))
 				return
 			}
 		})
 	}
 
 	if payload.WebexRecipients {
+		tr.start(models.SyncTargetWebexRecipients)
 		wg.Go(func() {
-			if err := s.SyncWebexRecipients(ctx, payload.MaxConcurrentSyncs); err != nil {
-				errch <- fmt.Errorf("syncing webex recipients: %w", err)
+			if err := s.SyncWebexRecipients(ctx, payload.MaxConcurrentSyncs, tr); err != nil {
+				tr.addError(fmt.Errorf("syncing webex recipients: %w", err))
 				return
 			}
 		})
 	}
 
+	// tickets upsert their company and contact, so they wait for the company sync rather than
+	// contending with its transaction for the same rows
+	companiesDone := make(chan struct{})
+	if payload.CWCompanies {
+		tr.start(models.SyncTargetCWCompanies)
+		tr.start(models.SyncTargetCWContacts)
+		wg.Go(func() {
+			defer close(companiesDone)
+			if err := s.SyncCompanies(ctx, tr); err != nil {
+				tr.addError(fmt.Errorf("syncing connectwise companies and contacts: %w", err))
+				return
+			}
+		})
+	} else {
+		close(companiesDone)
+	}
+
 	if payload.CWTickets {
+		tr.start(models.SyncTargetCWTickets)
 		wg.Go(func() {
-			if err := s.SyncOpenTickets(ctx, payload.BoardIDs, payload.MaxConcurrentSyncs); err != nil {
-				errch <- fmt.Errorf("syncing connectwise tickets: %w", err)
+			<-companiesDone
+			if err := s.SyncOpenTickets(ctx, payload.BoardIDs, payload.MaxConcurrentSyncs, tr); err != nil {
+				tr.addError(fmt.Errorf("syncing connectwise tickets: %w", err))
 				return
 			}
 		})
 	}
 
 	wg.Wait()
-	close(errch)
 
-	for err := range errch {
-		if err != nil {
-			errored = true
-			slog.Error("hook sync", "error", err.Error())
-		}
+	errs := tr.snapshot().Errors
+	for _, e := range errs {
+		slog.Error("sync", "run_id", run.ID, "error", e)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("sync run %d finished with %d errors", run.ID, len(errs))
 	}
 
 	return nil
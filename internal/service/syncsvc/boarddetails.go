@@ -0,0 +1,190 @@
+package syncsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+// SyncBoardDetails mirrors a board's types, subtypes, items, and teams into the store. Each is
+// attempted in its own savepoint even if an earlier one fails, and the errors are joined.
+func (s *Service) SyncBoardDetails(ctx context.Context, boardID int) error {
+	return errors.Join(
+		s.inSavepoint(ctx, func(sp *Service) error { return sp.syncBoardTypes(ctx, boardID) }),
+		s.inSavepoint(ctx, func(sp *Service) error { return sp.syncBoardSubtypes(ctx, boardID) }),
+		s.inSavepoint(ctx, func(sp *Service) error { return sp.syncBoardItems(ctx, boardID) }),
+		s.inSavepoint(ctx, func(sp *Service) error { return sp.syncBoardTeams(ctx, boardID) }),
+	)
+}
+
+// boardDetailRepo is the part of a board detail's repository that syncing it uses.
+type boardDetailRepo[M any] interface {
+	ListByBoard(ctx context.Context, boardID int) ([]*M, error)
+	Upsert(ctx context.Context, m *M) (*M, error)
+	SoftDelete(ctx context.Context, id int) error
+}
+
+// detailKey is what syncing needs to know about a stored board detail.
+type detailKey struct {
+	id      int
+	name    string
+	deleted bool
+}
+
+// syncBoardDetail upserts the details of a board listed from Connectwise, and soft deletes the
+// stored ones that are no longer listed. kind names the detail in errors and logs.
+func syncBoardDetail[M any](ctx context.Context, kind string, boardID int, repo boardDetailRepo[M], fromCW []*M, key func(*M) detailKey) error {
+	stored, err := repo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("listing %ss from store: %w", kind, err)
+	}
+
+	for _, m := range fromCW {
+		if _, err := repo.Upsert(ctx, m); err != nil {
+			k := key(m)
+			return fmt.Errorf("upserting %s %d (%s): %w", kind, k.id, k.name, err)
+		}
+	}
+
+	for _, m := range detailsToDelete(fromCW, stored, key) {
+		k := key(m)
+		if err := repo.SoftDelete(ctx, k.id); err != nil {
+			return fmt.Errorf("soft deleting %s %d (%s): %w", kind, k.id, k.name, err)
+		}
+	}
+
+	slog.Debug("board details sync: synced "+kind+"s", "board_id", boardID, "total", len(fromCW))
+	return nil
+}
+
+func detailsToDelete[M any](fromCW, stored []*M, key func(*M) detailKey) []*M {
+	ci := make(map[int]struct{})
+	for _, c := range fromCW {
+		ci[key(c).id] = struct{}{}
+	}
+
+	var toDelete []*M
+	for _, s := range stored {
+		k := key(s)
+		if k.deleted {
+			continue
+		}
+
+		if _, ok := ci[k.id]; !ok {
+			toDelete = append(toDelete, s)
+		}
+	}
+
+	return toDelete
+}
+
+func (s *Service) syncBoardTypes(ctx context.Context, boardID int) error {
+	cws, err := s.CW.CWClient.ListBoardTypesCtx(ctx, nil, boardID)
+	if err != nil {
+		return fmt.Errorf("listing connectwise types for board %d: %w", boardID, err)
+	}
+
+	return syncBoardDetail(ctx, "type", boardID, s.CW.Types, typesFromCW(boardID, cws), func(t *models.BoardType) detailKey {
+		return detailKey{id: t.ID, name: t.Name, deleted: t.Deleted}
+	})
+}
+
+func typesFromCW(boardID int, cwTypes []psa.BoardType) []*models.BoardType {
+	var types []*models.BoardType
+	for _, c := range cwTypes {
+		types = append(types, &models.BoardType{
+			ID:          c.ID,
+			BoardID:     boardID,
+			Name:        c.Name,
+			DefaultType: c.DefaultFlag,
+			Inactive:    c.InactiveFlag,
+		})
+	}
+
+	return types
+}
+
+func (s *Service) syncBoardSubtypes(ctx context.Context, boardID int) error {
+	cws, err := s.CW.CWClient.ListBoardSubtypesCtx(ctx, nil, boardID)
+	if err != nil {
+		return fmt.Errorf("listing connectwise subtypes for board %d: %w", boardID, err)
+	}
+
+	return syncBoardDetail(ctx, "subtype", boardID, s.CW.Subtypes, subtypesFromCW(boardID, cws), func(t *models.BoardSubtype) detailKey {
+		return detailKey{id: t.ID, name: t.Name, deleted: t.Deleted}
+	})
+}
+
+func subtypesFromCW(boardID int, cwSubtypes []psa.BoardSubtype) []*models.BoardSubtype {
+	var subtypes []*models.BoardSubtype
+	for _, c := range cwSubtypes {
+		subtypes = append(subtypes, &models.BoardSubtype{
+			ID:       c.ID,
+			BoardID:  boardID,
+			Name:     c.Name,
+			Inactive: c.InactiveFlag,
+		})
+	}
+
+	return subtypes
+}
+
+func (s *Service) syncBoardItems(ctx context.Context, boardID int) error {
+	cws, err := s.CW.CWClient.ListBoardItemsCtx(ctx, nil, boardID)
+	if err != nil {
+		return fmt.Errorf("listing connectwise items for board %d: %w", boardID, err)
+	}
+
+	return syncBoardDetail(ctx, "item", boardID, s.CW.Items, itemsFromCW(boardID, cws), func(i *models.BoardItem) detailKey {
+		return detailKey{id: i.ID, name: i.Name, deleted: i.Deleted}
+	})
+}
+
+func itemsFromCW(boardID int, cwItems []psa.BoardItem) []*models.BoardItem {
+	var items []*models.BoardItem
+	for _, c := range cwItems {
+		items = append(items, &models.BoardItem{
+			ID:       c.ID,
+			BoardID:  boardID,
+			Name:     c.Name,
+			Inactive: c.InactiveFlag,
+		})
+	}
+
+	return items
+}
+
+func (s *Service) syncBoardTeams(ctx context.Context, boardID int) error {
+	cws, err := s.CW.CWClient.ListBoardTeamsCtx(ctx, nil, boardID)
+	if err != nil {
+		return fmt.Errorf("listing connectwise teams for board %d: %w", boardID, err)
+	}
+
+	return syncBoardDetail(ctx, "team", boardID, s.CW.Teams, teamsFromCW(boardID, cws), func(t *models.BoardTeam) detailKey {
+		return detailKey{id: t.ID, name: t.Name, deleted: t.Deleted}
+	})
+}
+
+func teamsFromCW(boardID int, cwTeams []psa.BoardTeam) []*models.BoardTeam {
+	var teams []*models.BoardTeam
+	for _, c := range cwTeams {
+		var leaderID *int
+		if c.TeamLeader.ID != 0 {
+			leaderID = &c.TeamLeader.ID
+		}
+
+		teams = append(teams, &models.BoardTeam{
+			ID:          c.ID,
+			BoardID:     boardID,
+			Name:        c.Name,
+			LeaderID:    leaderID,
+			DefaultTeam: c.DefaultFlag,
+		})
+	}
+
+	return teams
+}
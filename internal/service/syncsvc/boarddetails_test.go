@@ -0,0 +1,37 @@
+package syncsvc
+
+import (
+	"testing"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+func TestBoardDetailsUseSyncedBoardID(t *testing.T) {
+	// Connectwise can leave the board out of a detail's payload
+	types := typesFromCW(7, []psa.BoardType{{ID: 1}})
+	subtypes := subtypesFromCW(7, []psa.BoardSubtype{{ID: 1}})
+	items := itemsFromCW(7, []psa.BoardItem{{ID: 1}})
+	teams := teamsFromCW(7, []psa.BoardTeam{{ID: 1}})
+
+	got := []int{types[0].BoardID, subtypes[0].BoardID, items[0].BoardID, teams[0].BoardID}
+	for i, id := range got {
+		if id != 7 {
+			t.Errorf("detail %d has board id %d, want 7", i, id)
+		}
+	}
+}
+
+func TestDetailsToDelete(t *testing.T) {
+	key := func(i *models.BoardItem) detailKey {
+		return detailKey{id: i.ID, name: i.Name, deleted: i.Deleted}
+	}
+
+	fromCW := []*models.BoardItem{{ID: 1}}
+	stored := []*models.BoardItem{{ID: 1}, {ID: 2}, {ID: 3, Deleted: true}}
+
+	toDelete := detailsToDelete(fromCW, stored, key)
+	if len(toDelete) != 1 || toDelete[0].ID != 2 {
+		t.Errorf("got %+v, want only item 2", toDelete)
+	}
+}
@@ -10,14 +10,15 @@ import (
 	"github.com/thecoretg/ticketbot/pkg/psa"
 )
 
-func (s *Service) SyncBoards(ctx context.Context) error {
+func (s *Service) SyncBoards(ctx context.Context, tr *runTracker) error {
 	start := time.Now()
 	slog.Info("beginning connectwise board sync")
-	cwb, err := s.CW.CWClient.ListBoards(nil)
+	cwb, err := s.CW.CWClient.ListBoardsCtx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("listing connectwise boards: %w", err)
 	}
 	slog.Info("board sync: got boards from connectwise", "total_boards", len(cwb))
+	tr.addTotal(models.SyncTargetCWBoards, len(cwb))
 
 	sb, err := s.CW.Boards.List(ctx)
 	if err != nil {
@@ -35,21 +36,47 @@ func (s *Service) SyncBoards(ctx context.Context) error {
 		_ = tx.Rollback(ctx)
 	}()
 
+	// each board gets its own savepoints, so one that fails doesn't abort the transaction for the rest
 	for _, b := range boardsToUpsert(cwb) {
-		if _, err := txSvc.CW.Boards.Upsert(ctx, b); err != nil {
+		err := txSvc.inSavepoint(ctx, func(sp *Service) error {
+			_, err := sp.CW.Boards.Upsert(ctx, b)
+			return err
+		})
+		if err != nil {
 			slog.Error("board sync: upserting board", "board_id", b.ID, "error", err.Error())
+			tr.failed(models.SyncTargetCWBoards)
+			tr.addError(fmt.Errorf("upserting board %d: %w", b.ID, err))
 			continue
 		}
+		tr.upserted(models.SyncTargetCWBoards)
 
-		if err := txSvc.SyncBoardStatuses(ctx, b.ID); err != nil {
+		err = txSvc.inSavepoint(ctx, func(sp *Service) error {
+			return sp.SyncBoardStatuses(ctx, b.ID)
+		})
+		if err != nil {
 			slog.Error("board sync: status sync", "board_id", b.ID, "error", err.Error())
+			tr.addError(fmt.Errorf("syncing statuses for board %d: %w", b.ID, err))
 		}
+
+		if err := txSvc.SyncBoardDetails(ctx, b.ID); err != nil {
+			slog.Error("board sync: details sync", "board_id", b.ID, "error", err.Error())
+			tr.addError(fmt.Errorf("syncing details for board %d: %w", b.ID, err))
+		}
+	}
+
+	err = txSvc.inSavepoint(ctx, func(sp *Service) error {
+		return sp.SyncPriorities(ctx)
+	})
+	if err != nil {
+		slog.Error("board sync: priority sync", "error", err.Error())
+		tr.addError(fmt.Errorf("syncing priorities: %w", err))
 	}
 
 	for _, b := range boardsToDelete(cwb, sb) {
 		if err := txSvc.CW.Boards.SoftDelete(ctx, b.ID); err != nil {
 			return fmt.Errorf("soft deleting board %d (%s): %w", b.ID, b.Name, err)
 		}
+		tr.deleted(models.SyncTargetCWBoards)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
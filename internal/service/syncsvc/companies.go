@@ -0,0 +1,136 @@
+package syncsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+// SyncCompanies syncs active companies, then contacts. Contacts reference companies, so a contact
+// whose company isn't active in Connectwise is stored without one.
+func (s *Service) SyncCompanies(ctx context.Context, tr *runTracker) error {
+	start := time.Now()
+	slog.Info("beginning connectwise company and contact sync")
+
+	cwc, err := s.CW.CWClient.ListCompaniesCtx(ctx, psa.NewQuery().
+		Where(psa.Eq("deletedFlag", false)).
+		Fields("id", "name").
+		PageSize(1000))
+	if err != nil {
+		return fmt.Errorf("listing connectwise companies: %w", err)
+	}
+	slog.Info("company sync: got companies from connectwise", "total_companies", len(cwc))
+	tr.addTotal(models.SyncTargetCWCompanies, len(cwc))
+
+	cwct, err := s.CW.CWClient.ListContactsCtx(ctx, psa.NewQuery().
+		Fields("id", "firstName", "lastName", "company").
+		PageSize(1000))
+	if err != nil {
+		return fmt.Errorf("listing connectwise contacts: %w", err)
+	}
+	slog.Info("company sync: got contacts from connectwise", "total_contacts", len(cwct))
+	tr.addTotal(models.SyncTargetCWContacts, len(cwct))
+
+	sc, err := s.CW.Companies.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing companies from store: %w", err)
+	}
+
+	sct, err := s.CW.Contacts.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing contacts from store: %w", err)
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning tx: %w", err)
+	}
+
+	txSvc := s.withTx(tx)
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	companyIDs := make(map[int]struct{}, len(cwc))
+	for _, c := range cwc {
+		if _, err := txSvc.CW.Companies.Upsert(ctx, &models.Company{ID: c.Id, Name: c.Name}); err != nil {
+			return fmt.Errorf("upserting company %d: %w", c.Id, err)
+		}
+		companyIDs[c.Id] = struct{}{}
+		tr.upserted(models.SyncTargetCWCompanies)
+	}
+
+	for _, c := range cwct {
+		if _, err := txSvc.CW.Contacts.Upsert(ctx, contactToUpsert(c, companyIDs)); err != nil {
+			return fmt.Errorf("upserting contact %d: %w", c.ID, err)
+		}
+		tr.upserted(models.SyncTargetCWContacts)
+	}
+
+	for _, c := range sc {
+		if _, ok := companyIDs[c.ID]; ok || c.Deleted {
+			continue
+		}
+
+		if err := txSvc.CW.Companies.SoftDelete(ctx, c.ID); err != nil {
+			return fmt.Errorf("soft deleting company %d (%s): %w", c.ID, c.Name, err)
+		}
+		tr.deleted(models.SyncTargetCWCompanies)
+	}
+
+	for _, c := range contactsToDelete(cwct, sct) {
+		if err := txSvc.CW.Contacts.SoftDelete(ctx, c.ID); err != nil {
+			return fmt.Errorf("soft deleting contact %d: %w", c.ID, err)
+		}
+		tr.deleted(models.SyncTargetCWContacts)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing tx: %w", err)
+	}
+
+	slog.Info("company and contact sync complete", "took_time", time.Since(start).Seconds())
+	return nil
+}
+
+func contactToUpsert(c psa.Contact, companyIDs map[int]struct{}) *models.Contact {
+	ct := &models.Contact{
+		ID:        c.ID,
+		FirstName: c.FirstName,
+	}
+
+	if c.LastName != "" {
+		ct.LastName = &c.LastName
+	}
+
+	if _, ok := companyIDs[c.Company.ID]; ok {
+		id := c.Company.ID
+		ct.CompanyID = &id
+	}
+
+	return ct
+}
+
+func contactsToDelete(cwContacts []psa.Contact, storeContacts []*models.Contact) []*models.Contact {
+	ci := make(map[int]struct{}, len(cwContacts))
+	for _, c := range cwContacts {
+		ci[c.ID] = struct{}{}
+	}
+
+	var toDelete []*models.Contact
+	for _, c := range storeContacts {
+		// skip soft deleted contacts
+		if c.Deleted {
+			continue
+		}
+		if _, ok := ci[c.ID]; !ok {
+			toDelete = append(toDelete, c)
+		}
+	}
+
+	return toDelete
+}
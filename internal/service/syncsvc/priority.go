@@ -0,0 +1,81 @@
+package syncsvc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+func (s *Service) SyncPriorities(ctx context.Context) error {
+	cwp, err := s.CW.CWClient.ListPrioritiesCtx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("listing connectwise priorities: %w", err)
+	}
+
+	sp, err := s.CW.Priorities.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing priorities from store: %w", err)
+	}
+
+	for _, p := range prioritiesToUpsert(cwp) {
+		if _, err := s.CW.Priorities.Upsert(ctx, p); err != nil {
+			return fmt.Errorf("upserting priority %d (%s): %w", p.ID, p.Name, err)
+		}
+	}
+
+	for _, p := range prioritiesToDelete(cwp, sp) {
+		if err := s.CW.Priorities.SoftDelete(ctx, p.ID); err != nil {
+			return fmt.Errorf("soft deleting priority %d (%s): %w", p.ID, p.Name, err)
+		}
+	}
+
+	slog.Info("priority sync: complete", "total_priorities", len(cwp))
+	return nil
+}
+
+func prioritiesToUpsert(cwPriorities []psa.Priority) []*models.Priority {
+	var toUpsert []*models.Priority
+	for _, c := range cwPriorities {
+		p := &models.Priority{
+			ID:              c.ID,
+			Name:            c.Name,
+			DefaultPriority: c.DefaultFlag,
+		}
+
+		if c.Color != "" {
+			p.Color = &c.Color
+		}
+
+		if c.SortOrder != 0 {
+			p.SortOrder = &c.SortOrder
+		}
+
+		toUpsert = append(toUpsert, p)
+	}
+
+	return toUpsert
+}
+
+func prioritiesToDelete(cwPriorities []psa.Priority, storePriorities []*models.Priority) []*models.Priority {
+	ci := make(map[int]struct{})
+	for _, c := range cwPriorities {
+		ci[c.ID] = struct{}{}
+	}
+
+	var toDelete []*models.Priority
+	for _, p := range storePriorities {
+		// skip soft deleted priorities
+		if p.Deleted {
+			continue
+		}
+
+		if _, ok := ci[p.ID]; !ok {
+			toDelete = append(toDelete, p)
+		}
+	}
+
+	return toDelete
+}
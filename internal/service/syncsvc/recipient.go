@@ -8,11 +8,10 @@ import (
 	"time"
 
 	"github.com/thecoretg/ticketbot/internal/models"
-	"github.com/thecoretg/ticketbot/pkg/psa"
 	"github.com/thecoretg/ticketbot/pkg/webex"
 )
 
-func (s *Service) SyncWebexRecipients(ctx context.Context, maxSyncs int) error {
+func (s *Service) SyncWebexRecipients(ctx context.Context, maxSyncs int, tr *runTracker) error {
 	slog.Info("beginning webex room sync")
 	start := time.Now()
 	defer func() {
@@ -30,11 +29,11 @@ func (s *Service) SyncWebexRecipients(ctx context.Context, maxSyncs int) error {
 		_ = tx.Rollback(ctx)
 	}()
 
-	if err := txSvc.syncWebexRooms(ctx); err != nil {
+	if err := txSvc.syncWebexRooms(ctx, tr); err != nil {
 		return fmt.Errorf("syncing webex rooms: %w", err)
 	}
 
-	if err := txSvc.syncWebexPeople(ctx, maxSyncs); err != nil {
+	if err := txSvc.syncWebexPeople(ctx, maxSyncs, tr); err != nil {
 		return fmt.Errorf("syncing webex people: %w", err)
 	}
 
@@ -45,13 +44,13 @@ func (s *Service) SyncWebexRecipients(ctx context.Context, maxSyncs int) error {
 	return nil
 }
 
-func (s *Service) syncWebexRooms(ctx context.Context) error {
+func (s *Service) syncWebexRooms(ctx context.Context, tr *runTracker) error {
 	start := time.Now()
 	defer func() {
 		slog.Info("webex room sync complete", "took_time", time.Since(start).Seconds())
 	}()
 	// get rooms from webex as source of truth
-	wr, err := s.Webex.WebexClient.ListRooms(nil)
+	wr, err := s.Webex.WebexClient.ListRoomsCtx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("getting rooms from webex: %w", err)
 	}
@@ -64,98 +63,131 @@ func (s *Service) syncWebexRooms(ctx context.Context) error {
 	}
 	slog.Info("webex room sync: got rooms from store", "total_rooms", len(sr))
 
-	for _, r := range roomsToRecipients(wr) {
+	rooms := roomsToRecipients(wr)
+	tr.addTotal(models.SyncTargetWebexRecipients, len(rooms))
+	for _, r := range rooms {
 		if _, err := s.Webex.Recipients.Upsert(ctx, r); err != nil {
+			tr.failed(models.SyncTargetWebexRecipients)
 			return fmt.Errorf("upserting room with name %s: %w", r.Name, err)
 		}
+		tr.upserted(models.SyncTargetWebexRecipients)
 	}
 
 	return nil
 }
 
-func (s *Service) syncWebexPeople(ctx context.Context, maxSyncs int) error {
+func (s *Service) syncWebexPeople(ctx context.Context, maxSyncs int, tr *runTracker) error {
 	start := time.Now()
 	defer func() {
 		slog.Info("webex people sync complete", "took_time", time.Since(start).Seconds())
 	}()
 
-	cwm, err := s.CW.CWClient.ListMembers(nil)
-	if err != nil {
-		return fmt.Errorf("getting members from connectwise: %w", err)
-	}
-	slog.Info("webex people sync: got members from connectwise", "total_members", len(cwm))
-
 	sp, err := s.Webex.Recipients.ListPeople(ctx)
 	if err != nil {
 		return fmt.Errorf("listing people from store: %w", err)
 	}
 	slog.Info("webex people sync: got people from store", "total_people", len(sp))
 
-	wp, err := s.getWxPeopleFromCwMembers(cwm, maxSyncs)
-	if err != nil {
-		return fmt.Errorf("getting webex people from connectwise members: %w", err)
-	}
+	// Webex lookups run concurrently as pages of members arrive, but upserts stay on this
+	// goroutine since they share a transaction.
+	found := make(chan webex.Person, maxSyncs)
+	done := make(chan struct{})
+	var (
+		emails  map[string]struct{}
+		findErr error
+	)
+
+	go func() {
+		defer close(done)
+		emails, findErr = s.findWxPeople(ctx, maxSyncs, found)
+	}()
+
+	// after an upsert error, keep draining so the lookups can finish
+	var upsertErr error
+	for wp := range found {
+		for _, p := range peopleToRecipients([]webex.Person{wp}) {
+			if upsertErr != nil {
+				continue
+			}
 
-	for _, p := range peopleToRecipients(wp) {
-		if _, err := s.Webex.Recipients.Upsert(ctx, p); err != nil {
-			return fmt.Errorf("upserting person with name %s: %w", p.Name, err)
+			tr.addTotal(models.SyncTargetWebexRecipients, 1)
+			if _, err := s.Webex.Recipients.Upsert(ctx, p); err != nil {
+				tr.failed(models.SyncTargetWebexRecipients)
+				upsertErr = fmt.Errorf("upserting person with name %s: %w", p.Name, err)
+				continue
+			}
+			tr.upserted(models.SyncTargetWebexRecipients)
 		}
 	}
+	<-done
+
+	if findErr != nil {
+		return fmt.Errorf("getting webex people from connectwise members: %w", findErr)
+	}
+
+	if upsertErr != nil {
+		return upsertErr
+	}
 
-	for _, d := range peopleToDelete(cwm, sp) {
+	for _, d := range peopleToDelete(emails, sp) {
 		if err := s.Webex.Recipients.Delete(ctx, d.ID); err != nil {
 			return fmt.Errorf("deleting person with id %d (%s): %w", d.ID, d.Name, err)
 		}
+		tr.deleted(models.SyncTargetWebexRecipients)
 	}
 
 	return nil
 }
 
-func (s *Service) getWxPeopleFromCwMembers(members []psa.Member, maxSyncs int) ([]webex.Person, error) {
-	sem := make(chan struct{}, maxSyncs)
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(members))
+// findWxPeople looks up the Webex person for each Connectwise member as pages of members
+// arrive, sending any matches to found and closing it when done. It returns the primary emails
+// of every member seen.
+func (s *Service) findWxPeople(ctx context.Context, maxSyncs int, found chan<- webex.Person) (map[string]struct{}, error) {
+	defer close(found)
 
+	sem := make(chan struct{}, maxSyncs)
 	var (
-		wp []webex.Person
-		mu sync.Mutex
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
 	)
 
-	for _, m := range members {
+	emails := make(map[string]struct{})
+	for m, err := range s.CW.CWClient.ListMembersSeq(ctx, nil) {
+		if err != nil {
+			wg.Wait()
+			return nil, fmt.Errorf("getting members from connectwise: %w", err)
+		}
+
+		emails[m.PrimaryEmail] = struct{}{}
+		if m.PrimaryEmail == "" {
+			continue
+		}
+
 		sem <- struct{}{}
-		wg.Add(1)
-		go func(member psa.Member) {
+		wg.Go(func() {
 			defer func() { <-sem }()
-			defer wg.Done()
-
-			if m.PrimaryEmail == "" {
-				return
-			}
 
-			ppl, err := s.Webex.WebexClient.ListPeople(member.PrimaryEmail)
+			ppl, err := s.Webex.WebexClient.ListPeopleCtx(ctx, m.PrimaryEmail)
 			if err != nil {
-				errCh <- fmt.Errorf("listing people for email %s: %w", member.PrimaryEmail, err)
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("listing people for email %s: %w", m.PrimaryEmail, err)
+				}
+				mu.Unlock()
 				return
 			}
 
-			if len(ppl) == 0 {
-				return
+			if len(ppl) > 0 {
+				found <- ppl[0]
 			}
-
-			mu.Lock()
-			wp = append(wp, ppl[0])
-			mu.Unlock()
-		}(m)
+		})
 	}
 
 	wg.Wait()
-	close(errCh)
+	slog.Info("webex people sync: checked connectwise members", "total_members", len(emails))
 
-	if len(errCh) > 0 {
-		return nil, <-errCh
-	}
-
-	return wp, nil
+	return emails, firstErr
 }
 
 func peopleToRecipients(webexPpl []webex.Person) []*models.WebexRecipient {
@@ -198,15 +230,10 @@ func roomsToRecipients(webexRooms []webex.Room) []*models.WebexRecipient {
 	return toUpsert
 }
 
-func peopleToDelete(cwMembers []psa.Member, storedPpl []*models.WebexRecipient) []*models.WebexRecipient {
-	l := make(map[string]struct{})
-	for _, m := range cwMembers {
-		l[m.PrimaryEmail] = struct{}{}
-	}
-
+func peopleToDelete(memberEmails map[string]struct{}, storedPpl []*models.WebexRecipient) []*models.WebexRecipient {
 	var toDelete []*models.WebexRecipient
 	for _, p := range storedPpl {
-		if _, ok := l[*p.Email]; !ok {
+		if _, ok := memberEmails[*p.Email]; !ok {
 			toDelete = append(toDelete, p)
 		}
 	}
@@ -0,0 +1,160 @@
+package syncsvc
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// runFlushInterval is how often the progress of an in-flight sync is written to the store,
+// so pollers can see counts move without every item causing a write.
+const runFlushInterval = 2 * time.Second
+
+// runTracker collects counts and errors for a sync run from the concurrent target syncs.
+// All methods are safe to call on a nil tracker, which just discards everything.
+type runTracker struct {
+	mu    sync.Mutex
+	run   *models.SyncRun
+	repo  models.SyncRunRepository
+	dirty bool
+}
+
+func newRunTracker(run *models.SyncRun, repo models.SyncRunRepository) *runTracker {
+	if run.Results == nil {
+		run.Results = make(map[models.SyncTarget]*models.SyncTargetResult)
+	}
+
+	return &runTracker{run: run, repo: repo}
+}
+
+func (t *runTracker) result(target models.SyncTarget) *models.SyncTargetResult {
+	r, ok := t.run.Results[target]
+	if !ok {
+		r = &models.SyncTargetResult{}
+		t.run.Results[target] = r
+	}
+
+	return r
+}
+
+func (t *runTracker) update(target models.SyncTarget, fn func(r *models.SyncTargetResult)) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fn(t.result(target))
+	t.dirty = true
+}
+
+func (t *runTracker) start(target models.SyncTarget) {
+	t.update(target, func(r *models.SyncTargetResult) {})
+}
+
+func (t *runTracker) addTotal(target models.SyncTarget, n int) {
+	t.update(target, func(r *models.SyncTargetResult) { r.Total += n })
+}
+
+func (t *runTracker) upserted(target models.SyncTarget) {
+	t.update(target, func(r *models.SyncTargetResult) { r.Upserted++ })
+}
+
+func (t *runTracker) deleted(target models.SyncTarget) {
+	t.update(target, func(r *models.SyncTargetResult) { r.Deleted++ })
+}
+
+func (t *runTracker) failed(target models.SyncTarget) {
+	t.update(target, func(r *models.SyncTargetResult) { r.Failed++ })
+}
+
+func (t *runTracker) addError(err error) {
+	if t == nil || err == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.run.Errors = append(t.run.Errors, err.Error())
+	t.dirty = true
+}
+
+// watch flushes progress to the store on an interval until the returned stop func is called.
+// stop waits for any in-flight flush, so it can't land after the final status from finish.
+func (t *runTracker) watch(ctx context.Context) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		tk := time.NewTicker(runFlushInterval)
+		defer tk.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tk.C:
+				t.flush(ctx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// finish sets the final status of the run and writes it to the store.
+func (t *runTracker) finish(ctx context.Context) *models.SyncRun {
+	t.mu.Lock()
+	now := time.Now()
+	t.run.FinishedOn = &now
+	t.run.Status = models.SyncRunStatusSucceeded
+	if len(t.run.Errors) > 0 {
+		t.run.Status = models.SyncRunStatusFailed
+	}
+	t.dirty = true
+	t.mu.Unlock()
+
+	t.flush(ctx)
+	return t.snapshot()
+}
+
+func (t *runTracker) flush(ctx context.Context) {
+	t.mu.Lock()
+	if !t.dirty {
+		t.mu.Unlock()
+		return
+	}
+	r := t.snapshotLocked()
+	t.dirty = false
+	t.mu.Unlock()
+
+	if _, err := t.repo.Update(ctx, r); err != nil {
+		slog.Error("sync run: updating progress in store", "run_id", r.ID, "error", err.Error())
+	}
+}
+
+func (t *runTracker) snapshot() *models.SyncRun {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.snapshotLocked()
+}
+
+// snapshotLocked copies the run so it can be written while syncs keep updating the original.
+func (t *runTracker) snapshotLocked() *models.SyncRun {
+	r := *t.run
+	r.Results = make(map[models.SyncTarget]*models.SyncTargetResult, len(t.run.Results))
+	for k, v := range t.run.Results {
+		res := *v
+		r.Results[k] = &res
+	}
+	r.Errors = append([]string{}, t.run.Errors...)
+
+	return &r
+}
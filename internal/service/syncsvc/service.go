@@ -1,33 +1,75 @@
 package syncsvc
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
 	"github.com/thecoretg/ticketbot/internal/service/cwsvc"
 	"github.com/thecoretg/ticketbot/internal/service/notifier"
 	"github.com/thecoretg/ticketbot/internal/service/webexsvc"
 )
 
 type Service struct {
+	Cfg      models.ConfigSource
 	CW       *cwsvc.Service
 	Webex    *webexsvc.Service
 	Notifier *notifier.Service
+	Runs     models.SyncRunRepository
+	Audit    *audit.Service
 	pool     *pgxpool.Pool
+
+	// tx is set on services made by withTx, so work can be split into savepoints
+	tx pgx.Tx
 }
 
-func New(pool *pgxpool.Pool, cw *cwsvc.Service, wx *webexsvc.Service, ns *notifier.Service) *Service {
+func New(pool *pgxpool.Pool, cfg models.ConfigSource, cw *cwsvc.Service, wx *webexsvc.Service, ns *notifier.Service, runs models.SyncRunRepository, a *audit.Service) *Service {
 	return &Service{
+		Cfg:      cfg,
 		CW:       cw,
 		Webex:    wx,
 		Notifier: ns,
+		Runs:     runs,
+		Audit:    a,
 		pool:     pool,
 	}
 }
 
 func (s *Service) withTx(tx pgx.Tx) *Service {
 	return &Service{
+		Cfg:   s.Cfg,
 		CW:    s.CW.WithTX(tx),
 		Webex: s.Webex.WithTx(tx),
+		Runs:  s.Runs,
+		Audit: s.Audit,
 		pool:  s.pool,
+		tx:    tx,
+	}
+}
+
+// inSavepoint runs fn in a savepoint of the service's transaction. A failure only rolls back fn's
+// writes, so the transaction can carry on; otherwise Postgres would abort it and fail every later
+// statement. Without a transaction, fn just runs.
+func (s *Service) inSavepoint(ctx context.Context, fn func(sp *Service) error) error {
+	if s.tx == nil {
+		return fn(s)
 	}
+
+	sp, err := s.tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning savepoint: %w", err)
+	}
+
+	defer func() {
+		_ = sp.Rollback(ctx)
+	}()
+
+	if err := fn(s.withTx(sp)); err != nil {
+		return err
+	}
+
+	return sp.Commit(ctx)
 }
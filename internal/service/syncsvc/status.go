@@ -13,7 +13,7 @@ import (
 func (s *Service) SyncBoardStatuses(ctx context.Context, boardID int) error {
 	start := time.Now()
 	slog.Info("beginning connectwise status sync", "board_id", boardID)
-	cws, err := s.CW.CWClient.ListBoardStatuss(nil, boardID)
+	cws, err := s.CW.CWClient.ListBoardStatussCtx(ctx, nil, boardID)
 	if err != nil {
 		return fmt.Errorf("listing connectwise statuses for board %d: %w", boardID, err)
 	}
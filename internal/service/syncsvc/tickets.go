@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/thecoretg/ticketbot/internal/models"
 	"github.com/thecoretg/ticketbot/pkg/psa"
 )
 
-func (s *Service) SyncOpenTickets(ctx context.Context, boardIDs []int, maxSyncs int) error {
+func (s *Service) SyncOpenTickets(ctx context.Context, boardIDs []int, maxSyncs int, tr *runTracker) error {
 	start := time.Now()
 	slog.Info("cwsvc: beginning ticket sync", "board_ids", boardIDs)
 	defer func() {
@@ -20,68 +20,51 @@ func (s *Service) SyncOpenTickets(ctx context.Context, boardIDs []int, maxSyncs
 
 	// First phase: get all OPEN tickets from connectwise and ensure they all exist
 	// in the database.
-	con := "closedFlag = false"
-	if len(boardIDs) > 0 {
-		con += fmt.Sprintf(" AND %s", boardIDParam(boardIDs))
-	}
-
-	params := map[string]string{
-		"pageSize":   "100",
-		"conditions": con,
-	}
+	q := psa.NewQuery().
+		Where(psa.Eq("closedFlag", false), psa.InInts("board/id", boardIDs)).
+		PageSize(100)
 
-	tix, err := s.CW.CWClient.ListTickets(params)
-	if err != nil {
-		return fmt.Errorf("getting open tickets from connectwise: %w", err)
-	}
-	slog.Info("cwsvc: open ticket sync: got open tickets from connectwise", "total_tickets", len(tix))
+	// tickets are processed as each page arrives rather than after listing them all
 	sem := make(chan struct{}, maxSyncs)
 	var wg sync.WaitGroup
-	errCh := make(chan error, len(tix))
+	total := 0
+
+	for ticket, err := range s.CW.CWClient.ListTicketsSeq(ctx, q) {
+		if err != nil {
+			wg.Wait()
+			return fmt.Errorf("getting open tickets from connectwise: %w", err)
+		}
+
+		total++
+		tr.addTotal(models.SyncTargetCWTickets, 1)
 
-	for _, t := range tix {
 		sem <- struct{}{}
-		wg.Add(1)
-		go func(ticket psa.Ticket) {
+		wg.Go(func() {
 			defer func() { <-sem }()
-			defer wg.Done()
-			ft, err := s.CW.ProcessTicket(ctx, ticket.ID, "sync")
-			if err != nil {
-				errCh <- fmt.Errorf("error syncing ticket %d: %w", ticket.ID, err)
-				return
+			if err := s.syncTicket(ctx, ticket.ID, tr); err != nil {
+				slog.Error("sync: syncing open ticket", "error", err.Error())
+				tr.addError(err)
 			}
-
-			if err := s.Notifier.AddSkippedNotification(ctx, ft, "ticket sync"); err != nil {
-				errCh <- fmt.Errorf("skipping notification for ticket %d note %d: %w", ft.Ticket.ID, ft.LatestNote.ID, err)
-				return
-			}
-		}(t)
+		})
 	}
 
 	wg.Wait()
-	close(errCh)
-
-	for err := range errCh {
-		if err != nil {
-			slog.Error("sync: syncing open ticket", "error", err.Error())
-		}
-	}
+	slog.Info("cwsvc: open ticket sync: processed open tickets from connectwise", "total_tickets", total)
 
 	return nil
 }
 
-func boardIDParam(ids []int) string {
-	if len(ids) == 0 {
-		return ""
+func (s *Service) syncTicket(ctx context.Context, id int, tr *runTracker) error {
+	ft, err := s.CW.ProcessTicket(ctx, id, "sync")
+	if err != nil {
+		tr.failed(models.SyncTargetCWTickets)
+		return fmt.Errorf("error syncing ticket %d: %w", id, err)
 	}
+	tr.upserted(models.SyncTargetCWTickets)
 
-	var b strings.Builder
-	for i, id := range ids {
-		fmt.Fprintf(&b, "board/id = %d", id)
-		if i < len(ids)-1 {
-			b.WriteString(" OR ")
-		}
+	if err := s.Notifier.AddSkippedNotification(ctx, ft, "ticket sync"); err != nil {
+		return fmt.Errorf("skipping notification for ticket %d note %d: %w", ft.Ticket.ID, ft.LatestNote.ID, err)
 	}
 
-	return fmt.Sprintf("(%s)", b.String())
+	return nil
 }
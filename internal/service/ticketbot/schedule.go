@@ -0,0 +1,77 @@
+package ticketbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+// ProcessScheduleEntry handles a schedule entry callback from Connectwise. If the entry
+// schedules a member on a service ticket, that member is sent a dispatch notification.
+func (s *Service) ProcessScheduleEntry(ctx context.Context, id int) error {
+	logger := slog.Default().With("schedule_entry_id", id)
+
+	e, err := s.CW.CWClient.GetScheduleEntryCtx(ctx, id, nil)
+	if err != nil {
+		return fmt.Errorf("getting schedule entry %d: %w", id, err)
+	}
+
+	if e.Type.Identifier != psa.ScheduleTypeServiceTicket || e.ObjectID == 0 {
+		logger.Debug("ticketbot: schedule entry is not for a ticket", "type", e.Type.Identifier)
+		return nil
+	}
+
+	if e.Member.ID == 0 || e.DoneFlag {
+		logger.Debug("ticketbot: schedule entry has no member or is done", "ticket_id", e.ObjectID)
+		return nil
+	}
+
+	if !s.Cfg.Current().AttemptNotify {
+		logger.Debug("ticketbot: attempt notify disabled", "ticket_id", e.ObjectID)
+		return nil
+	}
+
+	// share the ticket's lock so a dispatch and a ticket update can't race each other
+	lock := s.getTicketLock(e.ObjectID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// the stored copy is enough for the message. Storing the ticket here would make its own
+	// webhook see it as existing and miss the new ticket notification, so a ticket that isn't
+	// stored yet goes through the same processing as that webhook instead.
+	ticket, err := s.CW.GetFullTicket(ctx, e.ObjectID)
+	if errors.Is(err, models.ErrTicketNotFound) {
+		logger.Debug("ticketbot: scheduled ticket not stored yet, processing it first", "ticket_id", e.ObjectID)
+		ticket, err = s.processTicket(ctx, e.ObjectID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting ticket %d: %w", e.ObjectID, err)
+	}
+
+	member, err := s.CW.EnsureMember(ctx, e.Member.ID)
+	if err != nil {
+		return fmt.Errorf("getting member %d: %w", e.Member.ID, err)
+	}
+
+	d := &models.Dispatch{
+		ScheduleEntryID: e.ID,
+		Ticket:          ticket,
+		Member:          member,
+		Start:           e.DateStart,
+	}
+
+	if !e.DateEnd.IsZero() {
+		d.End = &e.DateEnd
+	}
+
+	if err := s.Notifier.NotifyDispatch(ctx, d); err != nil {
+		return fmt.Errorf("notifying dispatch for ticket %d: %w", e.ObjectID, err)
+	}
+
+	return nil
+}
@@ -13,13 +13,13 @@ import (
 )
 
 type Service struct {
-	Cfg         *models.Config
+	Cfg         models.ConfigSource
 	CW          *cwsvc.Service
 	Notifier    *notifier.Service
 	ticketLocks sync.Map
 }
 
-func New(cfg *models.Config, cw *cwsvc.Service, ns *notifier.Service) *Service {
+func New(cfg models.ConfigSource, cw *cwsvc.Service, ns *notifier.Service) *Service {
 	return &Service{
 		Cfg:      cfg,
 		CW:       cw,
@@ -46,30 +46,37 @@ func (s *Service) ProcessTicket(ctx context.Context, id int) (err error) {
 	lock.Lock()
 	defer lock.Unlock()
 
+	_, err = s.processTicket(ctx, id)
+	return err
+}
+
+// processTicket stores the ticket's latest data from Connectwise and notifies for it, and returns
+// the stored ticket. The caller must hold the ticket's lock.
+func (s *Service) processTicket(ctx context.Context, id int) (*models.FullTicket, error) {
 	exists, err := s.CW.Tickets.Exists(ctx, id)
 	if err != nil {
-		return fmt.Errorf("checking if ticket %d exists: %w", id, err)
+		return nil, fmt.Errorf("checking if ticket %d exists: %w", id, err)
 	}
 	isNew := !exists
 
 	ticket, err := s.CW.ProcessTicket(ctx, id, "ticketbot")
 	if err != nil {
-		return fmt.Errorf("processing ticket %d: %w", id, err)
+		return nil, fmt.Errorf("processing ticket %d: %w", id, err)
 	}
 
-	if s.Cfg.AttemptNotify {
+	if s.Cfg.Current().AttemptNotify {
 		if err := s.Notifier.Run(ctx, ticket, isNew); err != nil {
-			return fmt.Errorf("running notifier for ticket %d: %w", id, err)
+			return nil, fmt.Errorf("running notifier for ticket %d: %w", id, err)
 		}
-		return nil
+		return ticket, nil
 	}
 
 	slog.Debug("ticketbot: attempt notify disabled", "ticket_id", id)
 	if err := s.Notifier.AddSkippedNotification(ctx, ticket, "ticketbot"); err != nil {
-		return fmt.Errorf("skipping notification for ticket %d note %d: %w", ticket.Ticket.ID, ticket.LatestNote.ID, err)
+		return nil, fmt.Errorf("skipping notification for ticket %d note %d: %w", ticket.Ticket.ID, ticket.LatestNote.ID, err)
 	}
 
-	return nil
+	return ticket, nil
 }
 
 func (s *Service) getTicketLock(id int) *sync.Mutex {
@@ -0,0 +1,257 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// authCacheTTL is how long a verified key is trusted without checking the store again.
+	// Deleting a key or user clears the cache of this server, but other servers may accept it
+	// until then.
+	authCacheTTL = 30 * time.Second
+
+	// lastUsedInterval limits how often a key's last use is written, since it's on every request
+	lastUsedInterval = time.Minute
+
+	// legacyMissTTL is how long a token that matched no legacy key is rejected without checking
+	// again, and maxLegacyMisses bounds how many are remembered.
+	legacyMissTTL   = 5 * time.Minute
+	maxLegacyMisses = 10000
+
+	// legacyChecks is how many requests may compare against legacy keys at once. Each check is a
+	// bcrypt compare per legacy key, so this caps the CPU that junk tokens can use.
+	legacyChecks = 2
+)
+
+type authCache struct {
+	mu       sync.Mutex
+	entries  map[[sha256.Size]byte]authCacheEntry
+	lastUsed map[int]time.Time
+
+	// legacyMisses holds tokens that matched no legacy key, until they expire
+	legacyMisses map[[sha256.Size]byte]time.Time
+	legacySlots  chan struct{}
+}
+
+type authCacheEntry struct {
+	user    *models.APIUser
+	key     *models.APIKey
+	expires time.Time
+}
+
+func newAuthCache() *authCache {
+	return &authCache{
+		entries:      make(map[[sha256.Size]byte]authCacheEntry),
+		lastUsed:     make(map[int]time.Time),
+		legacyMisses: make(map[[sha256.Size]byte]time.Time),
+		legacySlots:  make(chan struct{}, legacyChecks),
+	}
+}
+
+func (c *authCache) get(key [sha256.Size]byte) (authCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return authCacheEntry{}, false
+	}
+
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return authCacheEntry{}, false
+	}
+
+	return e, true
+}
+
+// set caches a verified user and key until the TTL passes, or until expiresOn if it's sooner.
+// The key is nil for login sessions.
+func (c *authCache) set(key [sha256.Size]byte, u *models.APIUser, k *models.APIKey, expiresOn *time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for ck, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, ck)
+		}
+	}
+
+	expires := now.Add(authCacheTTL)
+	if expiresOn != nil && expiresOn.Before(expires) {
+		expires = *expiresOn
+	}
+
+	c.entries[key] = authCacheEntry{user: u, key: k, expires: expires}
+}
+
+// shouldRecordUse reports whether a key's use is due to be written, and marks it written if so.
+func (c *authCache) shouldRecordUse(keyID int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastUsed[keyID]) < lastUsedInterval {
+		return false
+	}
+
+	c.lastUsed[keyID] = now
+	return true
+}
+
+// legacyMissed reports whether the token recently failed to match any legacy key.
+func (c *authCache) legacyMissed(key [sha256.Size]byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires, ok := c.legacyMisses[key]
+	if !ok {
+		return false
+	}
+
+	if time.Now().After(expires) {
+		delete(c.legacyMisses, key)
+		return false
+	}
+
+	return true
+}
+
+func (c *authCache) addLegacyMiss(key [sha256.Size]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// a flood of distinct tokens shouldn't grow the map without bound; starting over only means
+	// some of them get checked again
+	if len(c.legacyMisses) >= maxLegacyMisses {
+		clear(c.legacyMisses)
+	}
+
+	c.legacyMisses[key] = time.Now().Add(legacyMissTTL)
+}
+
+// clearLegacyMisses forgets rejected tokens, for when a legacy key is added that one of them
+// might match.
+func (c *authCache) clearLegacyMisses() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.legacyMisses)
+}
+
+func (c *authCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// Authenticate returns an API key and the user it belongs to, or models.ErrInvalidAPIKey if the
+// key is unknown or expired. Keys in the current format are found by their key ID and verified
+// with one hash. Anything else is checked against the legacy keys; see findLegacyKey.
+func (s *Service) Authenticate(ctx context.Context, key string) (*models.APIUser, *models.APIKey, error) {
+	if key == "" {
+		return nil, nil, models.ErrInvalidAPIKey
+	}
+
+	cacheKey := sha256.Sum256([]byte(key))
+	if e, ok := s.authCache.get(cacheKey); ok {
+		return e.user, e.key, nil
+	}
+
+	k, err := s.findKey(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if k.Expired(time.Now()) {
+		return nil, nil, models.ErrInvalidAPIKey
+	}
+
+	u, err := s.Users.Get(ctx, k.UserID)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			return nil, nil, models.ErrInvalidAPIKey
+		}
+		return nil, nil, fmt.Errorf("getting user for key: %w", err)
+	}
+
+	s.authCache.set(cacheKey, u, k, k.ExpiresOn)
+	return u, k, nil
+}
+
+// RecordKeyUse stores when and where a key was last used. Writes for the same key are limited to
+// one a minute.
+func (s *Service) RecordKeyUse(ctx context.Context, keyID int, ip string) error {
+	if !s.authCache.shouldRecordUse(keyID) {
+		return nil
+	}
+
+	return s.Keys.SetLastUsed(ctx, keyID, ip)
+}
+
+func (s *Service) findKey(ctx context.Context, key string) (*models.APIKey, error) {
+	if keyID, secret, ok := parseKey(key); ok {
+		k, err := s.Keys.GetByKeyID(ctx, keyID)
+		if err != nil {
+			if errors.Is(err, models.ErrAPIKeyNotFound) {
+				return nil, models.ErrInvalidAPIKey
+			}
+			return nil, fmt.Errorf("getting key: %w", err)
+		}
+
+		if subtle.ConstantTimeCompare(hashSecret(secret), k.KeyHash) != 1 {
+			return nil, models.ErrInvalidAPIKey
+		}
+
+		return k, nil
+	}
+
+	return s.findLegacyKey(ctx, key)
+}
+
+// findLegacyKey checks a token against each legacy key still in its grace period. Tokens that
+// match none are remembered for a while and only a few checks run at once, since anyone can
+// send a token and each check costs a bcrypt compare per legacy key.
+func (s *Service) findLegacyKey(ctx context.Context, key string) (*models.APIKey, error) {
+	cacheKey := sha256.Sum256([]byte(key))
+	if s.authCache.legacyMissed(cacheKey) {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	keys, err := s.Keys.ListLegacy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing legacy keys: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	select {
+	case s.authCache.legacySlots <- struct{}{}:
+		defer func() { <-s.authCache.legacySlots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for _, k := range keys {
+		if bcrypt.CompareHashAndPassword(k.KeyHash, []byte(key)) == nil {
+			slog.Warn("user authenticated with a legacy api key; replace it before it expires",
+				"user_id", k.UserID, "key_id", k.ID, "legacy_until", k.LegacyUntil)
+			return k, nil
+		}
+	}
+
+	s.authCache.addLegacyMiss(cacheKey)
+	return nil, models.ErrInvalidAPIKey
+}
@@ -3,53 +3,92 @@ package user
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/thecoretg/ticketbot/internal/models"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func (s *Service) createAPIKey(ctx context.Context, email string, explicitKey *string) (string, error) {
-	u, err := s.Users.GetByEmail(ctx, email)
+const (
+	keyPrefix  = "tbk_"
+	keyIDBytes = 8
+
+	// legacyKeyGrace is how long a key in the old format works after it is stored
+	legacyKeyGrace = 30 * 24 * time.Hour
+)
+
+// createAPIKey stores a new key for the user with the payload's email, and returns it along with
+// the plaintext key.
+func (s *Service) createAPIKey(ctx context.Context, cp *models.CreateAPIKeyPayload, explicitKey *string) (*models.APIKey, string, error) {
+	for _, sc := range cp.Scopes {
+		if !models.ValidScope(sc) {
+			return nil, "", ErrInvalidScope{Scope: sc}
+		}
+	}
+
+	if cp.ExpiresOn != nil && !cp.ExpiresOn.After(time.Now()) {
+		return nil, "", ErrExpiryInPast{}
+	}
+
+	u, err := s.Users.GetByEmail(ctx, cp.Email)
 	if err != nil {
 		if errors.Is(err, models.ErrAPIUserNotFound) {
-			return "", err
+			return nil, "", err
 		}
-		return "", fmt.Errorf("getting user by email: %w", err)
+		return nil, "", fmt.Errorf("getting user by email: %w", err)
 	}
 
 	plain, err := generateKey()
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	if explicitKey != nil {
 		plain = *explicitKey
 	}
 
-	hash, err := hashKey(plain)
-	if err != nil {
-		return "", fmt.Errorf("hashing key: %w", err)
+	p := &models.APIKey{
+		UserID:    u.ID,
+		Name:      cp.Name,
+		Scopes:    cp.Scopes,
+		ExpiresOn: cp.ExpiresOn,
 	}
 
-	hint := generateKeyHint(plain)
-	p := &models.APIKey{
-		UserID:  u.ID,
-		KeyHash: hash,
-		KeyHint: &hint,
+	if keyID, secret, ok := parseKey(plain); ok {
+		p.KeyID = &keyID
+		p.KeyHash = hashSecret(secret)
+	} else {
+		// only explicit keys from test flags can be in the old format
+		slog.Warn("api key is not in the current format; storing it as a legacy key", "grace_period", legacyKeyGrace)
+		p.KeyHash, err = hashLegacyKey(plain)
+		if err != nil {
+			return nil, "", fmt.Errorf("hashing key: %w", err)
+		}
+		until := time.Now().Add(legacyKeyGrace)
+		p.LegacyUntil = &until
 	}
 
-	_, err = s.Keys.Insert(ctx, p)
+	hint := generateKeyHint(plain)
+	p.KeyHint = &hint
+
+	k, err := s.Keys.Insert(ctx, p)
 	if err != nil {
-		return "", fmt.Errorf("storing key: %w", err)
+		return nil, "", fmt.Errorf("storing key: %w", err)
 	}
 
-	return plain, nil
+	if k.LegacyUntil != nil {
+		s.authCache.clearLegacyMisses()
+	}
+
+	return k, plain, nil
 }
 
 func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey *string) error {
@@ -65,7 +104,7 @@ func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey
 	if err != nil {
 		if errors.Is(err, models.ErrAPIUserNotFound) {
 			slog.Info("initial admin not found; creating now", "email", email)
-			u, err = s.Users.Insert(ctx, email)
+			u, err = s.Users.Insert(ctx, email, models.RoleAdmin)
 			if err != nil {
 				return fmt.Errorf("creating user: %w", err)
 			}
@@ -76,6 +115,14 @@ func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey
 		slog.Info("initial admin found in store")
 	}
 
+	if u.Role != models.RoleAdmin {
+		slog.Warn("initial admin did not have the admin role; restoring it", "email", email, "role", u.Role)
+		u.Role = models.RoleAdmin
+		if _, err := s.Users.Update(ctx, u); err != nil {
+			return fmt.Errorf("restoring admin role: %w", err)
+		}
+	}
+
 	keys, err := s.Keys.List(ctx)
 	if err != nil {
 		return fmt.Errorf("getting keys: %w", err)
@@ -83,7 +130,7 @@ func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey
 
 	hasKey := false
 	for _, k := range keys {
-		if k.UserID == u.ID {
+		if k.UserID == u.ID && !k.Expired(time.Now()) {
 			hasKey = true
 			break
 		}
@@ -94,7 +141,8 @@ func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey
 		return nil
 	}
 
-	key, err := s.createAPIKey(ctx, email, explicitKey)
+	name := "bootstrap"
+	_, key, err := s.createAPIKey(ctx, &models.CreateAPIKeyPayload{Email: email, Name: &name}, explicitKey)
 	if err != nil {
 		return fmt.Errorf("creating key: %w", err)
 	}
@@ -108,16 +156,49 @@ func (s *Service) BootstrapAdmin(ctx context.Context, email string, explicitKey
 	return nil
 }
 
+// generateKey returns a new key in the format tbk_<key id>_<secret>. The key ID is public and
+// used to look the key up, and only a SHA-256 of the secret is stored.
 func generateKey() (string, error) {
+	id := make([]byte, keyIDBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("generating key id: %w", err)
+	}
+
 	raw := make([]byte, 32)
 	if _, err := rand.Read(raw); err != nil {
 		return "", fmt.Errorf("generating key: %w", err)
 	}
 
-	return base64.RawURLEncoding.EncodeToString(raw), nil
+	return fmt.Sprintf("%s%s_%s", keyPrefix, hex.EncodeToString(id), base64.RawURLEncoding.EncodeToString(raw)), nil
+}
+
+// parseKey splits a key into its key ID and secret, returning false if it isn't in the
+// current format.
+func parseKey(key string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(key, keyPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	id, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(id) != hex.EncodedLen(keyIDBytes) || secret == "" {
+		return "", "", false
+	}
+
+	if _, err := hex.DecodeString(id); err != nil {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
+
+// hashSecret hashes the secret part of a key. The secret is random, so a fast hash is safe.
+func hashSecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
 }
 
-func hashKey(key string) ([]byte, error) {
+func hashLegacyKey(key string) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(key), bcrypt.DefaultCost)
 }
 
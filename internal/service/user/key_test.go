@@ -0,0 +1,170 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		wantID     string
+		wantSecret string
+		ok         bool
+	}{
+		{name: "current format", key: "tbk_0123456789abcdef_c2VjcmV0", wantID: "0123456789abcdef", wantSecret: "c2VjcmV0", ok: true},
+		{name: "secret with underscores", key: "tbk_0123456789abcdef_a_b_c", wantID: "0123456789abcdef", wantSecret: "a_b_c", ok: true},
+		{name: "empty", key: "", ok: false},
+		{name: "legacy key", key: "some-old-key", ok: false},
+		{name: "no prefix", key: "0123456789abcdef_secret", ok: false},
+		{name: "no secret", key: "tbk_0123456789abcdef", ok: false},
+		{name: "empty secret", key: "tbk_0123456789abcdef_", ok: false},
+		{name: "short id", key: "tbk_0123_secret", ok: false},
+		{name: "long id", key: "tbk_0123456789abcdef00_secret", ok: false},
+		{name: "id not hex", key: "tbk_0123456789abcdeg_secret", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, secret, ok := parseKey(tt.key)
+			if ok != tt.ok || id != tt.wantID || secret != tt.wantSecret {
+				t.Errorf("parseKey(%q) = %q, %q, %v; want %q, %q, %v", tt.key, id, secret, ok, tt.wantID, tt.wantSecret, tt.ok)
+			}
+		})
+	}
+}
+
+func TestGeneratedKeysParse(t *testing.T) {
+	for range 20 {
+		key, err := generateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, ok := parseKey(key); !ok {
+			t.Fatalf("generated key %q doesn't parse", key)
+		}
+	}
+}
+
+// keyRepo is an in-memory APIKeyRepository that counts legacy lookups.
+type keyRepo struct {
+	models.APIKeyRepository
+	keys        []*models.APIKey
+	legacyLists int
+}
+
+func (r *keyRepo) WithTx(pgx.Tx) models.APIKeyRepository { return r }
+
+func (r *keyRepo) ListLegacy(context.Context) ([]*models.APIKey, error) {
+	r.legacyLists++
+	var keys []*models.APIKey
+	for _, k := range r.keys {
+		if k.KeyID == nil && k.LegacyUntil != nil && k.LegacyUntil.After(time.Now()) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys, nil
+}
+
+func (r *keyRepo) GetByKeyID(_ context.Context, keyID string) (*models.APIKey, error) {
+	for _, k := range r.keys {
+		if k.KeyID != nil && *k.KeyID == keyID {
+			return k, nil
+		}
+	}
+
+	return nil, models.ErrAPIKeyNotFound
+}
+
+func (r *keyRepo) Insert(_ context.Context, a *models.APIKey) (*models.APIKey, error) {
+	a.ID = len(r.keys) + 1
+	r.keys = append(r.keys, a)
+	return a, nil
+}
+
+type userRepo struct {
+	models.APIUserRepository
+}
+
+func (userRepo) Get(_ context.Context, id int) (*models.APIUser, error) {
+	return &models.APIUser{ID: id, EmailAddress: "user@example.com", Role: models.RoleAdmin}, nil
+}
+
+func (userRepo) GetByEmail(_ context.Context, email string) (*models.APIUser, error) {
+	return &models.APIUser{ID: 1, EmailAddress: email, Role: models.RoleAdmin}, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	keys := &keyRepo{}
+	s := New(userRepo{}, keys, nil, nil, nil)
+
+	current, _, err := s.createAPIKey(ctx, &models.CreateAPIKeyPayload{Email: "user@example.com"}, nil)
+	if err != nil {
+		t.Fatalf("creating key: %v", err)
+	}
+
+	legacyPlain := "an-old-style-key"
+	legacy, _, err := s.createAPIKey(ctx, &models.CreateAPIKeyPayload{Email: "user@example.com"}, &legacyPlain)
+	if err != nil {
+		t.Fatalf("creating legacy key: %v", err)
+	}
+
+	if legacy.LegacyUntil == nil || legacy.KeyID != nil {
+		t.Fatalf("explicit key in the old format should be stored as legacy, got %+v", legacy)
+	}
+
+	if current.KeyID == nil {
+		t.Fatalf("generated key should have a key id, got %+v", current)
+	}
+
+	if _, k, err := s.Authenticate(ctx, legacyPlain); err != nil || k.ID != legacy.ID {
+		t.Errorf("legacy key: got key %v, err %v", k, err)
+	}
+
+	wrongSecret := "tbk_" + *current.KeyID + "_nope"
+	if _, _, err := s.Authenticate(ctx, wrongSecret); !errors.Is(err, models.ErrInvalidAPIKey) {
+		t.Errorf("wrong secret: err = %v, want ErrInvalidAPIKey", err)
+	}
+
+	// a junk token is only checked against the legacy keys once
+	lists := keys.legacyLists
+	for range 5 {
+		if _, _, err := s.Authenticate(ctx, "junk"); !errors.Is(err, models.ErrInvalidAPIKey) {
+			t.Fatalf("junk token: err = %v, want ErrInvalidAPIKey", err)
+		}
+	}
+
+	if got := keys.legacyLists - lists; got != 1 {
+		t.Errorf("junk token listed legacy keys %d times, want 1", got)
+	}
+
+	// adding a legacy key forgets the misses, in case the new key is one of them
+	junk := "junk"
+	if _, _, err := s.createAPIKey(ctx, &models.CreateAPIKeyPayload{Email: "user@example.com"}, &junk); err != nil {
+		t.Fatalf("creating legacy key: %v", err)
+	}
+
+	if _, _, err := s.Authenticate(ctx, junk); err != nil {
+		t.Errorf("newly added legacy key: err = %v", err)
+	}
+}
+
+func TestLegacyKeysSkippedWhenNoneLeft(t *testing.T) {
+	ctx := context.Background()
+	expired := time.Now().Add(-time.Hour)
+	keys := &keyRepo{keys: []*models.APIKey{{ID: 1, UserID: 1, KeyHash: []byte("x"), LegacyUntil: &expired}}}
+	s := New(userRepo{}, keys, nil, nil, nil)
+
+	if _, _, err := s.Authenticate(ctx, "anything"); !errors.Is(err, models.ErrInvalidAPIKey) {
+		t.Errorf("err = %v, want ErrInvalidAPIKey", err)
+	}
+}
@@ -0,0 +1,389 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+const (
+	deviceLoginTTL      = 10 * time.Minute
+	deviceLoginInterval = 5 * time.Second
+
+	sessionTTL        = time.Hour
+	sessionRefreshTTL = 30 * 24 * time.Hour
+
+	refreshTokenPrefix = "tbr_"
+
+	// userCodeChars leaves out vowels and lookalikes so codes are easy to read back and can't
+	// spell words
+	userCodeChars = "BCDFGHJKLMNPQRSTVWXZ"
+)
+
+type ErrNoUserForEmail struct {
+	Email string
+}
+
+func (e ErrNoUserForEmail) Error() string {
+	return fmt.Sprintf("no user with email '%s'; ask an admin to add you", e.Email)
+}
+
+// EnableLogin sets up the identity provider for device logins. Without it, login requests return
+// models.ErrLoginNotConfigured.
+func (s *Service) EnableLogin(ctx context.Context, cfg *OAuthConfig) error {
+	p, err := newOAuthProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	s.oauth = p
+	slog.Info("login enabled", "provider", cfg.Provider, "verification_url", cfg.verificationURL())
+	return nil
+}
+
+// StartDeviceLogin begins a login for a CLI. The CLI shows the user code and verification URL,
+// then polls PollDeviceLogin with the device code until the user signs in.
+func (s *Service) StartDeviceLogin(ctx context.Context) (*models.DeviceLoginResponse, error) {
+	if s.oauth == nil {
+		return nil, models.ErrLoginNotConfigured
+	}
+
+	if err := s.Logins.DeleteExpired(ctx); err != nil {
+		slog.Warn("deleting expired device logins", "error", err.Error())
+	}
+
+	deviceCode, err := randomToken("")
+	if err != nil {
+		return nil, err
+	}
+
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := randomToken("")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.Logins.Insert(ctx, &models.DeviceLogin{
+		DeviceCodeHash: hashSecret(deviceCode),
+		UserCode:       userCode,
+		State:          state,
+		ExpiresOn:      time.Now().Add(deviceLoginTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storing device login: %w", err)
+	}
+
+	vu := s.oauth.cfg.verificationURL()
+	return &models.DeviceLoginResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                d.UserCode,
+		VerificationURI:         vu,
+		VerificationURIComplete: fmt.Sprintf("%s?user_code=%s", vu, url.QueryEscape(d.UserCode)),
+		ExpiresIn:               int(deviceLoginTTL.Seconds()),
+		Interval:                int(deviceLoginInterval.Seconds()),
+	}, nil
+}
+
+// PendingDeviceLogin returns the login a user code belongs to, if it is still waiting for the
+// user to sign in.
+func (s *Service) PendingDeviceLogin(ctx context.Context, userCode string) (*models.DeviceLogin, error) {
+	if s.oauth == nil {
+		return nil, models.ErrLoginNotConfigured
+	}
+
+	d, err := s.Logins.GetByUserCode(ctx, normalizeUserCode(userCode))
+	if err != nil {
+		return nil, err
+	}
+
+	if d.UserID != nil || d.DeniedReason != nil || time.Now().After(d.ExpiresOn) {
+		return nil, models.ErrDeviceLoginNotFound
+	}
+
+	return d, nil
+}
+
+// DeviceLoginAuthURL returns the provider URL to send the user's browser to for a pending login.
+func (s *Service) DeviceLoginAuthURL(ctx context.Context, userCode string) (string, error) {
+	d, err := s.PendingDeviceLogin(ctx, userCode)
+	if err != nil {
+		return "", err
+	}
+
+	return s.oauth.authCodeURL(d.State), nil
+}
+
+// CompleteDeviceLogin handles the provider's redirect after the user signs in. The login is
+// approved if the provider's email address belongs to a user, and denied otherwise.
+func (s *Service) CompleteDeviceLogin(ctx context.Context, state, code string) (*models.APIUser, error) {
+	if s.oauth == nil {
+		return nil, models.ErrLoginNotConfigured
+	}
+
+	d, err := s.Logins.GetByState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(d.ExpiresOn) {
+		return nil, models.ErrLoginExpired
+	}
+
+	email, err := s.oauth.email(ctx, code)
+	if err != nil {
+		s.denyDeviceLogin(ctx, d.ID, err.Error())
+		return nil, fmt.Errorf("verifying sign in: %w", err)
+	}
+
+	u, err := s.Users.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			s.denyDeviceLogin(ctx, d.ID, "no user for email")
+			slog.Warn("login denied; no user with email", "email", email)
+			return nil, ErrNoUserForEmail{Email: email}
+		}
+		return nil, fmt.Errorf("getting user by email: %w", err)
+	}
+
+	if _, err := s.Logins.Approve(ctx, d.ID, u.ID); err != nil {
+		return nil, fmt.Errorf("approving device login: %w", err)
+	}
+
+	slog.Info("device login approved", "user_id", u.ID, "email", u.EmailAddress)
+	return u, nil
+}
+
+// DenyDeviceLogin denies the login for the state, for when the provider reports an error instead
+// of a code.
+func (s *Service) DenyDeviceLogin(ctx context.Context, state, reason string) {
+	d, err := s.Logins.GetByState(ctx, state)
+	if err != nil {
+		return
+	}
+
+	s.denyDeviceLogin(ctx, d.ID, reason)
+}
+
+func (s *Service) denyDeviceLogin(ctx context.Context, id int, reason string) {
+	if _, err := s.Logins.Deny(ctx, id, reason); err != nil && !errors.Is(err, models.ErrDeviceLoginNotFound) {
+		slog.Warn("denying device login", "id", id, "error", err.Error())
+	}
+}
+
+// PollDeviceLogin returns session tokens once the user has signed in for the device code. Until
+// then it returns models.ErrAuthorizationPending. A device code can only be redeemed once.
+func (s *Service) PollDeviceLogin(ctx context.Context, deviceCode string) (*models.SessionTokens, error) {
+	if s.oauth == nil {
+		return nil, models.ErrLoginNotConfigured
+	}
+
+	d, err := s.Logins.GetByDeviceCode(ctx, hashSecret(deviceCode))
+	if err != nil {
+		if errors.Is(err, models.ErrDeviceLoginNotFound) {
+			return nil, models.ErrLoginExpired
+		}
+		return nil, fmt.Errorf("getting device login: %w", err)
+	}
+
+	switch {
+	case d.DeniedReason != nil:
+		s.deleteDeviceLogin(ctx, d.ID)
+		return nil, models.ErrLoginDenied
+	case time.Now().After(d.ExpiresOn):
+		s.deleteDeviceLogin(ctx, d.ID)
+		return nil, models.ErrLoginExpired
+	case d.UserID == nil:
+		return nil, models.ErrAuthorizationPending
+	}
+
+	s.deleteDeviceLogin(ctx, d.ID)
+
+	u, err := s.Users.Get(ctx, *d.UserID)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			return nil, models.ErrLoginDenied
+		}
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+
+	ls := &models.LoginSession{UserID: u.ID}
+	tokens, err := newSessionTokens(ls, u.EmailAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.Sessions.Insert(ctx, ls); err != nil {
+		return nil, fmt.Errorf("storing session: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (s *Service) deleteDeviceLogin(ctx context.Context, id int) {
+	if err := s.Logins.Delete(ctx, id); err != nil {
+		slog.Warn("deleting device login", "id", id, "error", err.Error())
+	}
+}
+
+// RefreshSession issues new tokens for a session and invalidates the old ones.
+func (s *Service) RefreshSession(ctx context.Context, refreshToken string) (*models.SessionTokens, error) {
+	ls, err := s.Sessions.GetByRefreshHash(ctx, hashSecret(refreshToken))
+	if err != nil {
+		if errors.Is(err, models.ErrLoginSessionNotFound) {
+			return nil, models.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("getting session: %w", err)
+	}
+
+	if time.Now().After(ls.RefreshExpiresOn) {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	u, err := s.Users.Get(ctx, ls.UserID)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			return nil, models.ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+
+	tokens, err := newSessionTokens(ls, u.EmailAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.Sessions.Refresh(ctx, ls); err != nil {
+		return nil, fmt.Errorf("storing session: %w", err)
+	}
+	s.authCache.clear()
+
+	return tokens, nil
+}
+
+// Logout ends the session the refresh token belongs to. Unknown tokens are ignored.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	ls, err := s.Sessions.GetByRefreshHash(ctx, hashSecret(refreshToken))
+	if err != nil {
+		if errors.Is(err, models.ErrLoginSessionNotFound) {
+			return nil
+		}
+		return fmt.Errorf("getting session: %w", err)
+	}
+
+	if err := s.Sessions.Delete(ctx, ls.ID); err != nil {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+	s.authCache.clear()
+
+	return nil
+}
+
+// AuthenticateSession returns the user a session access token belongs to, or
+// models.ErrInvalidAPIKey if the token is unknown or expired.
+func (s *Service) AuthenticateSession(ctx context.Context, token string) (*models.APIUser, error) {
+	cacheKey := sha256.Sum256([]byte(token))
+	if e, ok := s.authCache.get(cacheKey); ok {
+		return e.user, nil
+	}
+
+	ls, err := s.Sessions.GetByTokenHash(ctx, hashSecret(token))
+	if err != nil {
+		if errors.Is(err, models.ErrLoginSessionNotFound) {
+			return nil, models.ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("getting session: %w", err)
+	}
+
+	if time.Now().After(ls.ExpiresOn) {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	u, err := s.Users.Get(ctx, ls.UserID)
+	if err != nil {
+		if errors.Is(err, models.ErrAPIUserNotFound) {
+			return nil, models.ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("getting user for session: %w", err)
+	}
+
+	s.authCache.set(cacheKey, u, nil, &ls.ExpiresOn)
+	return u, nil
+}
+
+// newSessionTokens generates new tokens for the session, storing their hashes and expiry times
+// on it.
+func newSessionTokens(ls *models.LoginSession, email string) (*models.SessionTokens, error) {
+	access, err := randomToken(models.SessionTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := randomToken(refreshTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ls.TokenHash = hashSecret(access)
+	ls.RefreshHash = hashSecret(refresh)
+	ls.ExpiresOn = now.Add(sessionTTL)
+	ls.RefreshExpiresOn = now.Add(sessionRefreshTTL)
+
+	return &models.SessionTokens{
+		Email:            email,
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		ExpiresOn:        ls.ExpiresOn,
+		RefreshExpiresOn: ls.RefreshExpiresOn,
+	}, nil
+}
+
+func randomToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// generateUserCode returns a code like BCDF-GHJK for the user to confirm in their browser.
+func generateUserCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating user code: %w", err)
+	}
+
+	var sb strings.Builder
+	for i, c := range b {
+		if i == 4 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(userCodeChars[int(c)%len(userCodeChars)])
+	}
+
+	return sb.String(), nil
+}
+
+// normalizeUserCode accepts codes typed in lowercase or without the dash.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 8 {
+		return code
+	}
+
+	return code[:4] + "-" + code[4:]
+}
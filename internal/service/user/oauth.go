@@ -0,0 +1,212 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	ProviderWebex = "webex"
+	ProviderOIDC  = "oidc"
+
+	webexAuthURL     = "https://webexapis.com/v1/authorize"
+	webexTokenURL    = "https://webexapis.com/v1/access_token"
+	webexUserInfoURL = "https://webexapis.com/v1/userinfo"
+)
+
+// OAuthConfig is the identity provider admins sign in with. Webex is used unless the provider is
+// oidc, in which case endpoints are discovered from the issuer. Endpoints that are set explicitly
+// are never overridden.
+type OAuthConfig struct {
+	Provider     string
+	ClientID     string
+	ClientSecret string
+	Issuer       string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	Scopes       []string
+
+	// RootURL is where this server is reachable, used to build the verification and redirect URLs
+	RootURL string
+}
+
+// Enabled reports whether login is configured at all.
+func (c *OAuthConfig) Enabled() bool {
+	return c != nil && c.ClientID != ""
+}
+
+func (c *OAuthConfig) redirectURL() string {
+	return fmt.Sprintf("%s/auth/callback", c.RootURL)
+}
+
+func (c *OAuthConfig) verificationURL() string {
+	return fmt.Sprintf("%s/auth/device", c.RootURL)
+}
+
+type oauthProvider struct {
+	cfg        *OAuthConfig
+	httpClient *http.Client
+}
+
+// newOAuthProvider fills in the provider's endpoints, discovering them from the issuer for oidc.
+func newOAuthProvider(ctx context.Context, cfg *OAuthConfig) (*oauthProvider, error) {
+	p := &oauthProvider{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+	}
+
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email"}
+	}
+
+	switch cfg.Provider {
+	case "", ProviderWebex:
+		cfg.Provider = ProviderWebex
+		cfg.AuthURL = cmp.Or(cfg.AuthURL, webexAuthURL)
+		cfg.TokenURL = cmp.Or(cfg.TokenURL, webexTokenURL)
+		cfg.UserInfoURL = cmp.Or(cfg.UserInfoURL, webexUserInfoURL)
+	case ProviderOIDC:
+		if cfg.AuthURL == "" || cfg.TokenURL == "" || cfg.UserInfoURL == "" {
+			if err := p.discover(ctx); err != nil {
+				return nil, fmt.Errorf("discovering oidc endpoints: %w", err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown oauth provider %q; must be %s or %s", cfg.Provider, ProviderWebex, ProviderOIDC)
+	}
+
+	return p, nil
+}
+
+func (p *oauthProvider) discover(ctx context.Context) error {
+	if p.cfg.Issuer == "" {
+		return errors.New("no issuer set")
+	}
+
+	u := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	var d struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	}
+
+	if err := p.doJSON(req, &d); err != nil {
+		return err
+	}
+
+	p.cfg.AuthURL = cmp.Or(p.cfg.AuthURL, d.AuthorizationEndpoint)
+	p.cfg.TokenURL = cmp.Or(p.cfg.TokenURL, d.TokenEndpoint)
+	p.cfg.UserInfoURL = cmp.Or(p.cfg.UserInfoURL, d.UserInfoEndpoint)
+
+	if p.cfg.AuthURL == "" || p.cfg.TokenURL == "" || p.cfg.UserInfoURL == "" {
+		return errors.New("issuer is missing an authorization, token, or userinfo endpoint")
+	}
+
+	return nil
+}
+
+// authCodeURL is where the user's browser is sent to sign in.
+func (p *oauthProvider) authCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.cfg.ClientID)
+	v.Set("redirect_uri", p.cfg.redirectURL())
+	v.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	v.Set("state", state)
+
+	sep := "?"
+	if strings.Contains(p.cfg.AuthURL, "?") {
+		sep = "&"
+	}
+
+	return p.cfg.AuthURL + sep + v.Encode()
+}
+
+// email exchanges an authorization code for a token, and returns the verified email address of
+// the user it belongs to.
+func (p *oauthProvider) email(ctx context.Context, code string) (string, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", p.cfg.redirectURL())
+	v.Set("client_id", p.cfg.ClientID)
+	v.Set("client_secret", p.cfg.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.TokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := p.doJSON(req, &tok); err != nil {
+		return "", fmt.Errorf("exchanging code: %w", err)
+	}
+
+	if tok.AccessToken == "" {
+		return "", errors.New("provider returned no access token")
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.UserInfoURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+
+	var info struct {
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+	}
+
+	if err := p.doJSON(req, &info); err != nil {
+		return "", fmt.Errorf("getting user info: %w", err)
+	}
+
+	if info.Email == "" {
+		return "", errors.New("provider returned no email address; is the email scope allowed?")
+	}
+
+	// webex doesn't send email_verified, but its addresses are always verified
+	if info.EmailVerified != nil && !*info.EmailVerified {
+		return "", fmt.Errorf("email address %s is not verified with the provider", info.Email)
+	}
+
+	return info.Email, nil
+}
+
+func (p *oauthProvider) doJSON(req *http.Request, target any) error {
+	req.Header.Set("Accept", "application/json")
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("provider returned %s: %s", res.Status, string(body))
+	}
+
+	return json.Unmarshal(body, target)
+}
@@ -3,8 +3,11 @@ package user
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/internal/service/audit"
 )
 
 type ErrUserAlreadyExists struct {
@@ -15,21 +18,61 @@ func (e ErrUserAlreadyExists) Error() string {
 	return fmt.Sprintf("user with email '%s' already exists", e.Email)
 }
 
+type ErrInvalidRole struct {
+	Role models.Role
+}
+
+func (e ErrInvalidRole) Error() string {
+	return fmt.Sprintf("invalid role '%s'; must be one of %v", e.Role, models.Roles)
+}
+
+type ErrInvalidScope struct {
+	Scope string
+}
+
+func (e ErrInvalidScope) Error() string {
+	return fmt.Sprintf("invalid scope '%s'; must be one of %v", e.Scope, models.APIKeyScopes)
+}
+
+type ErrExpiryInPast struct{}
+
+func (e ErrExpiryInPast) Error() string {
+	return "api key expiry must be in the future"
+}
+
 type ErrCannotDeleteSelf struct{}
 
 func (e ErrCannotDeleteSelf) Error() string {
 	return "cannot delete your own user account"
 }
 
+type ErrCannotChangeOwnRole struct{}
+
+func (e ErrCannotChangeOwnRole) Error() string {
+	return "cannot change the role of your own user account"
+}
+
+// defaultRotateGrace is how long a rotated key keeps working if no grace period is given.
+const defaultRotateGrace = 24 * time.Hour
+
 type Service struct {
-	Users models.APIUserRepository
-	Keys  models.APIKeyRepository
+	Users     models.APIUserRepository
+	Keys      models.APIKeyRepository
+	Logins    models.DeviceLoginRepository
+	Sessions  models.LoginSessionRepository
+	Audit     *audit.Service
+	authCache *authCache
+	oauth     *oauthProvider
 }
 
-func New(u models.APIUserRepository, k models.APIKeyRepository) *Service {
+func New(u models.APIUserRepository, k models.APIKeyRepository, dl models.DeviceLoginRepository, ls models.LoginSessionRepository, a *audit.Service) *Service {
 	return &Service{
-		Users: u,
-		Keys:  k,
+		Users:     u,
+		Keys:      k,
+		Logins:    dl,
+		Sessions:  ls,
+		Audit:     a,
+		authCache: newAuthCache(),
 	}
 }
 
@@ -45,7 +88,16 @@ func (s *Service) GetUserByEmail(ctx context.Context, email string) (*models.API
 	return s.Users.GetByEmail(ctx, email)
 }
 
-func (s *Service) InsertUser(ctx context.Context, email string) (*models.APIUser, error) {
+// InsertUser creates a user with the given role, or read only if the role is empty.
+func (s *Service) InsertUser(ctx context.Context, email string, role models.Role) (*models.APIUser, error) {
+	if role == "" {
+		role = models.RoleReadOnly
+	}
+
+	if !role.Valid() {
+		return nil, ErrInvalidRole{Role: role}
+	}
+
 	exists, err := s.Users.Exists(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("checking if user exists: %w", err)
@@ -55,14 +107,70 @@ func (s *Service) InsertUser(ctx context.Context, email string) (*models.APIUser
 		return nil, ErrUserAlreadyExists{Email: email}
 	}
 
-	return s.Users.Insert(ctx, email)
+	u, err := s.Users.Insert(ctx, email, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Audit.Record(ctx, models.AuditActionCreate, models.AuditEntityAPIUser, u.ID, nil, u)
+	return u, nil
+}
+
+// UpdateUser changes a user's email address or role. Users can't change their own role, so the
+// last admin can't lock everyone out by mistake.
+func (s *Service) UpdateUser(ctx context.Context, u *models.APIUser, authenticatedUserID int) (*models.APIUser, error) {
+	if !u.Role.Valid() {
+		return nil, ErrInvalidRole{Role: u.Role}
+	}
+
+	before, err := s.Users.Get(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.ID == authenticatedUserID && u.Role != before.Role {
+		return nil, ErrCannotChangeOwnRole{}
+	}
+
+	if !strings.EqualFold(u.EmailAddress, before.EmailAddress) {
+		exists, err := s.Users.Exists(ctx, u.EmailAddress)
+		if err != nil {
+			return nil, fmt.Errorf("checking if user exists: %w", err)
+		}
+
+		if exists {
+			return nil, ErrUserAlreadyExists{Email: u.EmailAddress}
+		}
+	}
+
+	updated, err := s.Users.Update(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// cached logins still carry the old role
+	s.authCache.clear()
+	s.Audit.Record(ctx, models.AuditActionUpdate, models.AuditEntityAPIUser, updated.ID, before, updated)
+	return updated, nil
 }
 
 func (s *Service) DeleteUser(ctx context.Context, id int, authenticatedUserID int) error {
 	if id == authenticatedUserID {
 		return ErrCannotDeleteSelf{}
 	}
-	return s.Users.Delete(ctx, id)
+
+	u, err := s.Users.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Users.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.authCache.clear()
+	s.Audit.Record(ctx, models.AuditActionDelete, models.AuditEntityAPIUser, id, u, nil)
+	return nil
 }
 
 func (s *Service) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
@@ -74,10 +182,97 @@ func (s *Service) GetAPIKey(ctx context.Context, id int) (*models.APIKey, error)
 }
 
 // AddAPIKey creates an API key and returns the plaintext (only once)
-func (s *Service) AddAPIKey(ctx context.Context, email string) (string, error) {
-	return s.createAPIKey(ctx, email, nil)
+func (s *Service) AddAPIKey(ctx context.Context, p *models.CreateAPIKeyPayload) (*models.CreateAPIKeyResponse, error) {
+	k, plain, err := s.createAPIKey(ctx, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Audit.Record(ctx, models.AuditActionCreate, models.AuditEntityAPIKey, k.ID, nil, auditKey(k))
+	return &models.CreateAPIKeyResponse{ID: k.ID, Email: p.Email, Key: plain}, nil
+}
+
+// RotateAPIKey issues a new key with the same name, scopes, and expiry as an existing one, and
+// makes the old key expire after the grace period so clients can switch over. A zero grace uses
+// the default.
+func (s *Service) RotateAPIKey(ctx context.Context, id int, grace time.Duration) (*models.RotateAPIKeyResponse, error) {
+	if grace <= 0 {
+		grace = defaultRotateGrace
+	}
+
+	old, err := s.Keys.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.Users.Get(ctx, old.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("getting key's user: %w", err)
+	}
+
+	cp := &models.CreateAPIKeyPayload{
+		Email:     u.EmailAddress,
+		Name:      old.Name,
+		Scopes:    old.Scopes,
+		ExpiresOn: old.ExpiresOn,
+	}
+
+	// a new key can't be created already expired, so drop an expiry that has passed
+	if cp.ExpiresOn != nil && !cp.ExpiresOn.After(time.Now()) {
+		cp.ExpiresOn = nil
+	}
+
+	k, plain, err := s.createAPIKey(ctx, cp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating new key: %w", err)
+	}
+
+	revokeOn := time.Now().Add(grace)
+	if old.ExpiresOn != nil && old.ExpiresOn.Before(revokeOn) {
+		revokeOn = *old.ExpiresOn
+	}
+
+	revoked, err := s.Keys.SetExpiry(ctx, old.ID, &revokeOn)
+	if err != nil {
+		return nil, fmt.Errorf("scheduling old key for revocation: %w", err)
+	}
+	s.authCache.clear()
+
+	// the old key is the one rotated; the new key is in the after state along with its new expiry
+	s.Audit.Record(ctx, models.AuditActionRotate, models.AuditEntityAPIKey, old.ID, auditKey(old), map[string]*models.APIKey{
+		"old_key": auditKey(revoked),
+		"new_key": auditKey(k),
+	})
+
+	return &models.RotateAPIKeyResponse{
+		CreateAPIKeyResponse: models.CreateAPIKeyResponse{ID: k.ID, Email: u.EmailAddress, Key: plain},
+		OldKeyID:             old.ID,
+		OldKeyExpiresOn:      revokeOn,
+	}, nil
 }
 
 func (s *Service) DeleteAPIKey(ctx context.Context, id int) error {
-	return s.Keys.Delete(ctx, id)
+	k, err := s.Keys.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Keys.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.authCache.clear()
+	s.Audit.Record(ctx, models.AuditActionDelete, models.AuditEntityAPIKey, id, auditKey(k), nil)
+	return nil
+}
+
+// auditKey returns a copy of the key without its hash, which has no place in the audit log.
+func auditKey(k *models.APIKey) *models.APIKey {
+	if k == nil {
+		return nil
+	}
+
+	c := *k
+	c.KeyHash = nil
+	return &c
 }
@@ -25,6 +25,10 @@ func (s *Service) GetRecipient(ctx context.Context, id int) (*models.WebexRecipi
 	return s.Recipients.Get(ctx, id)
 }
 
+func (s *Service) ListRecipientsByEmail(ctx context.Context, email string) ([]*models.WebexRecipient, error) {
+	return s.Recipients.ListByEmail(ctx, email)
+}
+
 func (s *Service) EnsurePersonRecipientByEmail(ctx context.Context, email string) (*models.WebexRecipient, error) {
 	recips, err := s.Recipients.ListByEmail(ctx, email)
 	if err != nil {
@@ -39,7 +43,7 @@ func (s *Service) EnsurePersonRecipientByEmail(ctx context.Context, email string
 		return getMostActive(recips), nil
 	}
 
-	wxr, err := s.WebexClient.ListPeople(email)
+	wxr, err := s.WebexClient.ListPeopleCtx(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("fetching people from webex api: %w", err)
 	}
@@ -69,11 +69,7 @@ func (s *Service) ProcessAllHooks() error {
 }
 
 func (s *Service) ProcessCWHooks() error {
-	p := map[string]string{
-		"pageSize": "1000",
-	}
-
-	cwh, err := s.CWClient.ListCallbacks(p)
+	cwh, err := s.CWClient.ListCallbacks(psa.NewQuery().PageSize(1000))
 	if err != nil {
 		return fmt.Errorf("listing connectwise callbacks: %w", err)
 	}
@@ -83,6 +79,10 @@ func (s *Service) ProcessCWHooks() error {
 		return fmt.Errorf("processing ticketbot hook: %w", err)
 	}
 
+	if err := s.processCWHook(schedulesWebhookURL(s.RootURL), "schedule", "owner", 1, cwh); err != nil {
+		return fmt.Errorf("processing schedule hook: %w", err)
+	}
+
 	return nil
 }
 
@@ -95,6 +95,7 @@ func (s *Service) ProcessWebexHooks() error {
 	slog.Debug("webex hook sync: got existing webex hooks", "total", len(hs))
 
 	aURL := fmt.Sprintf("%s/hooks/webex/attachmentActions", s.RootURL)
+	mURL := fmt.Sprintf("%s/hooks/webex/messages", s.RootURL)
 	errch := make(chan error, 2)
 
 	var wg sync.WaitGroup
@@ -104,6 +105,12 @@ func (s *Service) ProcessWebexHooks() error {
 		}
 	})
 
+	wg.Go(func() {
+		if err := s.processWebexHook("TicketBot: Received Messages", mURL, "messages", "created", "", hs); err != nil {
+			errch <- fmt.Errorf("processing webex messages hook: %w", err)
+		}
+	})
+
 	wg.Wait()
 	close(errch)
 
@@ -219,3 +226,7 @@ func wxHooksMatch(expected, existing webex.Webhook) bool {
 func ticketsWebhookURL(rootURL string) string {
 	return fmt.Sprintf("%s/hooks/cw/tickets", rootURL)
 }
+
+func schedulesWebhookURL(rootURL string) string {
+	return fmt.Sprintf("%s/hooks/cw/schedules", rootURL)
+}
@@ -2,7 +2,9 @@ package tui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
@@ -24,7 +26,10 @@ type (
 		keys             []models.APIKey
 		keyToDelete      models.APIKey
 		keyDeleteConfirm bool
+		keyToRotate      models.APIKey
+		keyRotateConfirm bool
 		createdKey       string
+		rotatedKey       *models.RotateAPIKeyResponse
 		errorMsg         error
 	}
 
@@ -33,7 +38,10 @@ type (
 	}
 
 	apiKeysFormResult struct {
-		user models.APIUser
+		user       models.APIUser
+		name       string
+		scopes     []string
+		expireDays string
 	}
 
 	refreshAPIKeysMsg struct{}
@@ -80,8 +88,16 @@ func (akm *apiKeysModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				akm.status = statusConfirm
 				return akm, akm.form.Init()
 			}
+		case key.Matches(msg, allKeys.rotateItem) && akm.status == statusMain:
+			if len(akm.keys) > 0 {
+				akm.keyToRotate = akm.keys[akm.table.Cursor()]
+				akm.form = confirmationForm(fmt.Sprintf("Rotate API key ID %d? The old key keeps working for 24 hours.", akm.keyToRotate.ID), &akm.keyRotateConfirm, akm.parent.availHeight)
+				akm.status = statusConfirm
+				return akm, akm.form.Init()
+			}
 		case msg.String() == "enter" && akm.status == statusShowKey:
 			akm.createdKey = ""
+			akm.rotatedKey = nil
 			akm.status = statusRefresh
 			return akm, func() tea.Msg { return refreshAPIKeysMsg{} }
 		}
@@ -108,6 +124,24 @@ func (akm *apiKeysModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return akm, akm.form.Init()
 
 	case confirmDeleteMsg:
+		// the confirmation form is shared, so a pending rotation takes precedence
+		if akm.keyToRotate.ID != 0 {
+			var id int
+			if akm.keyRotateConfirm {
+				id = akm.keyToRotate.ID
+			}
+
+			// reset values
+			akm.keyRotateConfirm = false
+			akm.keyToRotate = models.APIKey{}
+
+			if id != 0 {
+				return akm, akm.rotateKey(id)
+			}
+			akm.status = statusMain
+			break
+		}
+
 		var id int
 		if akm.keyDeleteConfirm {
 			id = akm.keyToDelete.ID
@@ -149,6 +183,7 @@ func (akm *apiKeysModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 		switch akm.form.State {
 		case huh.StateAborted:
+			akm.keyToRotate = models.APIKey{}
 			akm.status = statusMain
 
 		case huh.StateCompleted:
@@ -159,7 +194,7 @@ func (akm *apiKeysModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case statusEntry:
 				res := akm.formResult
 				akm.status = statusRefresh
-				cmds = append(cmds, akm.submitKey(res.user.EmailAddress))
+				cmds = append(cmds, akm.submitKey(res))
 			}
 		}
 
@@ -196,7 +231,13 @@ func (akm *apiKeysModel) View() string {
 func (akm *apiKeysModel) showKeyView() string {
 	var b strings.Builder
 	b.WriteString("\n")
-	b.WriteString("  API Key Created Successfully!\n\n")
+	if akm.rotatedKey != nil {
+		b.WriteString("  API Key Rotated Successfully!\n\n")
+		fmt.Fprintf(&b, "  Old key ID %d stops working at %s.\n", akm.rotatedKey.OldKeyID, akm.rotatedKey.OldKeyExpiresOn.Local().Format("2006-01-02 15:04"))
+		fmt.Fprintf(&b, "  New key ID: %d\n\n", akm.rotatedKey.ID)
+	} else {
+		b.WriteString("  API Key Created Successfully!\n\n")
+	}
 	b.WriteString("  IMPORTANT: Copy this key now. It will not be shown again.\n\n")
 	fmt.Fprintf(&b, "  %s\n\n", akm.createdKey)
 	b.WriteString("  Press ENTER to continue...")
@@ -226,14 +267,20 @@ func (akm *apiKeysModel) setTableDimensions() {
 	t := &akm.table
 	idW := 6
 	userIDW := 8
-	hintW := 10
-	remainingW := w - idW - userIDW - hintW
-	createdW := remainingW
+	hintW := 32
+	expiresW := 16
+	lastUsedW := 34
+	remainingW := w - idW - userIDW - hintW - expiresW - lastUsedW
+	nameW := remainingW / 2
+	scopesW := remainingW - nameW
 	t.SetColumns([]table.Column{
 		{Title: "ID", Width: idW},
 		{Title: "USER", Width: userIDW},
+		{Title: "NAME", Width: nameW},
 		{Title: "HINT", Width: hintW},
-		{Title: "CREATED", Width: createdW},
+		{Title: "SCOPES", Width: scopesW},
+		{Title: "EXPIRES", Width: expiresW},
+		{Title: "LAST USED", Width: lastUsedW},
 	})
 
 	t.SetRows(apiKeysToRows(akm.keys))
@@ -255,14 +302,42 @@ func (akm *apiKeysModel) prepareForm() tea.Cmd {
 	}
 }
 
-func (akm *apiKeysModel) submitKey(email string) tea.Cmd {
+func (akm *apiKeysModel) submitKey(res *apiKeysFormResult) tea.Cmd {
 	return func() tea.Msg {
-		key, err := akm.parent.SDKClient.CreateAPIKey(email)
+		p := &models.CreateAPIKeyPayload{
+			Email:  res.user.EmailAddress,
+			Scopes: res.scopes,
+		}
+
+		if res.name != "" {
+			p.Name = &res.name
+		}
+
+		if res.expireDays != "" {
+			days, _ := strconv.Atoi(res.expireDays)
+			exp := time.Now().AddDate(0, 0, days)
+			p.ExpiresOn = &exp
+		}
+
+		key, err := akm.parent.SDKClient.CreateAPIKey(p)
 		if err != nil {
 			return errMsg{fmt.Errorf("creating API key: %w", err)}
 		}
 
-		akm.createdKey = key
+		akm.createdKey = key.Key
+		return showCreatedKeyMsg{}
+	}
+}
+
+func (akm *apiKeysModel) rotateKey(id int) tea.Cmd {
+	return func() tea.Msg {
+		r, err := akm.parent.SDKClient.RotateAPIKey(id, 0)
+		if err != nil {
+			return errMsg{fmt.Errorf("rotating API key: %w", err)}
+		}
+
+		akm.createdKey = r.Key
+		akm.rotatedKey = r
 		return showCreatedKeyMsg{}
 	}
 }
@@ -298,7 +373,7 @@ func apiKeysToRows(keys []models.APIKey) []table.Row {
 	if len(keys) == 0 {
 		return []table.Row{
 			{
-				"NO", "API", "KEYS", "FOUND",
+				"NO", "API", "KEYS", "FOUND", "", "", "",
 			},
 		}
 	}
@@ -307,12 +382,43 @@ func apiKeysToRows(keys []models.APIKey) []table.Row {
 		hint := "N/A"
 		if k.KeyHint != nil && *k.KeyHint != "" {
 			hint = "****" + *k.KeyHint
+			if k.KeyID != nil {
+				hint = fmt.Sprintf("tbk_%s_****%s", *k.KeyID, *k.KeyHint)
+			} else if k.LegacyUntil != nil {
+				hint = fmt.Sprintf("%s (legacy until %s)", hint, k.LegacyUntil.Format("2006-01-02"))
+			}
+		}
+		name := "N/A"
+		if k.Name != nil && *k.Name != "" {
+			name = *k.Name
 		}
+
+		scopes := "all"
+		if len(k.Scopes) > 0 {
+			scopes = strings.Join(k.Scopes, ",")
+		}
+
+		expires := "never"
+		if k.ExpiresOn != nil {
+			expires = k.ExpiresOn.Local().Format("2006-01-02 15:04")
+		}
+
+		lastUsed := "never"
+		if k.LastUsedAt != nil {
+			lastUsed = k.LastUsedAt.Local().Format("2006-01-02 15:04")
+			if k.LastUsedIP != nil {
+				lastUsed = fmt.Sprintf("%s (%s)", lastUsed, *k.LastUsedIP)
+			}
+		}
+
 		rows = append(rows, []string{
 			fmt.Sprintf("%d", k.ID),
 			fmt.Sprintf("%d", k.UserID),
+			name,
 			hint,
-			k.CreatedOn.Format("2006-01-02 15:04"),
+			scopes,
+			expires,
+			lastUsed,
 		})
 	}
 
@@ -327,10 +433,37 @@ func apiKeyEntryForm(users []models.APIUser, result *apiKeysFormResult, height i
 				Title("Select User").
 				Options(opts...).
 				Value(&result.user),
+			huh.NewInput().
+				Title("Name").
+				Description("Optional description of what the key is for").
+				Value(&result.name),
+			huh.NewMultiSelect[string]().
+				Title("Scopes").
+				Description("Leave empty for full access").
+				Options(huh.NewOptions(models.APIKeyScopes...)...).
+				Value(&result.scopes),
+			huh.NewInput().
+				Title("Expires In (Days)").
+				Description("Leave empty for a key that never expires").
+				Validate(validateExpireDays).
+				Value(&result.expireDays),
 		),
 	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false)
 }
 
+func validateExpireDays(s string) error {
+	if s == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return fmt.Errorf("must be a positive number of days")
+	}
+
+	return nil
+}
+
 func usersToFormOpts(users []models.APIUser) []huh.Option[models.APIUser] {
 	var opts []huh.Option[models.APIUser]
 	for _, u := range users {
@@ -0,0 +1,333 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/thecoretg/ticketbot/internal/models"
+	"github.com/thecoretg/ticketbot/pkg/sdk"
+)
+
+type (
+	auditModel struct {
+		parent *Model
+
+		table          table.Model
+		form           *huh.Form
+		filter         *auditFormResult
+		status         subModelStatus
+		previousStatus subModelStatus
+		events         []models.AuditEvent
+		selected       models.AuditEvent
+		errorMsg       error
+	}
+
+	auditFormResult struct {
+		userID int
+		action models.AuditAction
+		entity models.AuditEntity
+	}
+
+	refreshAuditMsg   struct{}
+	gotAuditEventsMsg struct{ events []models.AuditEvent }
+)
+
+func newAuditModel(parent *Model, initialEvents []models.AuditEvent) *auditModel {
+	am := &auditModel{
+		parent: parent,
+		events: initialEvents,
+		table:  newTable(),
+		filter: &auditFormResult{},
+		status: statusMain,
+	}
+	am.setModuleDimensions()
+	return am
+}
+
+func (am *auditModel) Init() tea.Cmd {
+	return nil
+}
+
+func (am *auditModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "enter" && am.status == statusError:
+			am.errorMsg = nil
+			am.status = am.previousStatus
+			return am, nil
+		case msg.String() == "enter" && am.status == statusShowDetail:
+			am.status = statusMain
+			return am, nil
+		case key.Matches(msg, allKeys.viewItem) && am.status == statusMain:
+			if len(am.events) > 0 {
+				am.selected = am.events[am.table.Cursor()]
+				am.status = statusShowDetail
+				return am, nil
+			}
+		case key.Matches(msg, allKeys.filterItems) && am.status == statusMain:
+			am.form = auditFilterForm(am.parent.usersModel.users, am.filter, am.parent.availHeight)
+			am.status = statusEntry
+			return am, am.form.Init()
+		}
+
+	case switchModelMsg:
+		// events pile up in the background, so get the latest whenever the tab is opened
+		if msg.modelType == modelTypeAudit && am.status == statusMain {
+			am.status = statusRefresh
+			return am, am.getEvents()
+		}
+
+	case resizeModelsMsg:
+		am.setModuleDimensions()
+		if am.status == statusInit {
+			am.status = statusMain
+		}
+
+	case refreshAuditMsg:
+		return am, am.getEvents()
+
+	case gotAuditEventsMsg:
+		am.events = msg.events
+		am.status = statusMain
+		am.setRows()
+		return am, nil
+
+	case errMsg:
+		if am.status == statusRefresh {
+			am.previousStatus = statusMain
+		} else {
+			am.previousStatus = am.status
+		}
+		am.errorMsg = msg.error
+		am.status = statusError
+	}
+
+	var cmds []tea.Cmd
+	switch am.status {
+	case statusEntry:
+		form, cmd := am.form.Update(msg)
+		if f, ok := form.(*huh.Form); ok {
+			am.form = f
+		}
+
+		cmds = append(cmds, cmd)
+		switch am.form.State {
+		case huh.StateAborted:
+			am.status = statusMain
+		case huh.StateCompleted:
+			am.status = statusRefresh
+			cmds = append(cmds, am.getEvents())
+		}
+
+	case statusMain:
+		var cmd tea.Cmd
+		am.table, cmd = am.table.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return am, tea.Batch(cmds...)
+}
+
+func (am *auditModel) View() string {
+	switch am.status {
+	case statusInit:
+		return fillSpaceCentered(useSpinner(spn, "Loading audit events..."), am.parent.width, am.parent.availHeight)
+	case statusRefresh:
+		return fillSpaceCentered(useSpinner(spn, "Refreshing..."), am.parent.width, am.parent.availHeight)
+	case statusError:
+		return renderErrorView(am.errorMsg, am.parent.width, am.parent.availHeight)
+	case statusEntry:
+		return am.form.View()
+	case statusShowDetail:
+		return am.detailView()
+	}
+
+	return am.table.View()
+}
+
+// detailView shows the full before and after states of the selected event, cut to fit.
+func (am *auditModel) detailView() string {
+	e := am.selected
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  Event %d: %s %s", e.ID, e.Action, e.EntityType)
+	if e.EntityID != nil {
+		fmt.Fprintf(&b, " %d", *e.EntityID)
+	}
+	fmt.Fprintf(&b, " by %s at %s\n\n", am.userName(e.UserID), e.CreatedOn.Local().Format("2006-01-02 15:04:05"))
+
+	for _, s := range []struct {
+		label string
+		state json.RawMessage
+	}{{"Before", e.Before}, {"After", e.After}} {
+		fmt.Fprintf(&b, "  %s:\n", s.label)
+		if len(s.state) == 0 {
+			b.WriteString("    (none)\n\n")
+			continue
+		}
+
+		var out bytes.Buffer
+		if err := json.Indent(&out, s.state, "    ", "  "); err != nil {
+			out.Write(s.state)
+		}
+		fmt.Fprintf(&b, "    %s\n\n", out.String())
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	if limit := am.parent.availHeight - 2; limit > 0 && len(lines) > limit {
+		lines = append(lines[:limit], "  ...")
+	}
+
+	return strings.Join(lines, "\n") + "\n  Press ENTER to go back"
+}
+
+func (am *auditModel) Status() subModelStatus {
+	return am.status
+}
+
+func (am *auditModel) Form() *huh.Form {
+	return am.form
+}
+
+func (am *auditModel) Table() table.Model {
+	return am.table
+}
+
+func (am *auditModel) setModuleDimensions() {
+	am.setTableDimensions()
+}
+
+func (am *auditModel) setTableDimensions() {
+	w := am.parent.width
+	h := am.parent.availHeight
+	t := &am.table
+	idW := 6
+	timeW := 20
+	actionW := 8
+	entityW := 18
+	entityIDW := 10
+	remainingW := max(0, w-idW-timeW-actionW-entityW-entityIDW)
+	userW := remainingW / 3
+	changedW := remainingW - userW
+	t.SetColumns([]table.Column{
+		{Title: "ID", Width: idW},
+		{Title: "TIME", Width: timeW},
+		{Title: "USER", Width: userW},
+		{Title: "ACTION", Width: actionW},
+		{Title: "ENTITY", Width: entityW},
+		{Title: "ENTITY ID", Width: entityIDW},
+		{Title: "CHANGED", Width: changedW},
+	})
+
+	t.SetRows(am.eventsToRows())
+	t.SetHeight(h)
+}
+
+func (am *auditModel) getEvents() tea.Cmd {
+	return func() tea.Msg {
+		q := &sdk.AuditQuery{
+			UserID:     am.filter.userID,
+			Action:     am.filter.action,
+			EntityType: am.filter.entity,
+		}
+
+		events, err := am.parent.SDKClient.ListAuditEvents(q)
+		if err != nil {
+			return errMsg{fmt.Errorf("getting audit events: %w", err)}
+		}
+
+		return gotAuditEventsMsg{events: events}
+	}
+}
+
+func (am *auditModel) setRows() {
+	am.table.SetRows(am.eventsToRows())
+}
+
+// userName returns the email of the user with the id, if the users tab knows it.
+func (am *auditModel) userName(id *int) string {
+	if id == nil {
+		return "system"
+	}
+
+	if am.parent.usersModel != nil {
+		for _, u := range am.parent.usersModel.users {
+			if u.ID == *id {
+				return u.EmailAddress
+			}
+		}
+	}
+
+	return strconv.Itoa(*id)
+}
+
+func (am *auditModel) eventsToRows() []table.Row {
+	if len(am.events) == 0 {
+		return []table.Row{
+			{
+				"NO", "AUDIT", "EVENTS", "FOUND",
+			},
+		}
+	}
+
+	var rows []table.Row
+	for _, e := range am.events {
+		entityID := "NA"
+		if e.EntityID != nil {
+			entityID = strconv.Itoa(*e.EntityID)
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(e.ID),
+			e.CreatedOn.Local().Format("2006-01-02 15:04:05"),
+			am.userName(e.UserID),
+			string(e.Action),
+			string(e.EntityType),
+			entityID,
+			strings.Join(e.ChangedFields(), ", "),
+		})
+	}
+
+	return rows
+}
+
+func auditFilterForm(users []models.APIUser, result *auditFormResult, height int) *huh.Form {
+	userOpts := []huh.Option[int]{huh.NewOption("All", 0)}
+	for _, u := range users {
+		userOpts = append(userOpts, huh.NewOption(u.EmailAddress, u.ID))
+	}
+
+	actionOpts := []huh.Option[models.AuditAction]{huh.NewOption("All", models.AuditAction(""))}
+	for _, a := range models.AuditActions {
+		actionOpts = append(actionOpts, huh.NewOption(string(a), a))
+	}
+
+	entityOpts := []huh.Option[models.AuditEntity]{huh.NewOption("All", models.AuditEntity(""))}
+	for _, e := range models.AuditEntities {
+		entityOpts = append(entityOpts, huh.NewOption(string(e), e))
+	}
+
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[int]().
+				Title("User").
+				Options(userOpts...).
+				Value(&result.userID),
+			huh.NewSelect[models.AuditAction]().
+				Title("Action").
+				Options(actionOpts...).
+				Value(&result.action),
+			huh.NewSelect[models.AuditEntity]().
+				Title("Entity").
+				Options(entityOpts...).
+				Value(&result.entity),
+		),
+	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false)
+}
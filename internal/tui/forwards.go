@@ -25,6 +25,7 @@ type (
 		status           subModelStatus
 		previousStatus   subModelStatus
 		fwds             []models.NotifierForwardFull
+		fwdToEdit        *models.NotifierForwardFull
 		fwdToDelete      models.NotifierForwardFull
 		fwdDeleteConfirm bool
 		errorMsg         error
@@ -40,6 +41,7 @@ type (
 		start     string
 		end       string
 		userKeeps bool
+		enabled   bool
 	}
 
 	refreshFwdsMsg struct{}
@@ -78,8 +80,16 @@ func (fm *fwdsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			fm.status = fm.previousStatus
 			return fm, nil
 		case key.Matches(msg, allKeys.newItem) && fm.status == statusMain:
+			fm.fwdToEdit = nil
 			fm.status = statusLoadingFormData
 			return fm, fm.prepareForm()
+		case key.Matches(msg, allKeys.editItem) && fm.status == statusMain:
+			if len(fm.fwds) > 0 {
+				f := fm.fwds[fm.table.Cursor()]
+				fm.fwdToEdit = &f
+				fm.status = statusLoadingFormData
+				return fm, fm.prepareForm()
+			}
 		case key.Matches(msg, allKeys.deleteItem) && fm.status == statusMain:
 			if len(fm.fwds) > 0 {
 				fm.fwdToDelete = fm.fwds[fm.table.Cursor()]
@@ -106,8 +116,11 @@ func (fm *fwdsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return fm, fm.setRows()
 
 	case fwdsFormDataMsg:
-		fm.formResult = &fwdsFormResult{}
-		fm.form = fwdEntryForm(msg.recips, fm.formResult)
+		fm.formResult = &fwdsFormResult{enabled: true}
+		if f := fm.fwdToEdit; f != nil {
+			fm.formResult = fwdToFormRes(f, msg.recips)
+		}
+		fm.form = fwdEntryForm(msg.recips, fm.formResult, fm.fwdToEdit != nil)
 		fm.status = statusEntry
 		return fm, fm.form.Init()
 
@@ -160,7 +173,13 @@ func (fm *fwdsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				res := fm.formResult
 				fwd := fwdFormResToForm(res)
 				fm.status = statusRefresh
-				cmds = append(cmds, fm.submitFwd(fwd))
+				if fm.fwdToEdit != nil {
+					fwd.ID = fm.fwdToEdit.ID
+					fm.fwdToEdit = nil
+					cmds = append(cmds, fm.updateFwd(fwd))
+				} else {
+					cmds = append(cmds, fm.submitFwd(fwd))
+				}
 			}
 		}
 
@@ -271,6 +290,17 @@ func (fm *fwdsModel) submitFwd(fwd *models.NotifierForward) tea.Cmd {
 	}
 }
 
+func (fm *fwdsModel) updateFwd(fwd *models.NotifierForward) tea.Cmd {
+	return func() tea.Msg {
+		_, err := fm.parent.SDKClient.UpdateUserForward(fwd)
+		if err != nil {
+			return errMsg{fmt.Errorf("updating notifier forward: %w", err)}
+		}
+
+		return refreshFwdsMsg{}
+	}
+}
+
 func (fm *fwdsModel) deleteFwd(id int) tea.Cmd {
 	return func() tea.Msg {
 		if err := fm.parent.SDKClient.DeleteUserForward(id); err != nil {
@@ -334,9 +364,19 @@ func fwdsToRows(fwds []models.NotifierForwardFull) []table.Row {
 	return rows
 }
 
-func fwdEntryForm(recips []models.WebexRecipient, result *fwdsFormResult) *huh.Form {
+// fwdEntryForm is used for both new and existing forwards. New forwards are always enabled, so
+// only existing ones get the enabled toggle.
+func fwdEntryForm(recips []models.WebexRecipient, result *fwdsFormResult, editing bool) *huh.Form {
 	theme := huh.ThemeBase16()
 	theme.Focused.ErrorMessage = lipgloss.NewStyle().Foreground(red)
+	enabledGroup := huh.NewGroup(
+		huh.NewConfirm().
+			Title("Enabled").
+			Negative("No").
+			Affirmative("Yes").
+			Value(&result.enabled),
+	).WithHide(!editing)
+
 	return huh.NewForm(
 		huh.NewGroup(
 			huh.NewSelect[models.WebexRecipient]().
@@ -397,6 +437,7 @@ func fwdEntryForm(recips []models.WebexRecipient, result *fwdsFormResult) *huh.F
 				Affirmative("Yes").
 				Value(&result.userKeeps),
 		),
+		enabledGroup,
 	).WithTheme(theme).WithShowHelp(false) // add +1 to height to account for not showing help
 }
 
@@ -405,7 +446,7 @@ func fwdFormResToForm(res *fwdsFormResult) *models.NotifierForward {
 		SourceID:      res.src.ID,
 		DestID:        res.dst.ID,
 		UserKeepsCopy: res.userKeeps,
-		Enabled:       true,
+		Enabled:       res.enabled,
 	}
 
 	if strings.TrimSpace(res.start) != "" {
@@ -420,3 +461,29 @@ func fwdFormResToForm(res *fwdsFormResult) *models.NotifierForward {
 
 	return fwd
 }
+
+func fwdToFormRes(f *models.NotifierForwardFull, recips []models.WebexRecipient) *fwdsFormResult {
+	res := &fwdsFormResult{
+		userKeeps: f.UserKeepsCopy,
+		enabled:   f.Enabled,
+	}
+
+	for _, r := range recips {
+		switch r.ID {
+		case f.SourceID:
+			res.src = r
+		case f.DestinationID:
+			res.dst = r
+		}
+	}
+
+	if f.StartDate != nil {
+		res.start = f.StartDate.Format("2006-01-02")
+	}
+
+	if f.EndDate != nil {
+		res.end = f.EndDate.Format("2006-01-02")
+	}
+
+	return res
+}
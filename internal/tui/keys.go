@@ -7,13 +7,21 @@ import (
 )
 
 type keyMap struct {
-	quit               key.Binding
-	switchModelRules   key.Binding
-	switchModelFwds    key.Binding
-	switchModelUsers   key.Binding
-	switchModelAPIKeys key.Binding
-	newItem            key.Binding
-	deleteItem         key.Binding
+	quit                key.Binding
+	switchModelRules    key.Binding
+	switchModelFwds     key.Binding
+	switchModelUsers    key.Binding
+	switchModelAPIKeys  key.Binding
+	switchModelSyncRuns key.Binding
+	switchModelScheds   key.Binding
+	switchModelAudit    key.Binding
+	newItem             key.Binding
+	editItem            key.Binding
+	deleteItem          key.Binding
+	toggleItem          key.Binding
+	rotateItem          key.Binding
+	filterItems         key.Binding
+	viewItem            key.Binding
 }
 
 var allKeys = keyMap{
@@ -33,14 +41,43 @@ var allKeys = keyMap{
 	switchModelAPIKeys: key.NewBinding(
 		key.WithKeys("ctrl+a"),
 	),
+	switchModelSyncRuns: key.NewBinding(
+		key.WithKeys("ctrl+s"),
+	),
+	switchModelScheds: key.NewBinding(
+		key.WithKeys("ctrl+t"),
+	),
+	switchModelAudit: key.NewBinding(
+		key.WithKeys("ctrl+l"),
+	),
 	newItem: key.NewBinding(
 		key.WithKeys("n"),
 		key.WithHelp("n", "new"),
 	),
+	editItem: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "edit"),
+	),
 	deleteItem: key.NewBinding(
 		key.WithKeys("x"),
 		key.WithHelp("x", "delete"),
 	),
+	toggleItem: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle"),
+	),
+	rotateItem: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "rotate"),
+	),
+	filterItems: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "filter"),
+	),
+	viewItem: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "details"),
+	),
 }
 
 // ShortHelp() is here to satisfy an interface
@@ -55,29 +92,43 @@ func (k keyMap) FullHelp() [][]key.Binding {
 
 func (m *Model) helpKeys() []key.Binding {
 	var keys []key.Binding
-	keys = append(keys, allKeys.quit, allKeys.newItem)
+	keys = append(keys, allKeys.quit)
 
 	if m.activeModel == nil {
+		return append(keys, allKeys.newItem)
+	}
+
+	// the audit log is read only
+	if m.activeModel == m.auditModel {
+		keys = append(keys, allKeys.filterItems)
+		if m.auditModel.status == statusMain && len(m.auditModel.events) > 0 {
+			keys = append(keys, allKeys.viewItem)
+		}
 		return keys
 	}
+	keys = append(keys, allKeys.newItem)
 
 	if m.activeModel.Status() == statusMain && len(m.activeModel.Table().Rows()) != 0 {
 		switch m.activeModel {
 		case m.rulesModel:
 			if len(m.rulesModel.rules) > 0 {
-				keys = append(keys, allKeys.deleteItem)
+				keys = append(keys, allKeys.editItem, allKeys.deleteItem)
 			}
 		case m.fwdsModel:
 			if len(m.fwdsModel.fwds) > 0 {
-				keys = append(keys, allKeys.deleteItem)
+				keys = append(keys, allKeys.editItem, allKeys.deleteItem)
 			}
 		case m.usersModel:
 			if len(m.usersModel.users) > 0 {
-				keys = append(keys, allKeys.deleteItem)
+				keys = append(keys, allKeys.editItem, allKeys.deleteItem)
 			}
 		case m.apiKeysModel:
 			if len(m.apiKeysModel.keys) > 0 {
-				keys = append(keys, allKeys.deleteItem)
+				keys = append(keys, allKeys.rotateItem, allKeys.deleteItem)
+			}
+		case m.schedsModel:
+			if len(m.schedsModel.schedules) > 0 {
+				keys = append(keys, allKeys.toggleItem, allKeys.deleteItem)
 			}
 		}
 	}
@@ -105,5 +156,8 @@ func isGlobalKey(msg tea.KeyMsg) bool {
 		key.Matches(msg, allKeys.switchModelRules) ||
 		key.Matches(msg, allKeys.switchModelFwds) ||
 		key.Matches(msg, allKeys.switchModelUsers) ||
-		key.Matches(msg, allKeys.switchModelAPIKeys)
+		key.Matches(msg, allKeys.switchModelAPIKeys) ||
+		key.Matches(msg, allKeys.switchModelSyncRuns) ||
+		key.Matches(msg, allKeys.switchModelScheds) ||
+		key.Matches(msg, allKeys.switchModelAudit)
 }
@@ -27,6 +27,9 @@ type Model struct {
 	fwdsModel     *fwdsModel
 	usersModel    *usersModel
 	apiKeysModel  *apiKeysModel
+	syncRunsModel *syncRunsModel
+	schedsModel   *schedulesModel
+	auditModel    *auditModel
 	help          help.Model
 	width         int
 	height        int
@@ -34,10 +37,13 @@ type Model struct {
 }
 
 type modelsReadyMsg struct {
-	rules   *rulesModel
-	fwds    *fwdsModel
-	users   *usersModel
-	apiKeys *apiKeysModel
+	rules    *rulesModel
+	fwds     *fwdsModel
+	users    *usersModel
+	apiKeys  *apiKeysModel
+	syncRuns *syncRunsModel
+	scheds   *schedulesModel
+	audit    *auditModel
 }
 
 type subModel interface {
@@ -79,11 +85,29 @@ func (m *Model) createSubModels() tea.Cmd {
 			return errMsg{fmt.Errorf("listing initial API keys: %w", err)}
 		}
 
+		syncRuns, err := m.SDKClient.ListSyncRuns(0)
+		if err != nil {
+			return errMsg{fmt.Errorf("listing initial sync runs: %w", err)}
+		}
+
+		scheds, err := m.SDKClient.ListSyncSchedules()
+		if err != nil {
+			return errMsg{fmt.Errorf("listing initial sync schedules: %w", err)}
+		}
+
+		events, err := m.SDKClient.ListAuditEvents(nil)
+		if err != nil {
+			return errMsg{fmt.Errorf("listing initial audit events: %w", err)}
+		}
+
 		return modelsReadyMsg{
-			rules:   newRulesModel(m, rules),
-			fwds:    newFwdsModel(m, fwds),
-			users:   newUsersModel(m, users),
-			apiKeys: newAPIKeysModel(m, apiKeys),
+			rules:    newRulesModel(m, rules),
+			fwds:     newFwdsModel(m, fwds),
+			users:    newUsersModel(m, users),
+			apiKeys:  newAPIKeysModel(m, apiKeys),
+			syncRuns: newSyncRunsModel(m, syncRuns),
+			scheds:   newSchedulesModel(m, scheds),
+			audit:    newAuditModel(m, events),
 		}
 	}
 }
@@ -114,7 +138,16 @@ func (m *Model) identifyCurrentKey() tea.Cmd {
 			return errMsg{fmt.Errorf("getting API keys to identify current: %w", err)}
 		}
 
+		// current keys are tbk_<key id>_<secret>; legacy keys can only be found by their hash
+		keyID, _, _ := strings.Cut(strings.TrimPrefix(m.currentAPIKey, "tbk_"), "_")
 		for _, k := range keys {
+			if k.KeyID != nil {
+				if *k.KeyID == keyID {
+					return gotCurrentKeyMsg{keyID: k.ID}
+				}
+				continue
+			}
+
 			if err := compareBcryptHash(k.KeyHash, m.currentAPIKey); err == nil {
 				return gotCurrentKeyMsg{keyID: k.ID}
 			}
@@ -152,6 +185,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.usersModel = am
 			case *apiKeysModel:
 				m.apiKeysModel = am
+			case *syncRunsModel:
+				m.syncRunsModel = am
+			case *schedulesModel:
+				m.schedsModel = am
+			case *auditModel:
+				m.auditModel = am
 			}
 
 			cmds = append(cmds, cmd)
@@ -167,6 +206,22 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, switchModel(modelTypeUsers)
 		case key.Matches(msg, allKeys.switchModelAPIKeys):
 			return m, switchModel(modelTypeAPIKeys)
+		case key.Matches(msg, allKeys.switchModelSyncRuns):
+			return m, switchModel(modelTypeSyncRuns)
+		case key.Matches(msg, allKeys.switchModelScheds):
+			return m, switchModel(modelTypeScheds)
+		case key.Matches(msg, allKeys.switchModelAudit):
+			return m, switchModel(modelTypeAudit)
+		}
+
+	case syncRunsTickMsg:
+		// the poll tick must reach the sync runs model even when it isn't active, or it would stop
+		if m.syncRunsModel != nil && m.activeModel != m.syncRunsModel {
+			sr, cmd := m.syncRunsModel.Update(msg)
+			if s, ok := sr.(*syncRunsModel); ok {
+				m.syncRunsModel = s
+			}
+			return m, cmd
 		}
 
 	case modelsReadyMsg:
@@ -174,9 +229,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.fwdsModel = msg.fwds
 		m.usersModel = msg.users
 		m.apiKeysModel = msg.apiKeys
+		m.syncRunsModel = msg.syncRuns
+		m.schedsModel = msg.scheds
+		m.auditModel = msg.audit
 		m.activeModel = m.rulesModel
 		m.initialized = true
-		return m, tea.Batch(m.rulesModel.Init(), m.fwdsModel.Init(), m.usersModel.Init(), m.apiKeysModel.Init())
+		return m, tea.Batch(m.rulesModel.Init(), m.fwdsModel.Init(), m.usersModel.Init(), m.apiKeysModel.Init(), m.syncRunsModel.Init(), m.schedsModel.Init(), m.auditModel.Init())
 
 	case switchModelMsg:
 		switch msg.modelType {
@@ -196,6 +254,18 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.activeModel != m.apiKeysModel {
 				m.activeModel = m.apiKeysModel
 			}
+		case modelTypeSyncRuns:
+			if m.activeModel != m.syncRunsModel {
+				m.activeModel = m.syncRunsModel
+			}
+		case modelTypeScheds:
+			if m.activeModel != m.schedsModel {
+				m.activeModel = m.schedsModel
+			}
+		case modelTypeAudit:
+			if m.activeModel != m.auditModel {
+				m.activeModel = m.auditModel
+			}
 		}
 	case gotCurrentUserMsg:
 		m.currentUserID = msg.userID
@@ -228,6 +298,24 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.apiKeysModel = ak
 		}
 		cmds = append(cmds, cmd)
+	case m.syncRunsModel:
+		syncRuns, cmd := m.syncRunsModel.Update(msg)
+		if sr, ok := syncRuns.(*syncRunsModel); ok {
+			m.syncRunsModel = sr
+		}
+		cmds = append(cmds, cmd)
+	case m.schedsModel:
+		scheds, cmd := m.schedsModel.Update(msg)
+		if sc, ok := scheds.(*schedulesModel); ok {
+			m.schedsModel = sc
+		}
+		cmds = append(cmds, cmd)
+	case m.auditModel:
+		audit, cmd := m.auditModel.Update(msg)
+		if a, ok := audit.(*auditModel); ok {
+			m.auditModel = a
+		}
+		cmds = append(cmds, cmd)
 	}
 
 	var cmd tea.Cmd
@@ -250,6 +338,9 @@ func (m *Model) headerView() string {
 	fl := "[F] FORWARDS"
 	ul := "[U] USERS"
 	kl := "[A] KEYS"
+	sl := "[S] SYNCS"
+	tl := "[T] SCHEDULES"
+	al := "[L] AUDIT"
 	rulesTab := menuLabelStyle.Render(rl)
 	if m.activeModel == m.rulesModel {
 		rulesTab = activeMenuLabelStyle.Render(rl)
@@ -270,7 +361,22 @@ func (m *Model) headerView() string {
 		keysTab = activeMenuLabelStyle.Render(kl)
 	}
 
-	tabs := []string{rulesTab, fwdsTab, usersTab, keysTab}
+	syncsTab := menuLabelStyle.Render(sl)
+	if m.activeModel == m.syncRunsModel {
+		syncsTab = activeMenuLabelStyle.Render(sl)
+	}
+
+	schedsTab := menuLabelStyle.Render(tl)
+	if m.activeModel == m.schedsModel {
+		schedsTab = activeMenuLabelStyle.Render(tl)
+	}
+
+	auditTab := menuLabelStyle.Render(al)
+	if m.activeModel == m.auditModel {
+		auditTab = activeMenuLabelStyle.Render(al)
+	}
+
+	tabs := []string{rulesTab, fwdsTab, usersTab, keysTab, syncsTab, schedsTab, auditTab}
 	leaderKey := menuLabelStyle.Render("CTRL + ")
 	sep := " / "
 	content := lipgloss.JoinHorizontal(lipgloss.Bottom, leaderKey, strings.Join(tabs, sep), " ")
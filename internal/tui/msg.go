@@ -23,6 +23,9 @@ const (
 	modelTypeFwds
 	modelTypeUsers
 	modelTypeAPIKeys
+	modelTypeSyncRuns
+	modelTypeScheds
+	modelTypeAudit
 )
 
 func switchModel(m modelType) tea.Cmd {
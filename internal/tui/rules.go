@@ -21,6 +21,7 @@ type (
 		status            subModelStatus
 		previousStatus    subModelStatus
 		rules             []models.NotifierRuleFull
+		ruleToEdit        *models.NotifierRuleFull
 		ruleToDelete      models.NotifierRuleFull
 		ruleDeleteConfirm bool
 		errorMsg          error
@@ -32,8 +33,9 @@ type (
 	}
 
 	rulesFormResult struct {
-		board models.Board
-		recip models.WebexRecipient
+		board   models.Board
+		recip   models.WebexRecipient
+		enabled bool
 	}
 
 	refreshRulesMsg struct{}
@@ -65,8 +67,16 @@ func (rm *rulesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			rm.status = rm.previousStatus
 			return rm, nil
 		case key.Matches(msg, allKeys.newItem) && rm.status == statusMain:
+			rm.ruleToEdit = nil
 			rm.status = statusLoadingFormData
 			return rm, tea.Batch(rm.prepareForm())
+		case key.Matches(msg, allKeys.editItem) && rm.status == statusMain:
+			if len(rm.rules) > 0 {
+				r := rm.rules[rm.table.Cursor()]
+				rm.ruleToEdit = &r
+				rm.status = statusLoadingFormData
+				return rm, rm.prepareForm()
+			}
 		case key.Matches(msg, allKeys.deleteItem) && rm.status == statusMain:
 			if len(rm.rules) > 0 {
 				rm.ruleToDelete = rm.rules[rm.table.Cursor()]
@@ -94,8 +104,21 @@ func (rm *rulesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return rm, tea.Batch(rm.setRows())
 
 	case ruleFormDataMsg:
-		rm.formResult = &rulesFormResult{}
-		rm.form = ruleEntryForm(msg.boards, msg.recips, rm.formResult, rm.parent.availHeight)
+		rm.formResult = &rulesFormResult{enabled: true}
+		if r := rm.ruleToEdit; r != nil {
+			rm.formResult.enabled = r.Enabled
+			for _, b := range msg.boards {
+				if b.ID == r.BoardID {
+					rm.formResult.board = b
+				}
+			}
+			for _, w := range msg.recips {
+				if w.ID == r.RecipientID {
+					rm.formResult.recip = w
+				}
+			}
+		}
+		rm.form = ruleEntryForm(msg.boards, msg.recips, rm.formResult, rm.ruleToEdit != nil, rm.parent.availHeight)
 		rm.status = statusEntry
 		return rm, rm.form.Init()
 
@@ -149,10 +172,16 @@ func (rm *rulesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				rule := &models.NotifierRule{
 					CwBoardID:        res.board.ID,
 					WebexRecipientID: res.recip.ID,
-					NotifyEnabled:    true,
+					NotifyEnabled:    res.enabled,
 				}
 				rm.status = statusRefresh
-				cmds = append(cmds, rm.submitRule(rule))
+				if rm.ruleToEdit != nil {
+					rule.ID = rm.ruleToEdit.ID
+					rm.ruleToEdit = nil
+					cmds = append(cmds, rm.updateRule(rule))
+				} else {
+					cmds = append(cmds, rm.submitRule(rule))
+				}
 			}
 		}
 
@@ -258,6 +287,17 @@ func (rm *rulesModel) submitRule(rule *models.NotifierRule) tea.Cmd {
 	}
 }
 
+func (rm *rulesModel) updateRule(rule *models.NotifierRule) tea.Cmd {
+	return func() tea.Msg {
+		_, err := rm.parent.SDKClient.UpdateNotifierRule(rule)
+		if err != nil {
+			return errMsg{fmt.Errorf("updating notifier rule: %w", err)}
+		}
+
+		return refreshRulesMsg{}
+	}
+}
+
 func (rm *rulesModel) deleteRule(id int) tea.Cmd {
 	return func() tea.Msg {
 		if err := rm.parent.SDKClient.DeleteNotifierRule(id); err != nil {
@@ -303,8 +343,10 @@ func rulesToRows(rules []models.NotifierRuleFull) []table.Row {
 	return rows
 }
 
-func ruleEntryForm(boards []models.Board, recips []models.WebexRecipient, result *rulesFormResult, height int) *huh.Form {
-	return huh.NewForm(
+// ruleEntryForm is used for both new and existing rules. New rules are always enabled, so only
+// existing ones get the enabled toggle.
+func ruleEntryForm(boards []models.Board, recips []models.WebexRecipient, result *rulesFormResult, editing bool, height int) *huh.Form {
+	groups := []*huh.Group{
 		huh.NewGroup(
 			huh.NewSelect[models.Board]().
 				Title("Connectwise Board").
@@ -317,5 +359,17 @@ func ruleEntryForm(boards []models.Board, recips []models.WebexRecipient, result
 				Options(recipsToFormOpts(recips, nil)...).
 				Value(&result.recip),
 		),
-	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false) // add +1 to height to account for not showing help
+	}
+
+	if editing {
+		groups = append(groups, huh.NewGroup(
+			huh.NewConfirm().
+				Title("Notify Enabled").
+				Negative("No").
+				Affirmative("Yes").
+				Value(&result.enabled),
+		))
+	}
+
+	return huh.NewForm(groups...).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false) // add +1 to height to account for not showing help
 }
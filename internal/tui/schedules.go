@@ -0,0 +1,412 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+type (
+	schedulesModel struct {
+		parent *Model
+
+		table                 table.Model
+		form                  *huh.Form
+		formResult            *schedulesFormResult
+		status                subModelStatus
+		previousStatus        subModelStatus
+		schedules             []models.SyncSchedule
+		scheduleToDelete      models.SyncSchedule
+		scheduleDeleteConfirm bool
+		errorMsg              error
+	}
+
+	schedulesFormResult struct {
+		name     string
+		cron     string
+		targets  []models.SyncTarget
+		boardIDs []int
+	}
+
+	scheduleFormDataMsg struct {
+		boards []models.Board
+	}
+
+	refreshSchedulesMsg struct{}
+	gotSchedulesMsg     struct{ schedules []models.SyncSchedule }
+)
+
+func newSchedulesModel(parent *Model, initialSchedules []models.SyncSchedule) *schedulesModel {
+	sm := &schedulesModel{
+		parent:     parent,
+		schedules:  initialSchedules,
+		table:      newTable(),
+		formResult: &schedulesFormResult{},
+		status:     statusMain,
+	}
+	sm.setModuleDimensions()
+	return sm
+}
+
+func (sm *schedulesModel) Init() tea.Cmd {
+	return nil
+}
+
+func (sm *schedulesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "enter" && sm.status == statusError:
+			sm.errorMsg = nil
+			sm.status = sm.previousStatus
+			return sm, nil
+		case key.Matches(msg, allKeys.newItem) && sm.status == statusMain:
+			sm.status = statusLoadingFormData
+			return sm, sm.prepareForm()
+		case key.Matches(msg, allKeys.toggleItem) && sm.status == statusMain:
+			if len(sm.schedules) > 0 {
+				s := sm.schedules[sm.table.Cursor()]
+				s.Enabled = !s.Enabled
+				sm.status = statusRefresh
+				return sm, sm.updateSchedule(&s)
+			}
+		case key.Matches(msg, allKeys.deleteItem) && sm.status == statusMain:
+			if len(sm.schedules) > 0 {
+				sm.scheduleToDelete = sm.schedules[sm.table.Cursor()]
+				sm.form = confirmationForm(fmt.Sprintf("Delete schedule %s?", sm.scheduleToDelete.Name), &sm.scheduleDeleteConfirm, sm.parent.availHeight)
+				sm.status = statusConfirm
+				return sm, sm.form.Init()
+			}
+		}
+
+	case resizeModelsMsg:
+		sm.setModuleDimensions()
+		if sm.status == statusInit {
+			sm.status = statusMain
+		}
+
+	case refreshSchedulesMsg:
+		return sm, sm.getSchedules()
+
+	case gotSchedulesMsg:
+		sm.schedules = msg.schedules
+		sm.status = statusMain
+		return sm, sm.setRows()
+
+	case scheduleFormDataMsg:
+		sm.formResult = &schedulesFormResult{}
+		sm.form = scheduleEntryForm(msg.boards, sm.formResult, sm.parent.availHeight)
+		sm.status = statusEntry
+		return sm, sm.form.Init()
+
+	case confirmDeleteMsg:
+		var id int
+		if sm.scheduleDeleteConfirm {
+			id = sm.scheduleToDelete.ID
+		}
+
+		// reset values
+		sm.scheduleDeleteConfirm = false
+		sm.scheduleToDelete = models.SyncSchedule{}
+
+		if id != 0 {
+			return sm, sm.deleteSchedule(id)
+		}
+		sm.status = statusMain
+
+	case errMsg:
+		// If we're in a transient/loading status, go back to main after error
+		if sm.status == statusLoadingFormData || sm.status == statusRefresh {
+			sm.previousStatus = statusMain
+		} else {
+			sm.previousStatus = sm.status
+		}
+		sm.errorMsg = msg.error
+		sm.status = statusError
+	}
+
+	var cmds []tea.Cmd
+	switch sm.status {
+	case statusEntry, statusConfirm:
+		form, cmd := sm.form.Update(msg)
+		if f, ok := form.(*huh.Form); ok {
+			sm.form = f
+		}
+
+		cmds = append(cmds, cmd)
+		switch sm.form.State {
+		case huh.StateAborted:
+			sm.status = statusMain
+
+		case huh.StateCompleted:
+			switch sm.status {
+			case statusConfirm:
+				sm.status = statusRefresh
+				cmds = append(cmds, completeConfirmForm())
+			case statusEntry:
+				res := sm.formResult
+				s := &models.SyncSchedule{
+					Name: res.name,
+					Cron: res.cron,
+					Payload: models.SyncPayload{
+						CWBoards:           slices.Contains(res.targets, models.SyncTargetCWBoards),
+						WebexRecipients:    slices.Contains(res.targets, models.SyncTargetWebexRecipients),
+						CWTickets:          slices.Contains(res.targets, models.SyncTargetCWTickets),
+						CWCompanies:        slices.Contains(res.targets, models.SyncTargetCWCompanies),
+						BoardIDs:           res.boardIDs,
+						MaxConcurrentSyncs: models.DefaultConfig.MaxConcurrentSyncs,
+					},
+					Enabled: true,
+				}
+				sm.status = statusRefresh
+				cmds = append(cmds, sm.submitSchedule(s))
+			}
+		}
+
+	default:
+		var cmd tea.Cmd
+		sm.table, cmd = sm.table.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return sm, tea.Batch(cmds...)
+}
+
+func (sm *schedulesModel) View() string {
+	switch sm.status {
+	case statusInit:
+		return fillSpaceCentered(useSpinner(spn, "Loading schedules..."), sm.parent.width, sm.parent.availHeight)
+	case statusRefresh:
+		return fillSpaceCentered(useSpinner(spn, "Refreshing..."), sm.parent.width, sm.parent.availHeight)
+	case statusError:
+		return renderErrorView(sm.errorMsg, sm.parent.width, sm.parent.availHeight)
+	case statusLoadingFormData:
+		return fillSpaceCentered(useSpinner(spn, "Loading form data..."), sm.parent.width, sm.parent.availHeight)
+	case statusEntry, statusConfirm:
+		return sm.form.View()
+	}
+
+	return sm.table.View()
+}
+
+func (sm *schedulesModel) Status() subModelStatus {
+	return sm.status
+}
+
+func (sm *schedulesModel) Form() *huh.Form {
+	return sm.form
+}
+
+func (sm *schedulesModel) Table() table.Model {
+	return sm.table
+}
+
+func (sm *schedulesModel) setModuleDimensions() {
+	sm.setTableDimensions()
+}
+
+func (sm *schedulesModel) setTableDimensions() {
+	w := sm.parent.width
+	h := sm.parent.availHeight
+	t := &sm.table
+	enableW := 8
+	nameW := 20
+	cronW := 16
+	lastW := 17
+	nextW := 17
+	remainingW := max(0, w-enableW-nameW-cronW-lastW-nextW)
+	targetsW := remainingW
+	t.SetColumns([]table.Column{
+		{Title: "ENABLED", Width: enableW},
+		{Title: "NAME", Width: nameW},
+		{Title: "CRON", Width: cronW},
+		{Title: "LAST RUN", Width: lastW},
+		{Title: "NEXT RUN", Width: nextW},
+		{Title: "TARGETS", Width: targetsW},
+	})
+
+	t.SetRows(schedulesToRows(sm.schedules))
+	t.SetHeight(h)
+}
+
+func (sm *schedulesModel) prepareForm() tea.Cmd {
+	return func() tea.Msg {
+		boards, err := sm.parent.SDKClient.ListBoards()
+		if err != nil {
+			return errMsg{fmt.Errorf("listing boards: %w", err)}
+		}
+		sortBoards(boards)
+
+		return scheduleFormDataMsg{boards: boards}
+	}
+}
+
+func (sm *schedulesModel) submitSchedule(s *models.SyncSchedule) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := sm.parent.SDKClient.CreateSyncSchedule(s); err != nil {
+			return errMsg{fmt.Errorf("creating sync schedule: %w", err)}
+		}
+
+		return refreshSchedulesMsg{}
+	}
+}
+
+func (sm *schedulesModel) updateSchedule(s *models.SyncSchedule) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := sm.parent.SDKClient.UpdateSyncSchedule(s); err != nil {
+			return errMsg{fmt.Errorf("updating sync schedule: %w", err)}
+		}
+
+		return refreshSchedulesMsg{}
+	}
+}
+
+func (sm *schedulesModel) deleteSchedule(id int) tea.Cmd {
+	return func() tea.Msg {
+		if err := sm.parent.SDKClient.DeleteSyncSchedule(id); err != nil {
+			return errMsg{fmt.Errorf("deleting sync schedule: %w", err)}
+		}
+
+		return refreshSchedulesMsg{}
+	}
+}
+
+func (sm *schedulesModel) getSchedules() tea.Cmd {
+	return func() tea.Msg {
+		s, err := sm.parent.SDKClient.ListSyncSchedules()
+		if err != nil {
+			return errMsg{fmt.Errorf("getting sync schedules: %w", err)}
+		}
+
+		return gotSchedulesMsg{schedules: s}
+	}
+}
+
+func (sm *schedulesModel) setRows() tea.Cmd {
+	cursor := sm.table.Cursor()
+	sm.table.SetRows(schedulesToRows(sm.schedules))
+	sm.table.SetCursor(min(cursor, max(0, len(sm.schedules)-1)))
+	return nil
+}
+
+func schedulesToRows(scheds []models.SyncSchedule) []table.Row {
+	if len(scheds) == 0 {
+		return []table.Row{
+			{
+				"NO", "SCHEDULES", "FOUND",
+			},
+		}
+	}
+
+	var rows []table.Row
+	for _, s := range scheds {
+		lastRun := "NA"
+		if s.LastRunOn != nil {
+			lastRun = s.LastRunOn.Local().Format("2006-01-02 15:04")
+		}
+
+		nextRun := "NA"
+		if s.NextRunOn != nil {
+			nextRun = s.NextRunOn.Local().Format("2006-01-02 15:04")
+		}
+
+		rows = append(rows, []string{
+			boolToIcon(s.Enabled),
+			s.Name,
+			s.Cron,
+			lastRun,
+			nextRun,
+			scheduleTargets(s.Payload),
+		})
+	}
+
+	return rows
+}
+
+func scheduleTargets(p models.SyncPayload) string {
+	var t []string
+	if p.CWBoards {
+		t = append(t, "boards")
+	}
+
+	if p.WebexRecipients {
+		t = append(t, "recipients")
+	}
+
+	if p.CWCompanies {
+		t = append(t, "companies")
+	}
+
+	if p.CWTickets {
+		if len(p.BoardIDs) > 0 {
+			t = append(t, fmt.Sprintf("tickets (%d boards)", len(p.BoardIDs)))
+		} else {
+			t = append(t, "tickets (all boards)")
+		}
+	}
+
+	return strings.Join(t, ", ")
+}
+
+func scheduleEntryForm(boards []models.Board, result *schedulesFormResult, height int) *huh.Form {
+	var boardOpts []huh.Option[int]
+	for _, b := range boards {
+		boardOpts = append(boardOpts, huh.NewOption(b.Name, b.ID))
+	}
+
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Name").
+				Value(&result.name).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("name is required")
+					}
+					return nil
+				}),
+			huh.NewInput().
+				Title("Cron").
+				Description("Server local time, such as 0 2 * * * or @hourly").
+				Value(&result.cron).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("cron expression is required")
+					}
+					return nil
+				}),
+		),
+		huh.NewGroup(
+			huh.NewMultiSelect[models.SyncTarget]().
+				Title("Sync Targets").
+				Options(
+					huh.NewOption("Connectwise Boards", models.SyncTargetCWBoards),
+					huh.NewOption("Webex Recipients", models.SyncTargetWebexRecipients),
+					huh.NewOption("Connectwise Companies & Contacts", models.SyncTargetCWCompanies),
+					huh.NewOption("Connectwise Tickets", models.SyncTargetCWTickets),
+				).
+				Value(&result.targets).
+				Validate(func(t []models.SyncTarget) error {
+					if len(t) == 0 {
+						return fmt.Errorf("at least one target is required")
+					}
+					return nil
+				}),
+		),
+		huh.NewGroup(
+			huh.NewMultiSelect[int]().
+				Title("Ticket Boards").
+				Description("Only used for ticket syncs; select none for all boards").
+				Options(boardOpts...).
+				Value(&result.boardIDs),
+		).WithHideFunc(func() bool {
+			return !slices.Contains(result.targets, models.SyncTargetCWTickets) || len(boardOpts) == 0
+		}),
+	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false)
+}
@@ -0,0 +1,298 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// syncRunsPollInterval is how often the runs list is refreshed while a run is in progress.
+const syncRunsPollInterval = 2 * time.Second
+
+type (
+	syncRunsModel struct {
+		parent *Model
+
+		table          table.Model
+		form           *huh.Form
+		formResult     *syncRunsFormResult
+		status         subModelStatus
+		previousStatus subModelStatus
+		runs           []models.SyncRun
+		errorMsg       error
+	}
+
+	syncRunsFormResult struct {
+		targets []models.SyncTarget
+	}
+
+	refreshSyncRunsMsg struct{}
+	syncRunsTickMsg    struct{}
+	gotSyncRunsMsg     struct{ runs []models.SyncRun }
+)
+
+func newSyncRunsModel(parent *Model, initialRuns []models.SyncRun) *syncRunsModel {
+	sm := &syncRunsModel{
+		parent:     parent,
+		runs:       initialRuns,
+		table:      newTable(),
+		formResult: &syncRunsFormResult{},
+		status:     statusMain,
+	}
+	sm.setModuleDimensions()
+	return sm
+}
+
+func (sm *syncRunsModel) Init() tea.Cmd {
+	return syncRunsTick()
+}
+
+func (sm *syncRunsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "enter" && sm.status == statusError:
+			sm.errorMsg = nil
+			sm.status = sm.previousStatus
+			return sm, nil
+		case key.Matches(msg, allKeys.newItem) && sm.status == statusMain:
+			sm.formResult = &syncRunsFormResult{}
+			sm.form = syncEntryForm(sm.formResult, sm.parent.availHeight)
+			sm.status = statusEntry
+			return sm, sm.form.Init()
+		}
+
+	case resizeModelsMsg:
+		sm.setModuleDimensions()
+		if sm.status == statusInit {
+			sm.status = statusMain
+		}
+
+	case syncRunsTickMsg:
+		// only poll while something is still running; otherwise just keep the tick alive
+		if sm.anyRunning() {
+			return sm, tea.Batch(sm.getSyncRuns(), syncRunsTick())
+		}
+		return sm, syncRunsTick()
+
+	case refreshSyncRunsMsg:
+		return sm, sm.getSyncRuns()
+
+	case gotSyncRunsMsg:
+		sm.runs = msg.runs
+		if sm.status == statusRefresh {
+			sm.status = statusMain
+		}
+		sm.setRows()
+		return sm, nil
+
+	case errMsg:
+		if sm.status == statusRefresh {
+			sm.previousStatus = statusMain
+		} else {
+			sm.previousStatus = sm.status
+		}
+		sm.errorMsg = msg.error
+		sm.status = statusError
+	}
+
+	var cmds []tea.Cmd
+	switch sm.status {
+	case statusEntry:
+		form, cmd := sm.form.Update(msg)
+		if f, ok := form.(*huh.Form); ok {
+			sm.form = f
+		}
+
+		cmds = append(cmds, cmd)
+		switch sm.form.State {
+		case huh.StateAborted:
+			sm.status = statusMain
+		case huh.StateCompleted:
+			sm.status = statusRefresh
+			cmds = append(cmds, sm.startSync(sm.formResult.targets))
+		}
+
+	default:
+		var cmd tea.Cmd
+		sm.table, cmd = sm.table.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return sm, tea.Batch(cmds...)
+}
+
+func (sm *syncRunsModel) View() string {
+	switch sm.status {
+	case statusInit:
+		return fillSpaceCentered(useSpinner(spn, "Loading sync runs..."), sm.parent.width, sm.parent.availHeight)
+	case statusRefresh:
+		return fillSpaceCentered(useSpinner(spn, "Refreshing..."), sm.parent.width, sm.parent.availHeight)
+	case statusError:
+		return renderErrorView(sm.errorMsg, sm.parent.width, sm.parent.availHeight)
+	case statusEntry:
+		return sm.form.View()
+	}
+
+	return sm.table.View()
+}
+
+func (sm *syncRunsModel) Status() subModelStatus {
+	return sm.status
+}
+
+func (sm *syncRunsModel) Form() *huh.Form {
+	return sm.form
+}
+
+func (sm *syncRunsModel) Table() table.Model {
+	return sm.table
+}
+
+func (sm *syncRunsModel) setModuleDimensions() {
+	sm.setTableDimensions()
+}
+
+func (sm *syncRunsModel) setTableDimensions() {
+	w := sm.parent.width
+	h := sm.parent.availHeight
+	t := &sm.table
+	idW := 6
+	statusW := 10
+	startedW := 17
+	tookW := 8
+	errsW := 6
+	remainingW := max(0, w-idW-statusW-startedW-tookW-errsW)
+	targetsW := remainingW
+	t.SetColumns([]table.Column{
+		{Title: "ID", Width: idW},
+		{Title: "STATUS", Width: statusW},
+		{Title: "STARTED", Width: startedW},
+		{Title: "TOOK", Width: tookW},
+		{Title: "ERRORS", Width: errsW},
+		{Title: "PROGRESS", Width: targetsW},
+	})
+
+	t.SetRows(syncRunsToRows(sm.runs))
+	t.SetHeight(h)
+}
+
+func (sm *syncRunsModel) anyRunning() bool {
+	return slices.ContainsFunc(sm.runs, func(r models.SyncRun) bool {
+		return !r.Finished()
+	})
+}
+
+func (sm *syncRunsModel) startSync(targets []models.SyncTarget) tea.Cmd {
+	return func() tea.Msg {
+		p := &models.SyncPayload{
+			CWBoards:           slices.Contains(targets, models.SyncTargetCWBoards),
+			WebexRecipients:    slices.Contains(targets, models.SyncTargetWebexRecipients),
+			CWTickets:          slices.Contains(targets, models.SyncTargetCWTickets),
+			CWCompanies:        slices.Contains(targets, models.SyncTargetCWCompanies),
+			MaxConcurrentSyncs: 5,
+		}
+
+		if _, err := sm.parent.SDKClient.Sync(p); err != nil {
+			return errMsg{fmt.Errorf("starting sync: %w", err)}
+		}
+
+		return refreshSyncRunsMsg{}
+	}
+}
+
+func (sm *syncRunsModel) getSyncRuns() tea.Cmd {
+	return func() tea.Msg {
+		runs, err := sm.parent.SDKClient.ListSyncRuns(0)
+		if err != nil {
+			return errMsg{fmt.Errorf("getting sync runs: %w", err)}
+		}
+
+		return gotSyncRunsMsg{runs: runs}
+	}
+}
+
+func (sm *syncRunsModel) setRows() {
+	sm.table.SetRows(syncRunsToRows(sm.runs))
+}
+
+func syncRunsTick() tea.Cmd {
+	return tea.Tick(syncRunsPollInterval, func(time.Time) tea.Msg {
+		return syncRunsTickMsg{}
+	})
+}
+
+func syncRunsToRows(runs []models.SyncRun) []table.Row {
+	if len(runs) == 0 {
+		return []table.Row{
+			{
+				"NO", "SYNC", "RUNS", "FOUND",
+			},
+		}
+	}
+
+	var rows []table.Row
+	for _, r := range runs {
+		end := time.Now()
+		if r.FinishedOn != nil {
+			end = *r.FinishedOn
+		}
+
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", r.ID),
+			string(r.Status),
+			r.StartedOn.Format("2006-01-02 15:04"),
+			end.Sub(r.StartedOn).Round(time.Second).String(),
+			fmt.Sprintf("%d", len(r.Errors)),
+			syncRunProgress(r),
+		})
+	}
+
+	return rows
+}
+
+func syncRunProgress(r models.SyncRun) string {
+	var targets []models.SyncTarget
+	for t := range r.Results {
+		targets = append(targets, t)
+	}
+	slices.Sort(targets)
+
+	var parts []string
+	for _, t := range targets {
+		res := r.Results[t]
+		parts = append(parts, fmt.Sprintf("%s %d/%d", t, res.Upserted, res.Total))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func syncEntryForm(result *syncRunsFormResult, height int) *huh.Form {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewMultiSelect[models.SyncTarget]().
+				Title("Sync Targets").
+				Description("Ticket sync includes all boards and may take a while").
+				Options(
+					huh.NewOption("Connectwise Boards", models.SyncTargetCWBoards),
+					huh.NewOption("Webex Recipients", models.SyncTargetWebexRecipients),
+					huh.NewOption("Connectwise Companies & Contacts", models.SyncTargetCWCompanies),
+					huh.NewOption("Connectwise Tickets", models.SyncTargetCWTickets),
+				).
+				Value(&result.targets).
+				Validate(func(t []models.SyncTarget) error {
+					if len(t) == 0 {
+						return fmt.Errorf("at least one target is required")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false)
+}
@@ -21,6 +21,7 @@ type (
 		status            subModelStatus
 		previousStatus    subModelStatus
 		users             []models.APIUser
+		userToEdit        *models.APIUser
 		userToDelete      models.APIUser
 		userDeleteConfirm bool
 		errorMsg          error
@@ -28,6 +29,7 @@ type (
 
 	usersFormResult struct {
 		email string
+		role  models.Role
 	}
 
 	refreshUsersMsg   struct{}
@@ -61,10 +63,20 @@ func (um *usersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			um.status = um.previousStatus
 			return um, nil
 		case key.Matches(msg, allKeys.newItem) && um.status == statusMain:
-			um.formResult = &usersFormResult{}
+			um.userToEdit = nil
+			um.formResult = &usersFormResult{role: models.RoleReadOnly}
 			um.form = userEntryForm(um.formResult, um.parent.availHeight)
 			um.status = statusEntry
 			return um, um.form.Init()
+		case key.Matches(msg, allKeys.editItem) && um.status == statusMain:
+			if len(um.users) > 0 {
+				u := um.users[um.table.Cursor()]
+				um.userToEdit = &u
+				um.formResult = &usersFormResult{email: u.EmailAddress, role: u.Role}
+				um.form = userEntryForm(um.formResult, um.parent.availHeight)
+				um.status = statusEntry
+				return um, um.form.Init()
+			}
 		case key.Matches(msg, allKeys.deleteItem) && um.status == statusMain:
 			if len(um.users) > 0 {
 				um.userToDelete = um.users[um.table.Cursor()]
@@ -142,7 +154,12 @@ func (um *usersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case statusEntry:
 				res := um.formResult
 				um.status = statusRefresh
-				cmds = append(cmds, um.submitUser(res.email))
+				if u := um.userToEdit; u != nil {
+					um.userToEdit = nil
+					cmds = append(cmds, um.updateUser(&models.APIUser{ID: u.ID, EmailAddress: res.email, Role: res.role}))
+				} else {
+					cmds = append(cmds, um.submitUser(res.email, res.role))
+				}
 			}
 		}
 
@@ -194,11 +211,13 @@ func (um *usersModel) setTableDimensions() {
 	t := &um.table
 	idW := 6
 	emailW := 40
-	remainingW := w - idW - emailW
+	roleW := 14
+	remainingW := w - idW - emailW - roleW
 	createdW := remainingW
 	t.SetColumns([]table.Column{
 		{Title: "ID", Width: idW},
 		{Title: "EMAIL", Width: emailW},
+		{Title: "ROLE", Width: roleW},
 		{Title: "CREATED", Width: createdW},
 	})
 
@@ -206,9 +225,9 @@ func (um *usersModel) setTableDimensions() {
 	t.SetHeight(h)
 }
 
-func (um *usersModel) submitUser(email string) tea.Cmd {
+func (um *usersModel) submitUser(email string, role models.Role) tea.Cmd {
 	return func() tea.Msg {
-		_, err := um.parent.SDKClient.CreateUser(email)
+		_, err := um.parent.SDKClient.CreateUser(email, role)
 		if err != nil {
 			return errMsg{fmt.Errorf("creating user: %w", err)}
 		}
@@ -217,6 +236,17 @@ func (um *usersModel) submitUser(email string) tea.Cmd {
 	}
 }
 
+func (um *usersModel) updateUser(u *models.APIUser) tea.Cmd {
+	return func() tea.Msg {
+		_, err := um.parent.SDKClient.UpdateUser(u)
+		if err != nil {
+			return errMsg{fmt.Errorf("updating user: %w", err)}
+		}
+
+		return refreshUsersMsg{}
+	}
+}
+
 func (um *usersModel) deleteUser(id int) tea.Cmd {
 	return func() tea.Msg {
 		if err := um.parent.SDKClient.DeleteUser(id); err != nil {
@@ -248,7 +278,7 @@ func usersToRows(users []models.APIUser) []table.Row {
 	if len(users) == 0 {
 		return []table.Row{
 			{
-				"NO", "USERS", "FOUND",
+				"NO", "USERS", "FOUND", "",
 			},
 		}
 	}
@@ -257,6 +287,7 @@ func usersToRows(users []models.APIUser) []table.Row {
 		rows = append(rows, []string{
 			fmt.Sprintf("%d", u.ID),
 			u.EmailAddress,
+			string(u.Role),
 			u.CreatedOn.Format("2006-01-02 15:04"),
 		})
 	}
@@ -277,5 +308,20 @@ func userEntryForm(result *usersFormResult, height int) *huh.Form {
 					return nil
 				}),
 		),
+		huh.NewGroup(
+			huh.NewSelect[models.Role]().
+				Title("Role").
+				Options(rolesToFormOpts()...).
+				Value(&result.role),
+		),
 	).WithTheme(huh.ThemeBase16()).WithHeight(height + 1).WithShowHelp(false)
 }
+
+func rolesToFormOpts() []huh.Option[models.Role] {
+	var opts []huh.Option[models.Role]
+	for _, r := range models.Roles {
+		opts = append(opts, huh.NewOption(string(r), r))
+	}
+
+	return opts
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sync_run (
+    id SERIAL PRIMARY KEY,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'running',
+    results JSONB NOT NULL DEFAULT '{}',
+    errors TEXT[] NOT NULL DEFAULT '{}',
+    started_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_on TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sync_run;
+-- +goose StatementEnd
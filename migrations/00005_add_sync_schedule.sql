@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sync_schedule (
+    id SERIAL PRIMARY KEY,
+    name TEXT UNIQUE NOT NULL,
+    cron TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    last_run_on TIMESTAMP,
+    created_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sync_schedule;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE app_config
+    ADD COLUMN IF NOT EXISTS reconcile_interval_minutes INT NOT NULL DEFAULT 10,
+    ADD COLUMN IF NOT EXISTS reconcile_lookback_minutes INT NOT NULL DEFAULT 60;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE app_config
+    DROP COLUMN IF EXISTS reconcile_interval_minutes,
+    DROP COLUMN IF EXISTS reconcile_lookback_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS cw_ticket_summary_search_idx
+    ON cw_ticket USING GIN (to_tsvector('english', summary));
+
+CREATE INDEX IF NOT EXISTS cw_ticket_note_content_search_idx
+    ON cw_ticket_note USING GIN (to_tsvector('english', COALESCE(content, '')));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS cw_ticket_summary_search_idx;
+DROP INDEX IF EXISTS cw_ticket_note_content_search_idx;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cw_board_item (
+    id INT PRIMARY KEY,
+    board_id INT NOT NULL REFERENCES cw_board(id),
+    name TEXT NOT NULL,
+    inactive BOOLEAN NOT NULL,
+    added_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE IF NOT EXISTS cw_board_subtype (
+    id INT PRIMARY KEY,
+    board_id INT NOT NULL REFERENCES cw_board(id),
+    name TEXT NOT NULL,
+    inactive BOOLEAN NOT NULL,
+    added_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE IF NOT EXISTS cw_board_team (
+    id INT PRIMARY KEY,
+    board_id INT NOT NULL REFERENCES cw_board(id),
+    name TEXT NOT NULL,
+    leader_id INT,
+    default_team BOOLEAN NOT NULL,
+    added_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE IF NOT EXISTS cw_board_type (
+    id INT PRIMARY KEY,
+    board_id INT NOT NULL REFERENCES cw_board(id),
+    name TEXT NOT NULL,
+    default_type BOOLEAN NOT NULL,
+    inactive BOOLEAN NOT NULL,
+    added_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE IF NOT EXISTS cw_priority (
+    id INT PRIMARY KEY,
+    name TEXT NOT NULL,
+    color TEXT,
+    sort_order INT,
+    default_priority BOOLEAN NOT NULL,
+    added_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted BOOLEAN NOT NULL DEFAULT false
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cw_priority;
+DROP TABLE IF EXISTS cw_board_type;
+DROP TABLE IF EXISTS cw_board_team;
+DROP TABLE IF EXISTS cw_board_subtype;
+DROP TABLE IF EXISTS cw_board_item;
+-- +goose StatementEnd
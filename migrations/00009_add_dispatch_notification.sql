@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dispatch_notification (
+    id SERIAL PRIMARY KEY,
+    schedule_entry_id INT NOT NULL,
+    ticket_id INT NOT NULL REFERENCES cw_ticket(id) ON DELETE CASCADE,
+    member_id INT NOT NULL,
+    date_start TIMESTAMP NOT NULL,
+    date_end TIMESTAMP,
+    recipient_id INT REFERENCES webex_recipient(id) ON DELETE CASCADE,
+    forwarded_from_id INT REFERENCES webex_recipient(id) ON DELETE CASCADE,
+    sent BOOLEAN NOT NULL DEFAULT FALSE,
+    created_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS dispatch_notification_entry_idx
+    ON dispatch_notification (schedule_entry_id, member_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dispatch_notification;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE api_user ADD COLUMN role TEXT NOT NULL DEFAULT 'read_only'
+    CHECK (role IN ('admin', 'operator', 'self_service', 'read_only'));
+
+-- every user was effectively an admin before roles existed
+UPDATE api_user SET role = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_user DROP COLUMN role;
+-- +goose StatementEnd
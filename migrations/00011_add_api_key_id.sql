@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE api_key ADD COLUMN key_id TEXT UNIQUE;
+ALTER TABLE api_key ADD COLUMN legacy_until TIMESTAMP;
+
+-- keys from before key IDs keep working for 30 days so they can be replaced
+UPDATE api_key SET legacy_until = NOW() + INTERVAL '30 days' WHERE key_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_key DROP COLUMN legacy_until;
+ALTER TABLE api_key DROP COLUMN key_id;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS device_login (
+    id SERIAL PRIMARY KEY,
+    device_code_hash BYTEA UNIQUE NOT NULL,
+    user_code TEXT UNIQUE NOT NULL,
+    state TEXT UNIQUE NOT NULL,
+    user_id INT REFERENCES api_user(id) ON DELETE CASCADE,
+    denied_reason TEXT,
+    expires_on TIMESTAMP NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS login_session (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES api_user(id) ON DELETE CASCADE,
+    token_hash BYTEA UNIQUE NOT NULL,
+    refresh_hash BYTEA UNIQUE NOT NULL,
+    expires_on TIMESTAMP NOT NULL,
+    refresh_expires_on TIMESTAMP NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS login_session;
+DROP TABLE IF EXISTS device_login;
+-- +goose StatementEnd
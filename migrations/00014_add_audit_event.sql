@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS audit_event (
+    id SERIAL PRIMARY KEY,
+    user_id INT REFERENCES api_user(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id INT,
+    before JSONB,
+    after JSONB,
+    created_on TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_entity_idx ON audit_event(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS audit_event_user_id_idx ON audit_event(user_id);
+CREATE INDEX IF NOT EXISTS audit_event_created_on_idx ON audit_event(created_on);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_event;
+-- +goose StatementEnd
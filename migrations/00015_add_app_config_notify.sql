@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_app_config_changed() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('app_config_changed', NEW.id::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER app_config_changed
+    AFTER INSERT OR UPDATE ON app_config
+    FOR EACH ROW EXECUTE FUNCTION notify_app_config_changed();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS app_config_changed ON app_config;
+DROP FUNCTION IF EXISTS notify_app_config_changed();
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dispatch_notification
+    ADD COLUMN IF NOT EXISTS claimed_on TIMESTAMP;
+
+-- keep one row per recipient, preferring one that was sent
+DELETE FROM dispatch_notification d
+USING (
+    SELECT id, ROW_NUMBER() OVER (
+        PARTITION BY schedule_entry_id, member_id, date_start, date_end, recipient_id
+        ORDER BY sent DESC, id
+    ) AS rn
+    FROM dispatch_notification
+) dup
+WHERE d.id = dup.id AND dup.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS dispatch_notification_recipient_key
+    ON dispatch_notification (schedule_entry_id, member_id, date_start, date_end, recipient_id)
+    NULLS NOT DISTINCT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS dispatch_notification_recipient_key;
+
+ALTER TABLE dispatch_notification
+    DROP COLUMN IF EXISTS claimed_on;
+-- +goose StatementEnd
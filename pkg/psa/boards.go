@@ -1,6 +1,7 @@
 package psa
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,50 +17,322 @@ func boardIdStatusIdEndpoint(boardId, statusId int) string {
 	return fmt.Sprintf("%s/%d", boardIdStatusEndpoint(boardId), statusId)
 }
 
+func boardIdTypeEndpoint(boardId int) string {
+	return fmt.Sprintf("%s/types", boardIdEndpoint(boardId))
+}
+
+func boardIdTypeIdEndpoint(boardId, typeId int) string {
+	return fmt.Sprintf("%s/%d", boardIdTypeEndpoint(boardId), typeId)
+}
+
+func boardIdSubtypeEndpoint(boardId int) string {
+	return fmt.Sprintf("%s/subtypes", boardIdEndpoint(boardId))
+}
+
+func boardIdSubtypeIdEndpoint(boardId, subtypeId int) string {
+	return fmt.Sprintf("%s/%d", boardIdSubtypeEndpoint(boardId), subtypeId)
+}
+
+func boardIdItemEndpoint(boardId int) string {
+	return fmt.Sprintf("%s/items", boardIdEndpoint(boardId))
+}
+
+func boardIdItemIdEndpoint(boardId, itemId int) string {
+	return fmt.Sprintf("%s/%d", boardIdItemEndpoint(boardId), itemId)
+}
+
+func boardIdTeamEndpoint(boardId int) string {
+	return fmt.Sprintf("%s/teams", boardIdEndpoint(boardId))
+}
+
+func boardIdTeamIdEndpoint(boardId, teamId int) string {
+	return fmt.Sprintf("%s/%d", boardIdTeamEndpoint(boardId), teamId)
+}
+
 func (c *Client) PostBoard(board *Board) (*Board, error) {
-	return Post[Board](c, "service/boards", board)
+	return c.PostBoardCtx(context.Background(), board)
+}
+
+func (c *Client) PostBoardCtx(ctx context.Context, board *Board) (*Board, error) {
+	return PostCtx[Board](ctx, c, "service/boards", board)
 }
 
 func (c *Client) ListBoards(params map[string]string) ([]Board, error) {
-	return GetMany[Board](c, "service/boards", params)
+	return c.ListBoardsCtx(context.Background(), params)
+}
+
+func (c *Client) ListBoardsCtx(ctx context.Context, params map[string]string) ([]Board, error) {
+	return GetManyCtx[Board](ctx, c, "service/boards", params)
 }
 
 func (c *Client) GetBoard(boardID int, params map[string]string) (*Board, error) {
-	return GetOne[Board](c, boardIdEndpoint(boardID), params)
+	return c.GetBoardCtx(context.Background(), boardID, params)
+}
+
+func (c *Client) GetBoardCtx(ctx context.Context, boardID int, params map[string]string) (*Board, error) {
+	return GetOneCtx[Board](ctx, c, boardIdEndpoint(boardID), params)
 }
 
 func (c *Client) PutBoard(boardID int, board *Board) (*Board, error) {
-	return Put[Board](c, boardIdEndpoint(boardID), board)
+	return c.PutBoardCtx(context.Background(), boardID, board)
+}
+
+func (c *Client) PutBoardCtx(ctx context.Context, boardID int, board *Board) (*Board, error) {
+	return PutCtx[Board](ctx, c, boardIdEndpoint(boardID), board)
 }
 
 func (c *Client) PatchBoard(boardID int, patchOps []PatchOp) (*Board, error) {
-	return Patch[Board](c, boardIdEndpoint(boardID), patchOps)
+	return c.PatchBoardCtx(context.Background(), boardID, patchOps)
+}
+
+func (c *Client) PatchBoardCtx(ctx context.Context, boardID int, patchOps []PatchOp) (*Board, error) {
+	return PatchCtx[Board](ctx, c, boardIdEndpoint(boardID), patchOps)
 }
 
 func (c *Client) DeleteBoard(boardID int) error {
-	return Delete(c, boardIdEndpoint(boardID))
+	return c.DeleteBoardCtx(context.Background(), boardID)
+}
+
+func (c *Client) DeleteBoardCtx(ctx context.Context, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdEndpoint(boardID))
 }
 
 func (c *Client) PostBoardStatus(boardStatus *BoardStatus, boardID int) (*BoardStatus, error) {
-	return Post[BoardStatus](c, boardIdStatusEndpoint(boardID), boardStatus)
+	return c.PostBoardStatusCtx(context.Background(), boardStatus, boardID)
+}
+
+func (c *Client) PostBoardStatusCtx(ctx context.Context, boardStatus *BoardStatus, boardID int) (*BoardStatus, error) {
+	return PostCtx[BoardStatus](ctx, c, boardIdStatusEndpoint(boardID), boardStatus)
 }
 
 func (c *Client) ListBoardStatuss(params map[string]string, boardID int) ([]BoardStatus, error) {
-	return GetMany[BoardStatus](c, boardIdStatusEndpoint(boardID), params)
+	return c.ListBoardStatussCtx(context.Background(), params, boardID)
+}
+
+func (c *Client) ListBoardStatussCtx(ctx context.Context, params map[string]string, boardID int) ([]BoardStatus, error) {
+	return GetManyCtx[BoardStatus](ctx, c, boardIdStatusEndpoint(boardID), params)
 }
 
 func (c *Client) GetBoardStatus(statusID int, params map[string]string, boardID int) (*BoardStatus, error) {
-	return GetOne[BoardStatus](c, boardIdStatusIdEndpoint(boardID, statusID), params)
+	return c.GetBoardStatusCtx(context.Background(), statusID, params, boardID)
+}
+
+func (c *Client) GetBoardStatusCtx(ctx context.Context, statusID int, params map[string]string, boardID int) (*BoardStatus, error) {
+	return GetOneCtx[BoardStatus](ctx, c, boardIdStatusIdEndpoint(boardID, statusID), params)
 }
 
 func (c *Client) PutBoardStatus(statusID int, boardStatus *BoardStatus, boardID int) (*BoardStatus, error) {
-	return Put[BoardStatus](c, boardIdStatusIdEndpoint(boardID, statusID), boardStatus)
+	return c.PutBoardStatusCtx(context.Background(), statusID, boardStatus, boardID)
+}
+
+func (c *Client) PutBoardStatusCtx(ctx context.Context, statusID int, boardStatus *BoardStatus, boardID int) (*BoardStatus, error) {
+	return PutCtx[BoardStatus](ctx, c, boardIdStatusIdEndpoint(boardID, statusID), boardStatus)
 }
 
 func (c *Client) PatchBoardStatus(statusID int, patchOps []PatchOp, boardID int) (*BoardStatus, error) {
-	return Patch[BoardStatus](c, boardIdStatusIdEndpoint(boardID, statusID), patchOps)
+	return c.PatchBoardStatusCtx(context.Background(), statusID, patchOps, boardID)
+}
+
+func (c *Client) PatchBoardStatusCtx(ctx context.Context, statusID int, patchOps []PatchOp, boardID int) (*BoardStatus, error) {
+	return PatchCtx[BoardStatus](ctx, c, boardIdStatusIdEndpoint(boardID, statusID), patchOps)
 }
 
 func (c *Client) DeleteBoardStatus(statusID int, boardID int) error {
-	return Delete(c, boardIdStatusIdEndpoint(boardID, statusID))
+	return c.DeleteBoardStatusCtx(context.Background(), statusID, boardID)
+}
+
+func (c *Client) DeleteBoardStatusCtx(ctx context.Context, statusID int, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdStatusIdEndpoint(boardID, statusID))
+}
+
+func (c *Client) PostBoardType(boardType *BoardType, boardID int) (*BoardType, error) {
+	return c.PostBoardTypeCtx(context.Background(), boardType, boardID)
+}
+
+func (c *Client) PostBoardTypeCtx(ctx context.Context, boardType *BoardType, boardID int) (*BoardType, error) {
+	return PostCtx[BoardType](ctx, c, boardIdTypeEndpoint(boardID), boardType)
+}
+
+func (c *Client) ListBoardTypes(params map[string]string, boardID int) ([]BoardType, error) {
+	return c.ListBoardTypesCtx(context.Background(), params, boardID)
+}
+
+func (c *Client) ListBoardTypesCtx(ctx context.Context, params map[string]string, boardID int) ([]BoardType, error) {
+	return GetManyCtx[BoardType](ctx, c, boardIdTypeEndpoint(boardID), params)
+}
+
+func (c *Client) GetBoardType(typeID int, params map[string]string, boardID int) (*BoardType, error) {
+	return c.GetBoardTypeCtx(context.Background(), typeID, params, boardID)
+}
+
+func (c *Client) GetBoardTypeCtx(ctx context.Context, typeID int, params map[string]string, boardID int) (*BoardType, error) {
+	return GetOneCtx[BoardType](ctx, c, boardIdTypeIdEndpoint(boardID, typeID), params)
+}
+
+func (c *Client) PutBoardType(typeID int, boardType *BoardType, boardID int) (*BoardType, error) {
+	return c.PutBoardTypeCtx(context.Background(), typeID, boardType, boardID)
+}
+
+func (c *Client) PutBoardTypeCtx(ctx context.Context, typeID int, boardType *BoardType, boardID int) (*BoardType, error) {
+	return PutCtx[BoardType](ctx, c, boardIdTypeIdEndpoint(boardID, typeID), boardType)
+}
+
+func (c *Client) PatchBoardType(typeID int, patchOps []PatchOp, boardID int) (*BoardType, error) {
+	return c.PatchBoardTypeCtx(context.Background(), typeID, patchOps, boardID)
+}
+
+func (c *Client) PatchBoardTypeCtx(ctx context.Context, typeID int, patchOps []PatchOp, boardID int) (*BoardType, error) {
+	return PatchCtx[BoardType](ctx, c, boardIdTypeIdEndpoint(boardID, typeID), patchOps)
+}
+
+func (c *Client) DeleteBoardType(typeID int, boardID int) error {
+	return c.DeleteBoardTypeCtx(context.Background(), typeID, boardID)
+}
+
+func (c *Client) DeleteBoardTypeCtx(ctx context.Context, typeID int, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdTypeIdEndpoint(boardID, typeID))
+}
+
+func (c *Client) PostBoardSubtype(boardSubtype *BoardSubtype, boardID int) (*BoardSubtype, error) {
+	return c.PostBoardSubtypeCtx(context.Background(), boardSubtype, boardID)
+}
+
+func (c *Client) PostBoardSubtypeCtx(ctx context.Context, boardSubtype *BoardSubtype, boardID int) (*BoardSubtype, error) {
+	return PostCtx[BoardSubtype](ctx, c, boardIdSubtypeEndpoint(boardID), boardSubtype)
+}
+
+func (c *Client) ListBoardSubtypes(params map[string]string, boardID int) ([]BoardSubtype, error) {
+	return c.ListBoardSubtypesCtx(context.Background(), params, boardID)
+}
+
+func (c *Client) ListBoardSubtypesCtx(ctx context.Context, params map[string]string, boardID int) ([]BoardSubtype, error) {
+	return GetManyCtx[BoardSubtype](ctx, c, boardIdSubtypeEndpoint(boardID), params)
+}
+
+func (c *Client) GetBoardSubtype(subtypeID int, params map[string]string, boardID int) (*BoardSubtype, error) {
+	return c.GetBoardSubtypeCtx(context.Background(), subtypeID, params, boardID)
+}
+
+func (c *Client) GetBoardSubtypeCtx(ctx context.Context, subtypeID int, params map[string]string, boardID int) (*BoardSubtype, error) {
+	return GetOneCtx[BoardSubtype](ctx, c, boardIdSubtypeIdEndpoint(boardID, subtypeID), params)
+}
+
+func (c *Client) PutBoardSubtype(subtypeID int, boardSubtype *BoardSubtype, boardID int) (*BoardSubtype, error) {
+	return c.PutBoardSubtypeCtx(context.Background(), subtypeID, boardSubtype, boardID)
+}
+
+func (c *Client) PutBoardSubtypeCtx(ctx context.Context, subtypeID int, boardSubtype *BoardSubtype, boardID int) (*BoardSubtype, error) {
+	return PutCtx[BoardSubtype](ctx, c, boardIdSubtypeIdEndpoint(boardID, subtypeID), boardSubtype)
+}
+
+func (c *Client) PatchBoardSubtype(subtypeID int, patchOps []PatchOp, boardID int) (*BoardSubtype, error) {
+	return c.PatchBoardSubtypeCtx(context.Background(), subtypeID, patchOps, boardID)
+}
+
+func (c *Client) PatchBoardSubtypeCtx(ctx context.Context, subtypeID int, patchOps []PatchOp, boardID int) (*BoardSubtype, error) {
+	return PatchCtx[BoardSubtype](ctx, c, boardIdSubtypeIdEndpoint(boardID, subtypeID), patchOps)
+}
+
+func (c *Client) DeleteBoardSubtype(subtypeID int, boardID int) error {
+	return c.DeleteBoardSubtypeCtx(context.Background(), subtypeID, boardID)
+}
+
+func (c *Client) DeleteBoardSubtypeCtx(ctx context.Context, subtypeID int, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdSubtypeIdEndpoint(boardID, subtypeID))
+}
+
+func (c *Client) PostBoardItem(boardItem *BoardItem, boardID int) (*BoardItem, error) {
+	return c.PostBoardItemCtx(context.Background(), boardItem, boardID)
+}
+
+func (c *Client) PostBoardItemCtx(ctx context.Context, boardItem *BoardItem, boardID int) (*BoardItem, error) {
+	return PostCtx[BoardItem](ctx, c, boardIdItemEndpoint(boardID), boardItem)
+}
+
+func (c *Client) ListBoardItems(params map[string]string, boardID int) ([]BoardItem, error) {
+	return c.ListBoardItemsCtx(context.Background(), params, boardID)
+}
+
+func (c *Client) ListBoardItemsCtx(ctx context.Context, params map[string]string, boardID int) ([]BoardItem, error) {
+	return GetManyCtx[BoardItem](ctx, c, boardIdItemEndpoint(boardID), params)
+}
+
+func (c *Client) GetBoardItem(itemID int, params map[string]string, boardID int) (*BoardItem, error) {
+	return c.GetBoardItemCtx(context.Background(), itemID, params, boardID)
+}
+
+func (c *Client) GetBoardItemCtx(ctx context.Context, itemID int, params map[string]string, boardID int) (*BoardItem, error) {
+	return GetOneCtx[BoardItem](ctx, c, boardIdItemIdEndpoint(boardID, itemID), params)
+}
+
+func (c *Client) PutBoardItem(itemID int, boardItem *BoardItem, boardID int) (*BoardItem, error) {
+	return c.PutBoardItemCtx(context.Background(), itemID, boardItem, boardID)
+}
+
+func (c *Client) PutBoardItemCtx(ctx context.Context, itemID int, boardItem *BoardItem, boardID int) (*BoardItem, error) {
+	return PutCtx[BoardItem](ctx, c, boardIdItemIdEndpoint(boardID, itemID), boardItem)
+}
+
+func (c *Client) PatchBoardItem(itemID int, patchOps []PatchOp, boardID int) (*BoardItem, error) {
+	return c.PatchBoardItemCtx(context.Background(), itemID, patchOps, boardID)
+}
+
+func (c *Client) PatchBoardItemCtx(ctx context.Context, itemID int, patchOps []PatchOp, boardID int) (*BoardItem, error) {
+	return PatchCtx[BoardItem](ctx, c, boardIdItemIdEndpoint(boardID, itemID), patchOps)
+}
+
+func (c *Client) DeleteBoardItem(itemID int, boardID int) error {
+	return c.DeleteBoardItemCtx(context.Background(), itemID, boardID)
+}
+
+func (c *Client) DeleteBoardItemCtx(ctx context.Context, itemID int, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdItemIdEndpoint(boardID, itemID))
+}
+
+func (c *Client) PostBoardTeam(boardTeam *BoardTeam, boardID int) (*BoardTeam, error) {
+	return c.PostBoardTeamCtx(context.Background(), boardTeam, boardID)
+}
+
+func (c *Client) PostBoardTeamCtx(ctx context.Context, boardTeam *BoardTeam, boardID int) (*BoardTeam, error) {
+	return PostCtx[BoardTeam](ctx, c, boardIdTeamEndpoint(boardID), boardTeam)
+}
+
+func (c *Client) ListBoardTeams(params map[string]string, boardID int) ([]BoardTeam, error) {
+	return c.ListBoardTeamsCtx(context.Background(), params, boardID)
+}
+
+func (c *Client) ListBoardTeamsCtx(ctx context.Context, params map[string]string, boardID int) ([]BoardTeam, error) {
+	return GetManyCtx[BoardTeam](ctx, c, boardIdTeamEndpoint(boardID), params)
+}
+
+func (c *Client) GetBoardTeam(teamID int, params map[string]string, boardID int) (*BoardTeam, error) {
+	return c.GetBoardTeamCtx(context.Background(), teamID, params, boardID)
+}
+
+func (c *Client) GetBoardTeamCtx(ctx context.Context, teamID int, params map[string]string, boardID int) (*BoardTeam, error) {
+	return GetOneCtx[BoardTeam](ctx, c, boardIdTeamIdEndpoint(boardID, teamID), params)
+}
+
+func (c *Client) PutBoardTeam(teamID int, boardTeam *BoardTeam, boardID int) (*BoardTeam, error) {
+	return c.PutBoardTeamCtx(context.Background(), teamID, boardTeam, boardID)
+}
+
+func (c *Client) PutBoardTeamCtx(ctx context.Context, teamID int, boardTeam *BoardTeam, boardID int) (*BoardTeam, error) {
+	return PutCtx[BoardTeam](ctx, c, boardIdTeamIdEndpoint(boardID, teamID), boardTeam)
+}
+
+func (c *Client) PatchBoardTeam(teamID int, patchOps []PatchOp, boardID int) (*BoardTeam, error) {
+	return c.PatchBoardTeamCtx(context.Background(), teamID, patchOps, boardID)
+}
+
+func (c *Client) PatchBoardTeamCtx(ctx context.Context, teamID int, patchOps []PatchOp, boardID int) (*BoardTeam, error) {
+	return PatchCtx[BoardTeam](ctx, c, boardIdTeamIdEndpoint(boardID, teamID), patchOps)
+}
+
+func (c *Client) DeleteBoardTeam(teamID int, boardID int) error {
+	return c.DeleteBoardTeamCtx(context.Background(), teamID, boardID)
+}
+
+func (c *Client) DeleteBoardTeamCtx(ctx context.Context, teamID int, boardID int) error {
+	return DeleteCtx(ctx, c, boardIdTeamIdEndpoint(boardID, teamID))
 }
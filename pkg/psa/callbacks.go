@@ -1,6 +1,7 @@
 package psa
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -15,27 +16,51 @@ func callbackIdEndpoint(callbackID int) string {
 }
 
 func (c *Client) PostCallback(webhook *Callback) (*Callback, error) {
-	return Post[Callback](c, "system/callbacks", webhook)
+	return c.PostCallbackCtx(context.Background(), webhook)
+}
+
+func (c *Client) PostCallbackCtx(ctx context.Context, webhook *Callback) (*Callback, error) {
+	return PostCtx[Callback](ctx, c, "system/callbacks", webhook)
 }
 
 func (c *Client) ListCallbacks(params map[string]string) ([]Callback, error) {
-	return GetMany[Callback](c, "system/callbacks", params)
+	return c.ListCallbacksCtx(context.Background(), params)
+}
+
+func (c *Client) ListCallbacksCtx(ctx context.Context, params map[string]string) ([]Callback, error) {
+	return GetManyCtx[Callback](ctx, c, "system/callbacks", params)
 }
 
 func (c *Client) GetCallback(callbackID int, params map[string]string) (*Callback, error) {
-	return GetOne[Callback](c, callbackIdEndpoint(callbackID), params)
+	return c.GetCallbackCtx(context.Background(), callbackID, params)
+}
+
+func (c *Client) GetCallbackCtx(ctx context.Context, callbackID int, params map[string]string) (*Callback, error) {
+	return GetOneCtx[Callback](ctx, c, callbackIdEndpoint(callbackID), params)
 }
 
 func (c *Client) PutCallback(callbackID int, webhook *Callback) (*Callback, error) {
-	return Put[Callback](c, callbackIdEndpoint(callbackID), webhook)
+	return c.PutCallbackCtx(context.Background(), callbackID, webhook)
+}
+
+func (c *Client) PutCallbackCtx(ctx context.Context, callbackID int, webhook *Callback) (*Callback, error) {
+	return PutCtx[Callback](ctx, c, callbackIdEndpoint(callbackID), webhook)
 }
 
 func (c *Client) PatchCallback(callbackID int, patchOps []PatchOp) (*Callback, error) {
-	return Patch[Callback](c, callbackIdEndpoint(callbackID), patchOps)
+	return c.PatchCallbackCtx(context.Background(), callbackID, patchOps)
+}
+
+func (c *Client) PatchCallbackCtx(ctx context.Context, callbackID int, patchOps []PatchOp) (*Callback, error) {
+	return PatchCtx[Callback](ctx, c, callbackIdEndpoint(callbackID), patchOps)
 }
 
 func (c *Client) DeleteCallback(callbackID int) error {
-	return Delete(c, callbackIdEndpoint(callbackID))
+	return c.DeleteCallbackCtx(context.Background(), callbackID)
+}
+
+func (c *Client) DeleteCallbackCtx(ctx context.Context, callbackID int) error {
+	return DeleteCtx(ctx, c, callbackIdEndpoint(callbackID))
 }
 
 func ValidateWebhook(r *http.Request) (bool, error) {
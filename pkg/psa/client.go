@@ -2,6 +2,8 @@ package psa
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"resty.dev/v3"
 )
@@ -11,20 +13,92 @@ type Creds struct {
 	PrivateKey string `json:"private_key,omitempty" mapstructure:"private_key"`
 	ClientId   string `json:"client_id,omitempty" mapstructure:"client_id"`
 	CompanyId  string `json:"company_id,omitempty" mapstructure:"company_id"` // The company name you enter when you log in to the PSA
+
+	// Site is the API host, e.g. api-eu.myconnectwise.net, or the URL of an on-prem install.
+	// Defaults to DefaultSite.
+	Site string `json:"site,omitempty" mapstructure:"site"`
+
+	// Codebase is the release path of the site, e.g. v2025_1. Defaults to DefaultCodebase. Use
+	// Client.DiscoverCodebase to look it up instead.
+	Codebase string `json:"codebase,omitempty" mapstructure:"codebase"`
+
+	// APIVersion pins responses to a ConnectWise API version, e.g. 2025.1, via the Accept header.
+	// If empty, the site's current version is used.
+	APIVersion string `json:"api_version,omitempty" mapstructure:"api_version"`
 }
 
 type Client struct {
-	restClient *resty.Client
-	creds      *Creds
+	restClient  *resty.Client
+	creds       *Creds
+	siteURL     string
+	uiURL       string
+	codebase    string
+	limiter     *tokenBucket
+	stats       clientStats
+	maxRetries  int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+type Option func(*Client)
+
+// WithRateLimit sets how many requests per second the client may make, and how many it may
+// make at once after being idle.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newTokenBucket(perSecond, burst)
+	}
+}
+
+// WithRetries sets how many times a request is retried after a 429, or after a 5xx or transport
+// error for idempotent methods, and the bounds of the exponential backoff between attempts.
+func WithRetries(maxRetries int, baseBackoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.baseBackoff = baseBackoff
+		c.maxBackoff = maxBackoff
+	}
 }
 
-func NewClient(creds *Creds) *Client {
+func NewClient(creds *Creds, opts ...Option) *Client {
 	c := resty.New()
 	c.SetBasicAuth(fmt.Sprintf("%s+%s", creds.CompanyId, creds.PublicKey), creds.PrivateKey)
 	c.SetHeader("Content-Type", "application/json")
-	c.SetHeader("Accept", "application/json")
+	c.SetHeader("Accept", acceptHeader(creds.APIVersion))
 	c.SetHeader("clientId", creds.ClientId)
-	c.SetRetryCount(3)
 
-	return &Client{restClient: c, creds: creds}
+	// retries are handled by the client so they share the rate limiter
+	c.SetRetryCount(0)
+
+	cb := strings.Trim(creds.Codebase, "/")
+	if cb == "" {
+		cb = DefaultCodebase
+	}
+
+	api, ui := siteURLs(creds.Site)
+	cl := &Client{
+		restClient:  c,
+		creds:       creds,
+		siteURL:     api,
+		uiURL:       ui,
+		codebase:    cb,
+		limiter:     newTokenBucket(defaultRatePerSecond, defaultBurst),
+		maxRetries:  defaultMaxRetries,
+		baseBackoff: defaultBaseBackoff,
+		maxBackoff:  defaultMaxBackoff,
+	}
+
+	for _, o := range opts {
+		o(cl)
+	}
+
+	return cl
+}
+
+func acceptHeader(apiVersion string) string {
+	if apiVersion == "" {
+		return "application/json"
+	}
+
+	return fmt.Sprintf("application/vnd.connectwise.com+json; version=%s", apiVersion)
 }
@@ -1,6 +1,7 @@
 package psa
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -9,25 +10,49 @@ func companyIdEndpoint(companyId int) string {
 }
 
 func (c *Client) PostCompany(company *Company) (*Company, error) {
-	return Post[Company](c, "company/companies", company)
+	return c.PostCompanyCtx(context.Background(), company)
+}
+
+func (c *Client) PostCompanyCtx(ctx context.Context, company *Company) (*Company, error) {
+	return PostCtx[Company](ctx, c, "company/companies", company)
 }
 
 func (c *Client) ListCompanies(params map[string]string) ([]Company, error) {
-	return GetMany[Company](c, "company/companies", params)
+	return c.ListCompaniesCtx(context.Background(), params)
+}
+
+func (c *Client) ListCompaniesCtx(ctx context.Context, params map[string]string) ([]Company, error) {
+	return GetManyCtx[Company](ctx, c, "company/companies", params)
 }
 
 func (c *Client) GetCompany(companyID int, params map[string]string) (*Company, error) {
-	return GetOne[Company](c, companyIdEndpoint(companyID), params)
+	return c.GetCompanyCtx(context.Background(), companyID, params)
+}
+
+func (c *Client) GetCompanyCtx(ctx context.Context, companyID int, params map[string]string) (*Company, error) {
+	return GetOneCtx[Company](ctx, c, companyIdEndpoint(companyID), params)
 }
 
 func (c *Client) PutCompany(companyID int, company *Company) (*Company, error) {
-	return Put[Company](c, companyIdEndpoint(companyID), company)
+	return c.PutCompanyCtx(context.Background(), companyID, company)
+}
+
+func (c *Client) PutCompanyCtx(ctx context.Context, companyID int, company *Company) (*Company, error) {
+	return PutCtx[Company](ctx, c, companyIdEndpoint(companyID), company)
 }
 
 func (c *Client) PatchCompany(companyID int, patchOps []PatchOp) (*Company, error) {
-	return Patch[Company](c, companyIdEndpoint(companyID), patchOps)
+	return c.PatchCompanyCtx(context.Background(), companyID, patchOps)
+}
+
+func (c *Client) PatchCompanyCtx(ctx context.Context, companyID int, patchOps []PatchOp) (*Company, error) {
+	return PatchCtx[Company](ctx, c, companyIdEndpoint(companyID), patchOps)
 }
 
 func (c *Client) DeleteCompany(companyID int) error {
-	return Delete(c, companyIdEndpoint(companyID))
+	return c.DeleteCompanyCtx(context.Background(), companyID)
+}
+
+func (c *Client) DeleteCompanyCtx(ctx context.Context, companyID int) error {
+	return DeleteCtx(ctx, c, companyIdEndpoint(companyID))
 }
@@ -1,6 +1,7 @@
 package psa
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -9,25 +10,49 @@ func contactIdEndpoint(contactId int) string {
 }
 
 func (c *Client) PostContact(contact *Contact) (*Contact, error) {
-	return Post[Contact](c, "company/contacts", contact)
+	return c.PostContactCtx(context.Background(), contact)
+}
+
+func (c *Client) PostContactCtx(ctx context.Context, contact *Contact) (*Contact, error) {
+	return PostCtx[Contact](ctx, c, "company/contacts", contact)
 }
 
 func (c *Client) ListContacts(params map[string]string) ([]Contact, error) {
-	return GetMany[Contact](c, "company/contacts", params)
+	return c.ListContactsCtx(context.Background(), params)
+}
+
+func (c *Client) ListContactsCtx(ctx context.Context, params map[string]string) ([]Contact, error) {
+	return GetManyCtx[Contact](ctx, c, "company/contacts", params)
 }
 
 func (c *Client) GetContact(contactID int, params map[string]string) (*Contact, error) {
-	return GetOne[Contact](c, contactIdEndpoint(contactID), params)
+	return c.GetContactCtx(context.Background(), contactID, params)
+}
+
+func (c *Client) GetContactCtx(ctx context.Context, contactID int, params map[string]string) (*Contact, error) {
+	return GetOneCtx[Contact](ctx, c, contactIdEndpoint(contactID), params)
 }
 
 func (c *Client) PutContact(contactID int, contact *Contact) (*Contact, error) {
-	return Put[Contact](c, contactIdEndpoint(contactID), contact)
+	return c.PutContactCtx(context.Background(), contactID, contact)
+}
+
+func (c *Client) PutContactCtx(ctx context.Context, contactID int, contact *Contact) (*Contact, error) {
+	return PutCtx[Contact](ctx, c, contactIdEndpoint(contactID), contact)
 }
 
 func (c *Client) PatchContact(contactID int, patchOps []PatchOp) (*Contact, error) {
-	return Patch[Contact](c, contactIdEndpoint(contactID), patchOps)
+	return c.PatchContactCtx(context.Background(), contactID, patchOps)
+}
+
+func (c *Client) PatchContactCtx(ctx context.Context, contactID int, patchOps []PatchOp) (*Contact, error) {
+	return PatchCtx[Contact](ctx, c, contactIdEndpoint(contactID), patchOps)
 }
 
 func (c *Client) DeleteContact(contactID int) error {
-	return Delete(c, contactIdEndpoint(contactID))
+	return c.DeleteContactCtx(context.Background(), contactID)
+}
+
+func (c *Client) DeleteContactCtx(ctx context.Context, contactID int) error {
+	return DeleteCtx(ctx, c, contactIdEndpoint(contactID))
 }
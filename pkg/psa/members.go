@@ -1,7 +1,9 @@
 package psa
 
 import (
+	"context"
 	"fmt"
+	"iter"
 )
 
 func memberIdEndpoint(memberId int) string {
@@ -9,19 +11,34 @@ func memberIdEndpoint(memberId int) string {
 }
 
 func (c *Client) PostMember(member *Member) (*Member, error) {
-	return Post[Member](c, "system/members", member)
+	return c.PostMemberCtx(context.Background(), member)
+}
+
+func (c *Client) PostMemberCtx(ctx context.Context, member *Member) (*Member, error) {
+	return PostCtx[Member](ctx, c, "system/members", member)
 }
 
 func (c *Client) ListMembers(params map[string]string) ([]Member, error) {
-	return GetMany[Member](c, "system/members", params)
+	return c.ListMembersCtx(context.Background(), params)
+}
+
+func (c *Client) ListMembersCtx(ctx context.Context, params map[string]string) ([]Member, error) {
+	return GetManyCtx[Member](ctx, c, "system/members", params)
+}
+
+// ListMembersSeq iterates over members, fetching pages as they are needed.
+func (c *Client) ListMembersSeq(ctx context.Context, params map[string]string) iter.Seq2[Member, error] {
+	return GetManySeq[Member](ctx, c, "system/members", params)
 }
 
 func (c *Client) GetMemberByIdentifier(identifier string) (*Member, error) {
-	p := map[string]string{
-		"conditions": fmt.Sprintf("identifier='%s'", identifier),
-	}
+	return c.GetMemberByIdentifierCtx(context.Background(), identifier)
+}
+
+func (c *Client) GetMemberByIdentifierCtx(ctx context.Context, identifier string) (*Member, error) {
+	p := NewQuery().Where(Eq("identifier", identifier))
 
-	members, err := c.ListMembers(p)
+	members, err := c.ListMembersCtx(ctx, p)
 	if err != nil {
 		return nil, fmt.Errorf("listing members: %w", err)
 	}
@@ -38,17 +55,33 @@ func (c *Client) GetMemberByIdentifier(identifier string) (*Member, error) {
 }
 
 func (c *Client) GetMember(memberID int, params map[string]string) (*Member, error) {
-	return GetOne[Member](c, memberIdEndpoint(memberID), params)
+	return c.GetMemberCtx(context.Background(), memberID, params)
+}
+
+func (c *Client) GetMemberCtx(ctx context.Context, memberID int, params map[string]string) (*Member, error) {
+	return GetOneCtx[Member](ctx, c, memberIdEndpoint(memberID), params)
 }
 
 func (c *Client) PutMember(memberID int, member *Member) (*Member, error) {
-	return Put[Member](c, memberIdEndpoint(memberID), member)
+	return c.PutMemberCtx(context.Background(), memberID, member)
+}
+
+func (c *Client) PutMemberCtx(ctx context.Context, memberID int, member *Member) (*Member, error) {
+	return PutCtx[Member](ctx, c, memberIdEndpoint(memberID), member)
 }
 
 func (c *Client) PatchMember(memberID int, patchOps []PatchOp) (*Member, error) {
-	return Patch[Member](c, memberIdEndpoint(memberID), patchOps)
+	return c.PatchMemberCtx(context.Background(), memberID, patchOps)
+}
+
+func (c *Client) PatchMemberCtx(ctx context.Context, memberID int, patchOps []PatchOp) (*Member, error) {
+	return PatchCtx[Member](ctx, c, memberIdEndpoint(memberID), patchOps)
 }
 
 func (c *Client) DeleteMember(memberID int) error {
-	return Delete(c, memberIdEndpoint(memberID))
+	return c.DeleteMemberCtx(context.Background(), memberID)
+}
+
+func (c *Client) DeleteMemberCtx(ctx context.Context, memberID int) error {
+	return DeleteCtx(ctx, c, memberIdEndpoint(memberID))
 }
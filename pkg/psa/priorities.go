@@ -0,0 +1,58 @@
+package psa
+
+import (
+	"context"
+	"fmt"
+)
+
+func priorityIdEndpoint(priorityID int) string {
+	return fmt.Sprintf("service/priorities/%d", priorityID)
+}
+
+func (c *Client) PostPriority(priority *Priority) (*Priority, error) {
+	return c.PostPriorityCtx(context.Background(), priority)
+}
+
+func (c *Client) PostPriorityCtx(ctx context.Context, priority *Priority) (*Priority, error) {
+	return PostCtx[Priority](ctx, c, "service/priorities", priority)
+}
+
+func (c *Client) ListPriorities(params map[string]string) ([]Priority, error) {
+	return c.ListPrioritiesCtx(context.Background(), params)
+}
+
+func (c *Client) ListPrioritiesCtx(ctx context.Context, params map[string]string) ([]Priority, error) {
+	return GetManyCtx[Priority](ctx, c, "service/priorities", params)
+}
+
+func (c *Client) GetPriority(priorityID int, params map[string]string) (*Priority, error) {
+	return c.GetPriorityCtx(context.Background(), priorityID, params)
+}
+
+func (c *Client) GetPriorityCtx(ctx context.Context, priorityID int, params map[string]string) (*Priority, error) {
+	return GetOneCtx[Priority](ctx, c, priorityIdEndpoint(priorityID), params)
+}
+
+func (c *Client) PutPriority(priorityID int, priority *Priority) (*Priority, error) {
+	return c.PutPriorityCtx(context.Background(), priorityID, priority)
+}
+
+func (c *Client) PutPriorityCtx(ctx context.Context, priorityID int, priority *Priority) (*Priority, error) {
+	return PutCtx[Priority](ctx, c, priorityIdEndpoint(priorityID), priority)
+}
+
+func (c *Client) PatchPriority(priorityID int, patchOps []PatchOp) (*Priority, error) {
+	return c.PatchPriorityCtx(context.Background(), priorityID, patchOps)
+}
+
+func (c *Client) PatchPriorityCtx(ctx context.Context, priorityID int, patchOps []PatchOp) (*Priority, error) {
+	return PatchCtx[Priority](ctx, c, priorityIdEndpoint(priorityID), patchOps)
+}
+
+func (c *Client) DeletePriority(priorityID int) error {
+	return c.DeletePriorityCtx(context.Background(), priorityID)
+}
+
+func (c *Client) DeletePriorityCtx(ctx context.Context, priorityID int) error {
+	return DeleteCtx(ctx, c, priorityIdEndpoint(priorityID))
+}
@@ -0,0 +1,491 @@
+package psatest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// condition is a parsed ConnectWise conditions string, evaluated against an object decoded
+// from JSON.
+type condition interface {
+	match(obj map[string]any) bool
+}
+
+type (
+	andCond []condition
+	orCond  []condition
+
+	compareCond struct {
+		field string
+		op    string
+		vals  []any
+	}
+)
+
+func (c andCond) match(obj map[string]any) bool {
+	for _, sub := range c {
+		if !sub.match(obj) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c orCond) match(obj map[string]any) bool {
+	for _, sub := range c {
+		if sub.match(obj) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c compareCond) match(obj map[string]any) bool {
+	fv := lookup(obj, c.field)
+	switch c.op {
+	case "in":
+		for _, v := range c.vals {
+			if compare(fv, v) == 0 {
+				return true
+			}
+		}
+		return false
+	case "not in":
+		for _, v := range c.vals {
+			if compare(fv, v) == 0 {
+				return false
+			}
+		}
+		return true
+	case "like", "not like":
+		s, _ := fv.(string)
+		p, _ := c.vals[0].(string)
+		ok := likePattern(p).MatchString(s)
+		return ok == (c.op == "like")
+	}
+
+	cmp := compare(fv, c.vals[0])
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp == -1
+	case "<=":
+		return cmp == -1 || cmp == 0
+	case ">":
+		return cmp == 1
+	case ">=":
+		return cmp == 1 || cmp == 0
+	}
+
+	return false
+}
+
+// lookup resolves a slash separated field path, e.g. board/id. Fields ConnectWise keeps under
+// _info, such as lastUpdated, are also found by their bare name.
+func lookup(obj map[string]any, path string) any {
+	var cur any = obj
+	for _, part := range strings.Split(path, "/") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = fieldValue(m, part)
+	}
+
+	if cur == nil && !strings.Contains(path, "/") {
+		if info, ok := obj["_info"].(map[string]any); ok {
+			return fieldValue(info, path)
+		}
+	}
+
+	return cur
+}
+
+// fieldValue gets a field case-insensitively, as ConnectWise does.
+func fieldValue(m map[string]any, name string) any {
+	if v, ok := m[name]; ok {
+		return v
+	}
+
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// compare orders a field value against a condition value, returning -1, 0, or 1, or 2 if the
+// two can't be compared.
+func compare(field, val any) int {
+	switch v := val.(type) {
+	case nil:
+		if field == nil {
+			return 0
+		}
+		return 2
+	case bool:
+		f, _ := field.(bool)
+		if f == v {
+			return 0
+		}
+		return 2
+	case float64:
+		f, ok := field.(float64)
+		if !ok {
+			return 2
+		}
+		return cmpOrdered(f, v)
+	case time.Time:
+		s, _ := field.(string)
+		f, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return 2
+		}
+		return f.Compare(v)
+	case string:
+		f, ok := field.(string)
+		if !ok {
+			return 2
+		}
+		return cmpOrdered(strings.ToLower(f), strings.ToLower(v))
+	}
+
+	return 2
+}
+
+func cmpOrdered[T float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func likePattern(p string) *regexp.Regexp {
+	parts := strings.Split(p, "%")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("(?is)^" + strings.Join(parts, ".*") + "$")
+}
+
+// parseConditions parses a conditions string. An empty string matches everything.
+func parseConditions(s string) (condition, error) {
+	if strings.TrimSpace(s) == "" {
+		return andCond{}, nil
+	}
+
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &condParser{toks: toks}
+	c, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q", p.toks[p.pos].text)
+	}
+
+	return c, nil
+}
+
+type tokenKind int
+
+const (
+	tokWord tokenKind = iota
+	tokString
+	tokNumber
+	tokDate
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	r := []rune(s)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			toks = append(toks, token{tokLParen, "("})
+			i++
+		case c == ')':
+			toks = append(toks, token{tokRParen, ")"})
+			i++
+		case c == ',':
+			toks = append(toks, token{tokComma, ","})
+			i++
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(r) && r[j] != c; j++ {
+				if r[j] == '\\' && j+1 < len(r) {
+					j++
+				}
+				b.WriteRune(r[j])
+			}
+			if j >= len(r) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			toks = append(toks, token{tokString, b.String()})
+			i = j + 1
+		case c == '[':
+			j := i + 1
+			for j < len(r) && r[j] != ']' {
+				j++
+			}
+			if j >= len(r) {
+				return nil, fmt.Errorf("unterminated date at %d", i)
+			}
+			toks = append(toks, token{tokDate, string(r[i+1 : j])})
+			i = j + 1
+		case strings.ContainsRune("=!<>", c):
+			j := i + 1
+			if j < len(r) && r[j] == '=' {
+				j++
+			}
+			toks = append(toks, token{tokOp, string(r[i:j])})
+			i = j
+		case c == '-' || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(r) && (unicode.IsDigit(r[j]) || r[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, string(r[i:j])})
+			i = j
+		default:
+			j := i
+			for j < len(r) && (unicode.IsLetter(r[j]) || unicode.IsDigit(r[j]) || strings.ContainsRune("_/.", r[j])) {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("unexpected character %q at %d", c, i)
+			}
+			toks = append(toks, token{tokWord, string(r[i:j])})
+			i = j
+		}
+	}
+
+	return toks, nil
+}
+
+type condParser struct {
+	toks []token
+	pos  int
+}
+
+func (p *condParser) peek() (token, bool) {
+	if p.pos >= len(p.toks) {
+		return token{}, false
+	}
+
+	return p.toks[p.pos], true
+}
+
+func (p *condParser) next() (token, error) {
+	t, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("unexpected end of conditions")
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *condParser) isWord(w string) bool {
+	t, ok := p.peek()
+	return ok && t.kind == tokWord && strings.EqualFold(t.text, w)
+}
+
+func (p *condParser) parseOr() (condition, error) {
+	var or orCond
+	for {
+		c, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, c)
+
+		if !p.isWord("or") {
+			break
+		}
+		p.pos++
+	}
+
+	if len(or) == 1 {
+		return or[0], nil
+	}
+
+	return or, nil
+}
+
+func (p *condParser) parseAnd() (condition, error) {
+	var and andCond
+	for {
+		c, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, c)
+
+		if !p.isWord("and") {
+			break
+		}
+		p.pos++
+	}
+
+	if len(and) == 1 {
+		return and[0], nil
+	}
+
+	return and, nil
+}
+
+func (p *condParser) parseFactor() (condition, error) {
+	if t, ok := p.peek(); ok && t.kind == tokLParen {
+		p.pos++
+		c, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if t, err := p.next(); err != nil || t.kind != tokRParen {
+			return nil, fmt.Errorf("expected )")
+		}
+
+		return c, nil
+	}
+
+	field, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if field.kind != tokWord {
+		return nil, fmt.Errorf("expected field name, got %q", field.text)
+	}
+
+	op, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+
+	cc := compareCond{field: field.text, op: op}
+	if op != "in" && op != "not in" {
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		cc.vals = []any{v}
+		return cc, nil
+	}
+
+	if t, err := p.next(); err != nil || t.kind != tokLParen {
+		return nil, fmt.Errorf("expected ( after %s", op)
+	}
+
+	for {
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		cc.vals = append(cc.vals, v)
+
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if t.kind == tokRParen {
+			return cc, nil
+		}
+
+		if t.kind != tokComma {
+			return nil, fmt.Errorf("expected , or ) in list, got %q", t.text)
+		}
+	}
+}
+
+func (p *condParser) parseOperator() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	if t.kind == tokOp {
+		return t.text, nil
+	}
+
+	if t.kind == tokWord {
+		w := strings.ToLower(t.text)
+		switch w {
+		case "in", "like":
+			return w, nil
+		case "not":
+			n, err := p.next()
+			if err != nil {
+				return "", err
+			}
+			nw := strings.ToLower(n.text)
+			if nw == "in" || nw == "like" {
+				return "not " + nw, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unsupported operator %q", t.text)
+}
+
+func (p *condParser) parseValue() (any, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case tokString:
+		return t.text, nil
+	case tokNumber:
+		return strconv.ParseFloat(t.text, 64)
+	case tokDate:
+		return time.Parse(time.RFC3339, t.text)
+	case tokWord:
+		switch strings.ToLower(t.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected value %q", t.text)
+}
@@ -0,0 +1,137 @@
+package psatest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+// Endpoints of the collections the typed helpers seed.
+const (
+	TicketsEndpoint   = "service/tickets"
+	BoardsEndpoint    = "service/boards"
+	MembersEndpoint   = "system/members"
+	CompaniesEndpoint = "company/companies"
+	ContactsEndpoint  = "company/contacts"
+	CallbacksEndpoint = "system/callbacks"
+	ScheduleEndpoint  = "schedule/entries"
+)
+
+// Put stores any object in the collection at endpoint, e.g. "service/priorities" or
+// "service/boards/1/types", as if it had been posted. An object without an ID is given one.
+// It returns the stored object as JSON-decoded fields.
+func (s *Server) Put(endpoint string, obj any) (map[string]any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling object: %w", err)
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("unmarshaling object: %w", err)
+	}
+
+	coll, id, err := splitPath(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != 0 {
+		m["id"] = float64(id)
+	}
+
+	return s.put(coll, m), nil
+}
+
+// Get returns the object with the given ID from the collection at endpoint, or false if it
+// doesn't exist.
+func (s *Server) Get(endpoint string, id int) (map[string]any, bool) {
+	return s.get(endpoint, id)
+}
+
+// Delete removes the object with the given ID from the collection at endpoint, returning false
+// if it didn't exist.
+func (s *Server) Delete(endpoint string, id int) bool {
+	return s.delete(endpoint, id)
+}
+
+// Count returns how many objects are in the collection at endpoint.
+func (s *Server) Count(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.collections[endpoint])
+}
+
+func (s *Server) AddTicket(t psa.Ticket) *psa.Ticket {
+	return mustAdd(s, TicketsEndpoint, t)
+}
+
+func (s *Server) AddTicketNote(ticketID int, n psa.ServiceTicketNote) *psa.ServiceTicketNote {
+	return mustAdd(s, fmt.Sprintf("%s/%d/notes", TicketsEndpoint, ticketID), n)
+}
+
+func (s *Server) AddBoard(b psa.Board) *psa.Board {
+	return mustAdd(s, BoardsEndpoint, b)
+}
+
+func (s *Server) AddBoardStatus(boardID int, st psa.BoardStatus) *psa.BoardStatus {
+	return mustAdd(s, fmt.Sprintf("%s/%d/statuses", BoardsEndpoint, boardID), st)
+}
+
+func (s *Server) AddMember(m psa.Member) *psa.Member {
+	return mustAdd(s, MembersEndpoint, m)
+}
+
+func (s *Server) AddCompany(c psa.Company) *psa.Company {
+	return mustAdd(s, CompaniesEndpoint, c)
+}
+
+func (s *Server) AddContact(c psa.Contact) *psa.Contact {
+	return mustAdd(s, ContactsEndpoint, c)
+}
+
+func (s *Server) AddScheduleEntry(e psa.ScheduleEntry) *psa.ScheduleEntry {
+	return mustAdd(s, ScheduleEndpoint, e)
+}
+
+// Callbacks returns the callbacks registered with the server, e.g. by the webhooks service.
+func (s *Server) Callbacks() []psa.Callback {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []psa.Callback
+	for _, obj := range s.collections[CallbacksEndpoint] {
+		var cb psa.Callback
+		if err := decode(obj, &cb); err == nil {
+			out = append(out, cb)
+		}
+	}
+
+	return out
+}
+
+// mustAdd stores an object and decodes what was stored back into its type. It panics if the
+// object can't round trip through JSON, which is a bug in the test calling it.
+func mustAdd[T any](s *Server, endpoint string, v T) *T {
+	m, err := s.Put(endpoint, v)
+	if err != nil {
+		panic(fmt.Sprintf("psatest: adding to %s: %v", endpoint, err))
+	}
+
+	out := new(T)
+	if err := decode(m, out); err != nil {
+		panic(fmt.Sprintf("psatest: decoding %s: %v", endpoint, err))
+	}
+
+	return out
+}
+
+func decode(obj map[string]any, v any) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
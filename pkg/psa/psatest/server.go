@@ -0,0 +1,477 @@
+// Package psatest provides an in-memory fake of the ConnectWise PSA API for tests.
+//
+// The fake stores every object as JSON, so any endpoint of the form collection, collection/{id},
+// collection/{id}/child, or collection/{id}/child/{id} works for GET, POST, PUT, PATCH, and
+// DELETE. List endpoints support conditions, orderBy, page, and pageSize, and return Link
+// headers for the next page like the real API. childConditions and fields are ignored.
+//
+//	srv := psatest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddTicket(psa.Ticket{Summary: "Printer on fire"})
+//	client := srv.Client()
+//	tickets, err := client.ListTickets(nil)
+package psatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+const (
+	CompanyID  = "psatest"
+	PublicKey  = "psatest-public"
+	PrivateKey = "psatest-private"
+	ClientID   = "psatest-client"
+	Codebase   = "v4_6_release"
+
+	defaultPageSize = 25
+	maxPageSize     = 1000
+)
+
+// Server is a fake ConnectWise API backed by an httptest server. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	collections map[string]map[int]map[string]any
+	nextID      int
+	signingKey  string
+	requests    int
+	messages    int
+}
+
+// NewServer starts a fake ConnectWise server. Call Close when done with it.
+func NewServer() *Server {
+	s := &Server{
+		collections: make(map[string]map[int]map[string]any),
+		nextID:      1,
+		signingKey:  "psatest-signing-key",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /login/companyinfo/{company}", s.handleCompanyInfo)
+	mux.HandleFunc("GET /psatest/signing-key", s.handleSigningKey)
+	mux.HandleFunc(fmt.Sprintf("/%s/apis/3.0/", Codebase), s.handleAPI)
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Creds returns credentials that point a psa.Client at the fake server.
+func (s *Server) Creds() *psa.Creds {
+	return &psa.Creds{
+		PublicKey:  PublicKey,
+		PrivateKey: PrivateKey,
+		ClientId:   ClientID,
+		CompanyId:  CompanyID,
+		Site:       s.URL,
+		Codebase:   Codebase,
+	}
+}
+
+// Client returns a psa.Client for the fake server. Retries are disabled by default so tests
+// fail fast; pass options to override.
+func (s *Server) Client(opts ...psa.Option) *psa.Client {
+	opts = append([]psa.Option{psa.WithRetries(0, time.Millisecond, time.Millisecond)}, opts...)
+	return psa.NewClient(s.Creds(), opts...)
+}
+
+// Requests returns how many API requests the server has handled.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) handleCompanyInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, psa.CompanyInfo{
+		CompanyName: r.PathValue("company"),
+		CompanyID:   r.PathValue("company"),
+		Codebase:    Codebase + "/",
+		SiteURL:     strings.TrimPrefix(s.URL, "http://"),
+	})
+}
+
+func (s *Server) handleSigningKey(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"signing_key": s.signingKey})
+}
+
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request) {
+	user, pass, ok := r.BasicAuth()
+	if !ok || user != CompanyID+"+"+PublicKey || pass != PrivateKey || r.Header.Get("clientId") != ClientID {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "invalid credentials")
+		return
+	}
+
+	s.mu.Lock()
+	s.requests++
+	s.mu.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/%s/apis/3.0/", Codebase)), "/")
+	coll, id, err := splitPath(path)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "NotFound", err.Error())
+		return
+	}
+
+	switch {
+	case id == 0 && r.Method == http.MethodGet:
+		s.handleList(w, r, coll)
+	case id == 0 && r.Method == http.MethodPost:
+		s.handleCreate(w, r, coll)
+	case id != 0 && r.Method == http.MethodGet:
+		s.handleGet(w, coll, id)
+	case id != 0 && r.Method == http.MethodPut:
+		s.handleReplace(w, r, coll, id)
+	case id != 0 && r.Method == http.MethodPatch:
+		s.handlePatch(w, r, coll, id)
+	case id != 0 && r.Method == http.MethodDelete:
+		s.handleDelete(w, coll, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" not supported on "+path)
+	}
+}
+
+// splitPath turns an API path into a collection key and an optional object ID. A ticket's
+// allNotes endpoint is served from its notes.
+func splitPath(path string) (string, int, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return "", 0, fmt.Errorf("unknown endpoint %s", path)
+	}
+
+	coll := parts[0] + "/" + parts[1]
+	rest := parts[2:]
+	for len(rest) > 0 {
+		id, err := strconv.Atoi(rest[0])
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid id %q", rest[0])
+		}
+
+		if len(rest) == 1 {
+			return coll, id, nil
+		}
+
+		child := rest[1]
+		if child == "allNotes" {
+			child = "notes"
+		}
+
+		coll = fmt.Sprintf("%s/%d/%s", coll, id, child)
+		rest = rest[2:]
+	}
+
+	return coll, 0, nil
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, coll string) {
+	q := r.URL.Query()
+	cond, err := parseConditions(q.Get("conditions"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidObject", fmt.Sprintf("invalid conditions: %v", err))
+		return
+	}
+
+	page := max(atoiDefault(q.Get("page"), 1), 1)
+	size := min(max(atoiDefault(q.Get("pageSize"), defaultPageSize), 1), maxPageSize)
+
+	s.mu.Lock()
+	var matched []map[string]any
+	for _, obj := range s.collections[coll] {
+		if cond.match(obj) {
+			matched = append(matched, obj)
+		}
+	}
+	s.mu.Unlock()
+
+	sortObjects(matched, q.Get("orderBy"))
+
+	start := min((page-1)*size, len(matched))
+	end := min(start+size, len(matched))
+	if end < len(matched) {
+		q.Set("page", strconv.Itoa(page+1))
+		q.Set("pageSize", strconv.Itoa(size))
+		next := fmt.Sprintf("%s%s?%s", s.URL, r.URL.Path, q.Encode())
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+	}
+
+	out := matched[start:end]
+	if out == nil {
+		out = []map[string]any{}
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, coll string) {
+	var obj map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidObject", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, s.put(coll, obj))
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, coll string, id int) {
+	obj, ok := s.get(coll, id)
+	if !ok {
+		writeNotFound(w, coll, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) handleReplace(w http.ResponseWriter, r *http.Request, coll string, id int) {
+	if _, ok := s.get(coll, id); !ok {
+		writeNotFound(w, coll, id)
+		return
+	}
+
+	var obj map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidObject", err.Error())
+		return
+	}
+	obj["id"] = float64(id)
+
+	writeJSON(w, http.StatusOK, s.put(coll, obj))
+}
+
+func (s *Server) handlePatch(w http.ResponseWriter, r *http.Request, coll string, id int) {
+	obj, ok := s.get(coll, id)
+	if !ok {
+		writeNotFound(w, coll, id)
+		return
+	}
+
+	var ops []psa.PatchOp
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidObject", err.Error())
+		return
+	}
+
+	for _, op := range ops {
+		if err := applyPatch(obj, op); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidObject", err.Error())
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, s.put(coll, obj))
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, coll string, id int) {
+	if !s.delete(coll, id) {
+		writeNotFound(w, coll, id)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// put stores an object, assigning an ID if it has none and stamping _info/lastUpdated, and
+// returns a copy of what was stored.
+func (s *Server) put(coll string, obj map[string]any) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := 0
+	if f, ok := obj["id"].(float64); ok {
+		id = int(f)
+	}
+
+	if id == 0 {
+		id = s.nextID
+		obj["id"] = float64(id)
+	}
+	s.nextID = max(s.nextID, id+1)
+
+	info, _ := obj["_info"].(map[string]any)
+	if info == nil {
+		info = make(map[string]any)
+		obj["_info"] = info
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	info["lastUpdated"] = now
+	if _, ok := info["dateEntered"]; !ok || info["dateEntered"] == zeroTime {
+		info["dateEntered"] = now
+	}
+
+	setParentRef(coll, obj)
+
+	if s.collections[coll] == nil {
+		s.collections[coll] = make(map[int]map[string]any)
+	}
+	s.collections[coll][id] = obj
+
+	return copyObject(obj)
+}
+
+func (s *Server) get(coll string, id int) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collections[coll][id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyObject(obj), true
+}
+
+func (s *Server) delete(coll string, id int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.collections[coll][id]; !ok {
+		return false
+	}
+
+	delete(s.collections[coll], id)
+	return true
+}
+
+const zeroTime = "0001-01-01T00:00:00Z"
+
+// setParentRef fills in the reference to the parent object that ConnectWise includes on child
+// objects, such as a status's board or a note's ticket ID.
+func setParentRef(coll string, obj map[string]any) {
+	parts := strings.Split(coll, "/")
+	if len(parts) < 4 {
+		return
+	}
+
+	parentID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	parent, child := parts[0]+"/"+parts[1], parts[3]
+	switch {
+	case parent == "service/tickets" && child == "notes":
+		obj["ticketId"] = float64(parentID)
+	case parent == "service/boards" && child == "teams":
+		obj["boardId"] = float64(parentID)
+	case parent == "service/boards":
+		board, _ := obj["board"].(map[string]any)
+		if board == nil {
+			board = make(map[string]any)
+			obj["board"] = board
+		}
+		board["id"] = float64(parentID)
+	}
+}
+
+func applyPatch(obj map[string]any, op psa.PatchOp) error {
+	parts := strings.Split(strings.Trim(op.Path, "/"), "/")
+	cur := obj
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := cur[p].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			cur[p] = next
+		}
+		cur = next
+	}
+
+	last := parts[len(parts)-1]
+	switch strings.ToLower(string(op.Op)) {
+	case "replace", "add":
+		// round trip the value so it matches what a JSON decode would produce
+		b, err := json.Marshal(op.Value)
+		if err != nil {
+			return err
+		}
+
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		cur[last] = v
+	case "remove":
+		delete(cur, last)
+	default:
+		return fmt.Errorf("unsupported patch op %q", op.Op)
+	}
+
+	return nil
+}
+
+// sortObjects sorts by the orderBy param, e.g. "id desc, summary", defaulting to ID ascending.
+func sortObjects(objs []map[string]any, orderBy string) {
+	type key struct {
+		field string
+		desc  bool
+	}
+
+	var keys []key
+	for part := range strings.SplitSeq(orderBy, ",") {
+		f := strings.Fields(part)
+		if len(f) == 0 {
+			continue
+		}
+		keys = append(keys, key{field: f[0], desc: len(f) > 1 && strings.EqualFold(f[1], "desc")})
+	}
+	keys = append(keys, key{field: "id"})
+
+	slices.SortStableFunc(objs, func(a, b map[string]any) int {
+		for _, k := range keys {
+			c := compare(lookup(a, k.field), lookup(b, k.field))
+			if c == 2 {
+				c = 0
+			}
+
+			if k.desc {
+				c = -c
+			}
+
+			if c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+}
+
+func copyObject(obj map[string]any) map[string]any {
+	b, _ := json.Marshal(obj)
+	var out map[string]any
+	_ = json.Unmarshal(b, &out)
+	return out
+}
+
+func atoiDefault(s string, def int) int {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+
+	return i
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, errCode, msg string) {
+	writeJSON(w, code, map[string]string{"code": errCode, "message": msg})
+}
+
+func writeNotFound(w http.ResponseWriter, coll string, id int) {
+	writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("%s/%d not found", coll, id))
+}
@@ -0,0 +1,321 @@
+package psatest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/thecoretg/ticketbot/pkg/psa"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func ticketIDs(tix []psa.Ticket) []int {
+	ids := make([]int, len(tix))
+	for i, t := range tix {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestListPagination(t *testing.T) {
+	srv := newTestServer(t)
+	var want []int
+	for range 30 {
+		want = append(want, srv.AddTicket(psa.Ticket{Summary: "ticket"}).ID)
+	}
+
+	var got []int
+	for tk, err := range srv.Client().ListTicketsSeq(context.Background(), psa.NewQuery().PageSize(7)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, tk.ID)
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got ids %v, want %v", got, want)
+	}
+
+	// 30 tickets at 7 per page
+	if n := srv.Requests(); n != 5 {
+		t.Errorf("server got %d requests, want 5", n)
+	}
+}
+
+func TestListConditionsAndOrder(t *testing.T) {
+	srv := newTestServer(t)
+	add := func(board int, closed bool, summary string) int {
+		tk := psa.Ticket{Summary: summary, ClosedFlag: closed}
+		tk.Board.ID = board
+		return srv.AddTicket(tk).ID
+	}
+
+	printer := add(1, false, "Printer on fire")
+	closed := add(1, true, "Printer jammed")
+	other := add(2, false, "Email down")
+	vpn := add(1, false, "VPN is slow")
+
+	tests := []struct {
+		name  string
+		query map[string]string
+		want  []int
+	}{
+		{name: "no conditions", query: nil, want: []int{printer, closed, other, vpn}},
+		{name: "eq", query: psa.NewQuery().Where(psa.Eq("board/id", 1)), want: []int{printer, closed, vpn}},
+		{
+			name:  "and",
+			query: psa.NewQuery().Where(psa.Eq("board/id", 1), psa.Eq("closedFlag", false)),
+			want:  []int{printer, vpn},
+		},
+		{
+			name:  "or",
+			query: psa.NewQuery().Where(psa.Or(psa.Eq("board/id", 2), psa.Eq("closedFlag", true))),
+			want:  []int{closed, other},
+		},
+		{name: "like is case insensitive", query: psa.NewQuery().Where(psa.Like("summary", "printer%")), want: []int{printer, closed}},
+		{name: "in", query: psa.NewQuery().Where(psa.InInts("id", []int{vpn, other})), want: []int{other, vpn}},
+		{name: "order by desc", query: psa.NewQuery().Where(psa.Eq("closedFlag", false)).OrderByDesc("id"), want: []int{vpn, other, printer}},
+		{name: "order by string", query: psa.NewQuery().OrderBy("summary"), want: []int{other, closed, printer, vpn}},
+	}
+
+	c := srv.Client()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tix, err := c.ListTicketsCtx(context.Background(), tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := ticketIDs(tix); !slices.Equal(got, tt.want) {
+				t.Errorf("got ids %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidConditions(t *testing.T) {
+	srv := newTestServer(t)
+	if _, err := srv.Client().ListTickets(map[string]string{"conditions": "summary = "}); err == nil {
+		t.Error("expected an error for invalid conditions")
+	}
+}
+
+func TestCRUD(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+	c := srv.Client()
+
+	created, err := c.PostTicketCtx(ctx, &psa.Ticket{Summary: "New ticket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.ID == 0 || created.Info.LastUpdated.IsZero() {
+		t.Fatalf("created ticket should have an id and last updated, got %+v", created)
+	}
+
+	patched, err := c.PatchTicketCtx(ctx, created.ID, []psa.PatchOp{{Op: "replace", Path: "summary", Value: "Patched"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if patched.Summary != "Patched" {
+		t.Errorf("patched summary = %q, want Patched", patched.Summary)
+	}
+
+	if _, err := c.PutTicketCtx(ctx, created.ID, &psa.Ticket{Summary: "Replaced"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetTicketCtx(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.ID != created.ID || got.Summary != "Replaced" {
+		t.Errorf("got %d %q, want %d Replaced", got.ID, got.Summary, created.ID)
+	}
+
+	if err := c.DeleteTicketCtx(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetTicketCtx(ctx, created.ID, nil); !errors.Is(err, psa.ErrNotFound) {
+		t.Errorf("getting deleted ticket: err = %v, want ErrNotFound", err)
+	}
+
+	if err := c.DeleteTicketCtx(ctx, created.ID); !errors.Is(err, psa.ErrNotFound) {
+		t.Errorf("deleting deleted ticket: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestChildEndpoints(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+	c := srv.Client()
+
+	tk := srv.AddTicket(psa.Ticket{Summary: "Has notes"})
+	srv.AddTicketNote(tk.ID, psa.ServiceTicketNote{Text: "first"})
+	latest := srv.AddTicketNote(tk.ID, psa.ServiceTicketNote{Text: "second"})
+
+	if latest.TicketId != tk.ID {
+		t.Errorf("note ticket id = %d, want %d", latest.TicketId, tk.ID)
+	}
+
+	n, err := c.GetMostRecentTicketNoteCtx(ctx, tk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n == nil || n.ID != latest.ID || n.Text != "second" {
+		t.Errorf("most recent note = %+v, want %d", n, latest.ID)
+	}
+
+	b := srv.AddBoard(psa.Board{Name: "Help Desk"})
+	st := srv.AddBoardStatus(b.ID, psa.BoardStatus{Name: "New"})
+
+	got, err := c.GetBoardStatusCtx(ctx, st.ID, nil, b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Name != "New" || got.Board.ID != b.ID {
+		t.Errorf("got status %q on board %d, want New on board %d", got.Name, got.Board.ID, b.ID)
+	}
+
+	// statuses of another board are a different collection
+	if _, err := c.GetBoardStatusCtx(ctx, st.ID, nil, b.ID+100); !errors.Is(err, psa.ErrNotFound) {
+		t.Errorf("status on wrong board: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	srv := newTestServer(t)
+	creds := srv.Creds()
+	creds.PrivateKey = "wrong"
+
+	c := psa.NewClient(creds, psa.WithRetries(0, 0, 0))
+	if _, err := c.ListTickets(nil); !errors.Is(err, psa.ErrUnauthorized) {
+		t.Errorf("err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestDiscoverCodebase(t *testing.T) {
+	srv := newTestServer(t)
+	creds := srv.Creds()
+	creds.Codebase = ""
+
+	cb, err := psa.NewClient(creds).DiscoverCodebase(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cb != Codebase {
+		t.Errorf("codebase = %q, want %q", cb, Codebase)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+	tk := srv.AddTicket(psa.Ticket{Summary: "Signed"})
+
+	req, err := srv.NewWebhookRequest(ctx, "http://example.com/hooks", "ticket", ActionUpdated, tk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if ok, err := psa.ValidateWebhook(req); err != nil || !ok {
+		t.Errorf("ValidateWebhook = %v, %v; want true", ok, err)
+	}
+
+	// the same signature doesn't validate a changed body
+	req.Body = io.NopCloser(bytes.NewReader(bytes.Replace(body, []byte("updated"), []byte("deleted"), 1)))
+	if ok, err := psa.ValidateWebhook(req); err != nil || ok {
+		t.Errorf("ValidateWebhook on tampered body = %v, %v; want false", ok, err)
+	}
+
+	if _, err := srv.NewWebhookRequest(ctx, "http://example.com/hooks", "ticket", ActionUpdated, tk.ID+100); err == nil {
+		t.Error("expected an error for a ticket that doesn't exist")
+	}
+
+	// deleted objects are sent without an entity, so they don't need to exist
+	if _, err := srv.NewWebhookRequest(ctx, "http://example.com/hooks", "ticket", ActionDeleted, tk.ID+100); err != nil {
+		t.Errorf("deleted webhook: %v", err)
+	}
+}
+
+func TestFireCallbacks(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+
+	var (
+		mu       sync.Mutex
+		received []map[string]any
+	)
+	recv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		received = append(received, payload)
+		mu.Unlock()
+	}))
+	defer recv.Close()
+
+	c := srv.Client()
+	callbacks := []psa.Callback{
+		{Type: "ticket", Level: "owner", URL: recv.URL},
+		{Type: "ticket", Level: "owner", URL: recv.URL, InactiveFlag: true},
+		{Type: "company", Level: "owner", URL: recv.URL},
+	}
+	for _, cb := range callbacks {
+		if _, err := c.PostCallbackCtx(ctx, &cb); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := len(srv.Callbacks()); got != len(callbacks) {
+		t.Fatalf("server has %d callbacks, want %d", got, len(callbacks))
+	}
+
+	tk := srv.AddTicket(psa.Ticket{Summary: "Fire"})
+	sent, err := srv.FireCallbacks(ctx, "ticket", ActionAdded, tk.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sent != 1 || len(received) != 1 {
+		t.Fatalf("sent %d and received %d callbacks, want 1", sent, len(received))
+	}
+
+	if got := received[0]["ID"]; got != float64(tk.ID) {
+		t.Errorf("payload id = %v, want %d", got, tk.ID)
+	}
+
+	if got := received[0]["Action"]; got != ActionAdded {
+		t.Errorf("payload action = %v, want %s", got, ActionAdded)
+	}
+}
@@ -0,0 +1,132 @@
+package psatest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Webhook actions ConnectWise sends in callback payloads.
+const (
+	ActionAdded   = "added"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// callbackEndpoints maps callback types to the collection holding their objects.
+var callbackEndpoints = map[string]string{
+	"ticket":   TicketsEndpoint,
+	"schedule": ScheduleEndpoint,
+	"company":  CompaniesEndpoint,
+	"contact":  ContactsEndpoint,
+	"member":   MembersEndpoint,
+}
+
+// NewWebhookRequest builds a signed callback request for the object of the given type and
+// ID, as ConnectWise would post it to url. The object's current state is sent as the entity
+// unless the action is deleted. The signature validates with psa.ValidateWebhook against this
+// server's signing key.
+func (s *Server) NewWebhookRequest(ctx context.Context, url, callbackType, action string, id int) (*http.Request, error) {
+	body, err := s.webhookBody(callbackType, action, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-content-signature", s.sign(body))
+
+	return req, nil
+}
+
+// FireCallbacks posts a signed webhook to every active callback registered for the type, and
+// returns the number sent. It fails on the first callback that can't be reached or doesn't
+// respond with a 2xx status.
+func (s *Server) FireCallbacks(ctx context.Context, callbackType, action string, id int) (int, error) {
+	sent := 0
+	for _, cb := range s.Callbacks() {
+		if cb.InactiveFlag || !strings.EqualFold(cb.Type, callbackType) {
+			continue
+		}
+
+		req, err := s.NewWebhookRequest(ctx, cb.URL, callbackType, action, id)
+		if err != nil {
+			return sent, err
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return sent, fmt.Errorf("posting to callback %d: %w", cb.ID, err)
+		}
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return sent, fmt.Errorf("callback %d responded with %s", cb.ID, res.Status)
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *Server) webhookBody(callbackType, action string, id int) ([]byte, error) {
+	endpoint, ok := callbackEndpoints[strings.ToLower(callbackType)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported callback type %q", callbackType)
+	}
+
+	entity := ""
+	if action != ActionDeleted {
+		obj, ok := s.get(endpoint, id)
+		if !ok {
+			return nil, fmt.Errorf("%s %d not found", callbackType, id)
+		}
+
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling entity: %w", err)
+		}
+		entity = string(b)
+	}
+
+	payload := map[string]any{
+		"MessageId": fmt.Sprintf("psatest-%d", s.nextMessageID()),
+		"FromUrl":   strings.TrimPrefix(s.URL, "http://"),
+		"CompanyId": CompanyID,
+		"MemberId":  "psatest",
+		"Action":    action,
+		"Type":      strings.ToLower(callbackType),
+		"ID":        id,
+		"Entity":    entity,
+		"Metadata":  map[string]string{"key_url": s.URL + "/psatest/signing-key"},
+	}
+
+	return json.Marshal(payload)
+}
+
+// sign computes the x-content-signature header ConnectWise sends: an HMAC-SHA256 of the body,
+// keyed with the SHA-256 of the signing key, in base64.
+func (s *Server) sign(body []byte) string {
+	key := sha256.Sum256([]byte(s.signingKey))
+	h := hmac.New(sha256.New, key[:])
+	h.Write(body)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func (s *Server) nextMessageID() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages++
+	return s.messages
+}
@@ -0,0 +1,229 @@
+package psa
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query builds the query params accepted by ConnectWise list endpoints. It is a
+// map[string]string underneath, so it can be passed anywhere params are accepted:
+//
+//	q := psa.NewQuery().
+//		Where(psa.Eq("closedFlag", false), psa.In("board/id", 1, 2)).
+//		OrderByDesc("id").
+//		PageSize(100)
+//	tickets, err := client.ListTickets(q)
+type Query map[string]string
+
+func NewQuery() Query {
+	return Query{}
+}
+
+// Where sets the conditions param to the given conditions ANDed together, replacing any
+// conditions already set.
+func (q Query) Where(conds ...Condition) Query {
+	return q.set("conditions", And(conds...).String())
+}
+
+// WhereChild sets the childConditions param, which filters on child objects such as
+// a company's types, to the given conditions ANDed together.
+func (q Query) WhereChild(conds ...Condition) Query {
+	return q.set("childConditions", And(conds...).String())
+}
+
+// OrderBy adds an ascending sort on the field after any sorts already set.
+func (q Query) OrderBy(field string) Query {
+	return q.appendList("orderBy", field+" asc")
+}
+
+// OrderByDesc adds a descending sort on the field after any sorts already set.
+func (q Query) OrderByDesc(field string) Query {
+	return q.appendList("orderBy", field+" desc")
+}
+
+// Fields limits the returned fields of each object to the ones given.
+func (q Query) Fields(fields ...string) Query {
+	return q.appendList("fields", fields...)
+}
+
+func (q Query) PageSize(n int) Query {
+	return q.set("pageSize", strconv.Itoa(n))
+}
+
+func (q Query) set(key, val string) Query {
+	if val == "" {
+		delete(q, key)
+		return q
+	}
+
+	q[key] = val
+	return q
+}
+
+func (q Query) appendList(key string, vals ...string) Query {
+	if len(vals) == 0 {
+		return q
+	}
+
+	if cur := q[key]; cur != "" {
+		vals = append([]string{cur}, vals...)
+	}
+
+	q[key] = strings.Join(vals, ",")
+	return q
+}
+
+// Condition is a single expression in a ConnectWise conditions string. The zero value is an
+// empty condition, which is ignored when combined with And or Or.
+type Condition struct {
+	expr string
+	// compound is set for multi-part And/Or groups, which need parentheses when nested
+	compound bool
+}
+
+func (c Condition) String() string {
+	return c.expr
+}
+
+func Eq(field string, val any) Condition {
+	return compare(field, "=", val)
+}
+
+func NotEq(field string, val any) Condition {
+	return compare(field, "!=", val)
+}
+
+// Gt compares with >. Use a time.Time value for date fields, e.g. Gt("lastUpdated", t).
+func Gt(field string, val any) Condition {
+	return compare(field, ">", val)
+}
+
+func Gte(field string, val any) Condition {
+	return compare(field, ">=", val)
+}
+
+func Lt(field string, val any) Condition {
+	return compare(field, "<", val)
+}
+
+func Lte(field string, val any) Condition {
+	return compare(field, "<=", val)
+}
+
+// Like matches a string field against a pattern, where % matches any characters.
+func Like(field, pattern string) Condition {
+	return compare(field, "like", pattern)
+}
+
+// NotLike is the inverse of Like.
+func NotLike(field, pattern string) Condition {
+	return compare(field, "not like", pattern)
+}
+
+// In matches a field against any of the given values. An empty list gives an empty condition.
+func In(field string, vals ...any) Condition {
+	return inList(field, "in", vals)
+}
+
+// NotIn is the inverse of In.
+func NotIn(field string, vals ...any) Condition {
+	return inList(field, "not in", vals)
+}
+
+// And joins conditions with AND, skipping empty ones.
+func And(conds ...Condition) Condition {
+	return join("AND", conds)
+}
+
+// Or joins conditions with OR, skipping empty ones.
+func Or(conds ...Condition) Condition {
+	return join("OR", conds)
+}
+
+// InInts is a convenience for In with a slice of ints, such as IDs.
+func InInts(field string, vals []int) Condition {
+	a := make([]any, len(vals))
+	for i, v := range vals {
+		a[i] = v
+	}
+
+	return In(field, a...)
+}
+
+func compare(field, op string, val any) Condition {
+	return Condition{expr: fmt.Sprintf("%s %s %s", field, op, formatValue(val))}
+}
+
+func inList(field, op string, vals []any) Condition {
+	if len(vals) == 0 {
+		return Condition{}
+	}
+
+	f := make([]string, len(vals))
+	for i, v := range vals {
+		f[i] = formatValue(v)
+	}
+
+	return Condition{expr: fmt.Sprintf("%s %s (%s)", field, op, strings.Join(f, ", "))}
+}
+
+func join(op string, conds []Condition) Condition {
+	var nonEmpty []Condition
+	for _, c := range conds {
+		if c.expr != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+
+	switch len(nonEmpty) {
+	case 0:
+		return Condition{}
+	case 1:
+		return nonEmpty[0]
+	}
+
+	parts := make([]string, len(nonEmpty))
+	for i, c := range nonEmpty {
+		parts[i] = c.expr
+		if c.compound {
+			parts[i] = fmt.Sprintf("(%s)", c.expr)
+		}
+	}
+
+	return Condition{expr: strings.Join(parts, fmt.Sprintf(" %s ", op)), compound: true}
+}
+
+// formatValue renders a value in ConnectWise condition syntax. Strings are double quoted with
+// backslash escapes, and times are UTC in square brackets.
+func formatValue(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return fmt.Sprintf("[%s]", v.UTC().Format(time.RFC3339))
+	case fmt.Stringer:
+		return quote(v.String())
+	default:
+		return quote(fmt.Sprint(v))
+	}
+}
+
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, s)
+}
@@ -0,0 +1,96 @@
+package psa
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{name: "nil", val: nil, want: `null`},
+		{name: "string", val: "Open", want: `"Open"`},
+		{name: "string with quotes", val: `say "hi"`, want: `"say \"hi\""`},
+		{name: "string with backslash", val: `C:\temp`, want: `"C:\\temp"`},
+		{name: "backslash before a quote", val: `\"`, want: `"\\\""`},
+		{name: "empty string", val: "", want: `""`},
+		{name: "bool", val: true, want: `true`},
+		{name: "int", val: 42, want: `42`},
+		{name: "int32", val: int32(-7), want: `-7`},
+		{name: "int64", val: int64(1) << 40, want: `1099511627776`},
+		{name: "float", val: 1.5, want: `1.5`},
+		{name: "time is utc", val: time.Date(2025, 3, 4, 5, 6, 7, 0, time.FixedZone("EST", -5*60*60)), want: `[2025-03-04T10:06:07Z]`},
+		{name: "stringer is quoted", val: net.IPv4(10, 0, 0, 1), want: `"10.0.0.1"`},
+		{name: "other types are quoted", val: uint(3), want: `"3"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatValue(tt.val); got != tt.want {
+				t.Errorf("formatValue(%#v) = %s, want %s", tt.val, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditions(t *testing.T) {
+	tests := []struct {
+		name string
+		cond Condition
+		want string
+	}{
+		{name: "eq", cond: Eq("closedFlag", false), want: `closedFlag = false`},
+		{name: "like", cond: Like("summary", "%printer%"), want: `summary like "%printer%"`},
+		{name: "in", cond: In("board/id", 1, 2), want: `board/id in (1, 2)`},
+		{name: "empty in", cond: In("board/id"), want: ``},
+		{name: "in ints", cond: InInts("id", []int{3, 4}), want: `id in (3, 4)`},
+		{name: "and skips empty", cond: And(Eq("a", 1), Condition{}, In("b")), want: `a = 1`},
+		{name: "and", cond: And(Eq("a", 1), NotEq("b", "x")), want: `a = 1 AND b != "x"`},
+		{
+			name: "nested groups are parenthesized",
+			cond: And(Eq("a", 1), Or(Eq("b", 2), Eq("c", 3))),
+			want: `a = 1 AND (b = 2 OR c = 3)`,
+		},
+		{name: "single nested condition isn't parenthesized", cond: And(Or(Eq("b", 2))), want: `b = 2`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cond.String(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	q := NewQuery().
+		Where(Eq("closedFlag", false)).
+		OrderByDesc("id").
+		OrderBy("summary").
+		Fields("id", "summary").
+		PageSize(100)
+
+	want := map[string]string{
+		"conditions": `closedFlag = false`,
+		"orderBy":    `id desc,summary asc`,
+		"fields":     `id,summary`,
+		"pageSize":   `100`,
+	}
+
+	for k, v := range want {
+		if q[k] != v {
+			t.Errorf("%s = %q, want %q", k, q[k], v)
+		}
+	}
+
+	// empty conditions remove the param rather than sending an empty one
+	q.Where(In("board/id"))
+	if _, ok := q["conditions"]; ok {
+		t.Errorf("conditions = %q, want it unset", q["conditions"])
+	}
+}
@@ -0,0 +1,182 @@
+package psa
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultRatePerSecond = 8
+	defaultBurst         = 16
+	defaultMaxRetries    = 5
+	defaultBaseBackoff   = 500 * time.Millisecond
+	defaultMaxBackoff    = 30 * time.Second
+)
+
+// Stats are running totals for requests made by a Client, for use in metrics.
+type Stats struct {
+	Requests     int64   `json:"requests"`
+	Retries      int64   `json:"retries"`
+	RateLimited  int64   `json:"rate_limited"`
+	ServerErrors int64   `json:"server_errors"`
+	WaitSeconds  float64 `json:"wait_seconds"`
+	RateLimit    float64 `json:"rate_limit"`
+	Burst        int     `json:"burst"`
+}
+
+type clientStats struct {
+	requests     atomic.Int64
+	retries      atomic.Int64
+	rateLimited  atomic.Int64
+	serverErrors atomic.Int64
+	waitNanos    atomic.Int64
+}
+
+// Stats returns a snapshot of the client's request totals and rate limit settings.
+func (c *Client) Stats() Stats {
+	return Stats{
+		Requests:     c.stats.requests.Load(),
+		Retries:      c.stats.retries.Load(),
+		RateLimited:  c.stats.rateLimited.Load(),
+		ServerErrors: c.stats.serverErrors.Load(),
+		WaitSeconds:  time.Duration(c.stats.waitNanos.Load()).Seconds(),
+		RateLimit:    c.limiter.rate,
+		Burst:        int(c.limiter.burst),
+	}
+}
+
+// tokenBucket limits the request rate of every goroutine sharing a Client. A Retry-After
+// from ConnectWise pauses the whole bucket, not just the request that received it.
+type tokenBucket struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or the context is done, and returns how long it waited.
+func (b *tokenBucket) wait(ctx context.Context) (time.Duration, error) {
+	var waited time.Duration
+	for {
+		d := b.reserve()
+		if d == 0 {
+			return waited, nil
+		}
+
+		if err := sleepCtx(ctx, d); err != nil {
+			return waited, err
+		}
+		waited += d
+	}
+}
+
+// reserve takes a token if one is available and returns 0, or otherwise returns how long to
+// wait before trying again.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+		b.tokens = 0
+	}
+}
+
+// backoff returns an exponential delay for the given retry attempt with full jitter applied.
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.baseBackoff << attempt
+	if d <= 0 || d > c.maxBackoff {
+		d = c.maxBackoff
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryable reports whether a response is worth retrying. A 429 never reached ConnectWise's
+// handler, so it is always safe to retry, but a 5xx may come after a write was committed, so
+// only idempotent methods retry those.
+func retryable(method string, code int) bool {
+	if code == http.StatusTooManyRequests {
+		return true
+	}
+
+	return code >= http.StatusInternalServerError && idempotent(method)
+}
+
+// idempotent reports whether sending the request again has the same effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -1,148 +1,218 @@
 package psa
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"iter"
+	"log/slog"
 	"net/http"
 	"strings"
-)
 
-// TODO: implement retry handling
-const (
-	baseUrl = "https://api-na.myconnectwise.net/v4_6_release/apis/3.0"
+	"resty.dev/v3"
 )
 
 var (
-	ErrNotFound = errors.New("404 status returned")
+	ErrNotFound     = errors.New("404 status returned")
+	ErrUnauthorized = errors.New("unauthorized by ConnectWise API")
+	ErrRateLimited  = errors.New("rate limited by ConnectWise API")
 )
 
 func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, error) {
-	var target T
-	res, err := c.restClient.R().
-		SetQueryParams(params).
-		SetResult(&target).
-		Get(fullURL(baseUrl, endpoint))
+	return GetOneCtx[T](context.Background(), c, endpoint, params)
+}
 
+func GetOneCtx[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) (*T, error) {
+	var target T
+	res, err := c.do(ctx, http.MethodGet, c.apiURL(endpoint), func(r *resty.Request) {
+		r.SetQueryParams(params).SetResult(&target)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if res.IsError() {
-		if res.StatusCode() == http.StatusNotFound {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("error response from ConnectWise API: %s", res.String())
-	}
-
 	return res.Result().(*T), nil
 }
 
 func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T, error) {
-	var allItems []T
-
-	endpoint = fullURL(baseUrl, endpoint)
-	for endpoint != "" {
-		var target []T
-		req := c.restClient.R().
-			SetQueryParams(params).
-			SetResult(&target)
+	return GetManyCtx[T](context.Background(), c, endpoint, params)
+}
 
-		res, err := req.Get(endpoint)
+func GetManyCtx[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) ([]T, error) {
+	var allItems []T
+	for item, err := range GetManySeq[T](ctx, c, endpoint, params) {
 		if err != nil {
 			return nil, err
 		}
+		allItems = append(allItems, item)
+	}
+
+	return allItems, nil
+}
 
-		if res.IsError() {
-			if res.StatusCode() == http.StatusNotFound {
-				return nil, ErrNotFound
+// GetManySeq returns an iterator over every item from a list endpoint. Pages are requested
+// lazily as the iterator is consumed by following the Link header, so only one page is held in
+// memory at a time. If a request fails, the error is yielded and iteration stops.
+func GetManySeq[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		p := params
+		next := c.apiURL(endpoint)
+		for next != "" {
+			var target []T
+			res, err := c.do(ctx, http.MethodGet, next, func(r *resty.Request) {
+				r.SetQueryParams(p).SetResult(&target)
+			})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
 			}
-			return nil, fmt.Errorf("error response from ConnectWise API: %s", res.String())
-		}
 
-		for _, item := range target {
-			allItems = append(allItems, item)
-		}
+			for _, item := range target {
+				if !yield(item, nil) {
+					return
+				}
+			}
 
-		params = nil
-		endpoint = parseLinkHeader(res.Header().Get("Link"), "next")
+			p = nil
+			next = parseLinkHeader(res.Header().Get("Link"), "next")
+		}
 	}
-
-	return allItems, nil
 }
 
 func Post[T any](c *Client, endpoint string, body any) (*T, error) {
-	var target T
-	res, err := c.restClient.R().
-		SetBody(body).
-		SetResult(target).
-		Post(fullURL(baseUrl, endpoint))
+	return PostCtx[T](context.Background(), c, endpoint, body)
+}
 
+func PostCtx[T any](ctx context.Context, c *Client, endpoint string, body any) (*T, error) {
+	var target T
+	res, err := c.do(ctx, http.MethodPost, c.apiURL(endpoint), func(r *resty.Request) {
+		r.SetBody(body).SetResult(target)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if res.IsError() {
-		return nil, fmt.Errorf("error response from ConnectWise API: %s", res.String())
-	}
-
 	return res.Result().(*T), nil
 }
 
 func Put[T any](c *Client, endpoint string, body any) (*T, error) {
-	var target T
-	res, err := c.restClient.R().
-		SetBody(body).
-		SetResult(target).
-		Put(fullURL(baseUrl, endpoint))
+	return PutCtx[T](context.Background(), c, endpoint, body)
+}
 
+func PutCtx[T any](ctx context.Context, c *Client, endpoint string, body any) (*T, error) {
+	var target T
+	res, err := c.do(ctx, http.MethodPut, c.apiURL(endpoint), func(r *resty.Request) {
+		r.SetBody(body).SetResult(target)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if res.IsError() {
-		if res.StatusCode() == http.StatusNotFound {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("error response from ConnectWise API: %s", res.String())
-	}
-
 	return res.Result().(*T), nil
 }
 
 func Patch[T any](c *Client, endpoint string, patchOps []PatchOp) (*T, error) {
-	var target T
-	res, err := c.restClient.R().
-		SetBody(patchOps).
-		SetResult(target).
-		Patch(fullURL(baseUrl, endpoint))
+	return PatchCtx[T](context.Background(), c, endpoint, patchOps)
+}
 
+func PatchCtx[T any](ctx context.Context, c *Client, endpoint string, patchOps []PatchOp) (*T, error) {
+	var target T
+	res, err := c.do(ctx, http.MethodPatch, c.apiURL(endpoint), func(r *resty.Request) {
+		r.SetBody(patchOps).SetResult(target)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if res.IsError() {
-		return nil, fmt.Errorf("error response from ConnectWise API: %s", res.String())
-	}
-
 	return res.Result().(*T), nil
 }
 
 func Delete(c *Client, endpoint string) error {
-	res, err := c.restClient.R().
-		Delete(fullURL(baseUrl, endpoint))
+	return DeleteCtx(context.Background(), c, endpoint)
+}
 
-	if err != nil {
-		return err
-	}
+func DeleteCtx(ctx context.Context, c *Client, endpoint string) error {
+	_, err := c.do(ctx, http.MethodDelete, c.apiURL(endpoint), nil)
+	return err
+}
 
-	if res.IsError() {
-		if res.StatusCode() == http.StatusNotFound {
-			return ErrNotFound
+// do sends a request once the rate limiter allows it, retrying with backoff: 429s for every
+// method, and 5xx responses and transport errors only for idempotent methods, since a POST or
+// PATCH that failed late may already have been applied. A fresh request is built for each
+// attempt with the setup func. Error responses are returned as errors, so a nil error means the
+// response was successful.
+func (c *Client) do(ctx context.Context, method, url string, setup func(r *resty.Request)) (*resty.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req := c.restClient.R().SetContext(ctx)
+		if setup != nil {
+			setup(req)
 		}
-		return fmt.Errorf("error response from ConnectWise API: %s", res.String())
+
+		waited, err := c.limiter.wait(ctx)
+		c.stats.waitNanos.Add(int64(waited))
+		if err != nil {
+			return nil, err
+		}
+
+		c.stats.requests.Add(1)
+		res, err := req.Execute(method, url)
+		if err != nil {
+			if ctx.Err() != nil || !idempotent(method) || attempt >= c.maxRetries {
+				return nil, err
+			}
+
+			wait := c.backoff(attempt)
+			c.stats.retries.Add(1)
+			slog.Debug("psa: retrying request", "method", method, "url", url, "error", err.Error(), "attempt", attempt+1, "wait", wait)
+			if err := sleepCtx(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		code := res.StatusCode()
+		if code == http.StatusTooManyRequests {
+			c.stats.rateLimited.Add(1)
+		} else if code >= http.StatusInternalServerError {
+			c.stats.serverErrors.Add(1)
+		}
+
+		if !retryable(method, code) || attempt >= c.maxRetries {
+			return res, responseError(res)
+		}
+
+		wait := c.backoff(attempt)
+		if ra, ok := retryAfter(res.Header()); ok {
+			wait = ra
+			if code == http.StatusTooManyRequests {
+				c.limiter.pause(ra)
+			}
+		}
+
+		c.stats.retries.Add(1)
+		slog.Debug("psa: retrying request", "method", method, "url", url, "status", code, "attempt", attempt+1, "wait", wait)
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func responseError(res *resty.Response) error {
+	if !res.IsError() {
+		return nil
 	}
 
-	return nil
+	switch res.StatusCode() {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: %s", ErrUnauthorized, res.String())
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %s", ErrRateLimited, res.String())
+	default:
+		return fmt.Errorf("error response from ConnectWise API: %s", res.String())
+	}
 }
 
 func fullURL(base, endpoint string) string {
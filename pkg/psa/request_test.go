@@ -0,0 +1,201 @@
+package psa
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	future := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+	past := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+
+	tests := []struct {
+		name   string
+		header string
+		min    time.Duration
+		max    time.Duration
+		ok     bool
+	}{
+		{name: "missing", header: "", ok: false},
+		{name: "seconds", header: "7", min: 7 * time.Second, max: 7 * time.Second, ok: true},
+		{name: "zero seconds", header: "0", min: 0, max: 0, ok: true},
+		{name: "negative seconds", header: "-3", ok: false},
+		{name: "http date", header: future, min: 80 * time.Second, max: 90 * time.Second, ok: true},
+		{name: "http date in the past", header: past, min: 0, max: 0, ok: true},
+		{name: "garbage", header: "soon", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.header != "" {
+				h.Set("Retry-After", tt.header)
+			}
+
+			got, ok := retryAfter(h)
+			if ok != tt.ok {
+				t.Fatalf("retryAfter(%q) ok = %v, want %v", tt.header, ok, tt.ok)
+			}
+
+			if got < tt.min || got > tt.max {
+				t.Errorf("retryAfter(%q) = %v, want between %v and %v", tt.header, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := &Client{baseBackoff: 100 * time.Millisecond, maxBackoff: time.Second}
+
+	tests := []struct {
+		attempt int
+		ceiling time.Duration
+	}{
+		{attempt: 0, ceiling: 100 * time.Millisecond},
+		{attempt: 1, ceiling: 200 * time.Millisecond},
+		{attempt: 3, ceiling: 800 * time.Millisecond},
+		{attempt: 4, ceiling: time.Second},
+		// large enough that the shift overflows
+		{attempt: 80, ceiling: time.Second},
+	}
+
+	for _, tt := range tests {
+		for range 50 {
+			got := c.backoff(tt.attempt)
+			if got < tt.ceiling/2 || got > tt.ceiling {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.ceiling/2, tt.ceiling)
+			}
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		method string
+		code   int
+		want   bool
+	}{
+		{http.MethodGet, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPatch, http.StatusTooManyRequests, true},
+		{http.MethodGet, http.StatusBadGateway, true},
+		{http.MethodPut, http.StatusInternalServerError, true},
+		{http.MethodDelete, http.StatusServiceUnavailable, true},
+		{http.MethodPost, http.StatusInternalServerError, false},
+		{http.MethodPatch, http.StatusBadGateway, false},
+		{http.MethodGet, http.StatusNotFound, false},
+		{http.MethodGet, http.StatusOK, false},
+	}
+
+	for _, tt := range tests {
+		if got := retryable(tt.method, tt.code); got != tt.want {
+			t.Errorf("retryable(%s, %d) = %v, want %v", tt.method, tt.code, got, tt.want)
+		}
+	}
+}
+
+// testClient returns a client for the server with fast retries and no rate limit to speak of.
+func testClient(url string) *Client {
+	return NewClient(&Creds{Site: url},
+		WithRateLimit(1000, 1000),
+		WithRetries(3, time.Millisecond, 5*time.Millisecond),
+	)
+}
+
+func TestDoRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		status   int
+		wantHits int64
+	}{
+		{name: "get retries server errors", method: http.MethodGet, status: http.StatusInternalServerError, wantHits: 4},
+		{name: "post does not retry server errors", method: http.MethodPost, status: http.StatusInternalServerError, wantHits: 1},
+		{name: "patch does not retry server errors", method: http.MethodPatch, status: http.StatusBadGateway, wantHits: 1},
+		{name: "post retries rate limits", method: http.MethodPost, status: http.StatusTooManyRequests, wantHits: 4},
+		{name: "client errors are not retried", method: http.MethodGet, status: http.StatusBadRequest, wantHits: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			c := testClient(srv.URL)
+			if _, err := c.do(context.Background(), tt.method, c.apiURL("service/tickets"), nil); err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("server got %d requests, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	var hits atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer srv.Close()
+
+	c := testClient(srv.URL)
+	got, err := GetOneCtx[struct{ ID int }](context.Background(), c, "service/tickets/1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.ID != 1 {
+		t.Errorf("got id %d, want 1", got.ID)
+	}
+
+	s := c.Stats()
+	if s.Requests != 3 || s.Retries != 2 || s.RateLimited != 2 {
+		t.Errorf("stats = %+v, want 3 requests, 2 retries, 2 rate limited", s)
+	}
+}
+
+func TestDoRetriesTransportErrors(t *testing.T) {
+	// a listener that is closed right away, so every request fails to connect
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "http://" + l.Addr().String()
+	l.Close()
+
+	tests := []struct {
+		method       string
+		wantRequests int64
+	}{
+		{method: http.MethodGet, wantRequests: 4},
+		{method: http.MethodPost, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		c := testClient(url)
+		if _, err := c.do(context.Background(), tt.method, c.apiURL("service/tickets"), nil); err == nil {
+			t.Fatalf("%s: expected an error", tt.method)
+		}
+
+		if got := c.Stats().Requests; got != tt.wantRequests {
+			t.Errorf("%s: made %d requests, want %d", tt.method, got, tt.wantRequests)
+		}
+	}
+}
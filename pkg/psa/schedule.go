@@ -0,0 +1,62 @@
+package psa
+
+import (
+	"context"
+	"fmt"
+)
+
+// ScheduleTypeServiceTicket is the schedule type identifier of entries whose ObjectID is a
+// service ticket ID.
+const ScheduleTypeServiceTicket = "S"
+
+func scheduleEntryIdEndpoint(entryID int) string {
+	return fmt.Sprintf("schedule/entries/%d", entryID)
+}
+
+func (c *Client) PostScheduleEntry(entry *ScheduleEntry) (*ScheduleEntry, error) {
+	return c.PostScheduleEntryCtx(context.Background(), entry)
+}
+
+func (c *Client) PostScheduleEntryCtx(ctx context.Context, entry *ScheduleEntry) (*ScheduleEntry, error) {
+	return PostCtx[ScheduleEntry](ctx, c, "schedule/entries", entry)
+}
+
+func (c *Client) ListScheduleEntries(params map[string]string) ([]ScheduleEntry, error) {
+	return c.ListScheduleEntriesCtx(context.Background(), params)
+}
+
+func (c *Client) ListScheduleEntriesCtx(ctx context.Context, params map[string]string) ([]ScheduleEntry, error) {
+	return GetManyCtx[ScheduleEntry](ctx, c, "schedule/entries", params)
+}
+
+func (c *Client) GetScheduleEntry(entryID int, params map[string]string) (*ScheduleEntry, error) {
+	return c.GetScheduleEntryCtx(context.Background(), entryID, params)
+}
+
+func (c *Client) GetScheduleEntryCtx(ctx context.Context, entryID int, params map[string]string) (*ScheduleEntry, error) {
+	return GetOneCtx[ScheduleEntry](ctx, c, scheduleEntryIdEndpoint(entryID), params)
+}
+
+func (c *Client) PutScheduleEntry(entryID int, entry *ScheduleEntry) (*ScheduleEntry, error) {
+	return c.PutScheduleEntryCtx(context.Background(), entryID, entry)
+}
+
+func (c *Client) PutScheduleEntryCtx(ctx context.Context, entryID int, entry *ScheduleEntry) (*ScheduleEntry, error) {
+	return PutCtx[ScheduleEntry](ctx, c, scheduleEntryIdEndpoint(entryID), entry)
+}
+
+func (c *Client) PatchScheduleEntry(entryID int, patchOps []PatchOp) (*ScheduleEntry, error) {
+	return c.PatchScheduleEntryCtx(context.Background(), entryID, patchOps)
+}
+
+func (c *Client) PatchScheduleEntryCtx(ctx context.Context, entryID int, patchOps []PatchOp) (*ScheduleEntry, error) {
+	return PatchCtx[ScheduleEntry](ctx, c, scheduleEntryIdEndpoint(entryID), patchOps)
+}
+
+func (c *Client) DeleteScheduleEntry(entryID int) error {
+	return c.DeleteScheduleEntryCtx(context.Background(), entryID)
+}
+
+func (c *Client) DeleteScheduleEntryCtx(ctx context.Context, entryID int) error {
+	return DeleteCtx(ctx, c, scheduleEntryIdEndpoint(entryID))
+}
@@ -0,0 +1,122 @@
+package psa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"resty.dev/v3"
+)
+
+const (
+	DefaultSite     = "api-na.myconnectwise.net"
+	DefaultCodebase = "v4_6_release"
+
+	apiPath = "apis/3.0"
+)
+
+// CompanyInfo is the response of the unauthenticated companyinfo endpoint, which reports the
+// codebase a company's site is currently running.
+type CompanyInfo struct {
+	CompanyName   string `json:"CompanyName"`
+	Codebase      string `json:"Codebase"`
+	VersionCode   string `json:"VersionCode"`
+	VersionNumber string `json:"VersionNumber"`
+	CompanyID     string `json:"CompanyID"`
+	IsCloud       bool   `json:"IsCloud"`
+	SiteURL       string `json:"SiteUrl"`
+}
+
+// GetCompanyInfoCtx looks up the company's site details from the companyinfo endpoint.
+func (c *Client) GetCompanyInfoCtx(ctx context.Context) (*CompanyInfo, error) {
+	var info CompanyInfo
+	u := fmt.Sprintf("%s/login/companyinfo/%s", c.siteURL, url.PathEscape(c.creds.CompanyId))
+	if _, err := c.do(ctx, http.MethodGet, u, func(r *resty.Request) {
+		r.SetResult(&info)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// DiscoverCodebase sets the client's codebase to the one reported by the companyinfo endpoint
+// and returns it. Cloud sites move to a new codebase on each ConnectWise release, so this avoids
+// hard-coding one. It should be called before the client is shared between goroutines.
+func (c *Client) DiscoverCodebase(ctx context.Context) (string, error) {
+	info, err := c.GetCompanyInfoCtx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("getting company info: %w", err)
+	}
+
+	cb := strings.Trim(info.Codebase, "/")
+	if cb == "" {
+		return "", fmt.Errorf("company info for %s returned no codebase", c.creds.CompanyId)
+	}
+
+	c.codebase = cb
+	return cb, nil
+}
+
+// Codebase returns the codebase the client's requests and links are using.
+func (c *Client) Codebase() string {
+	return c.codebase
+}
+
+func (c *Client) apiURL(endpoint string) string {
+	return fullURL(fmt.Sprintf("%s/%s/%s", c.siteURL, c.codebase, apiPath), endpoint)
+}
+
+// InternalTicketLink returns a link to a ticket in the ConnectWise web UI of the configured site.
+func (c *Client) InternalTicketLink(ticketID int) string {
+	return ticketLink(c.uiURL, c.codebase, ticketID, c.creds.CompanyId)
+}
+
+func (c *Client) MarkdownInternalTicketLink(ticketID int) string {
+	return fmt.Sprintf("[%d](%s)", ticketID, c.InternalTicketLink(ticketID))
+}
+
+// InternalTicketLink returns a link to a ticket on the default site and codebase.
+//
+// Deprecated: use Client.InternalTicketLink, which links to the client's configured site.
+func InternalTicketLink(ticketID int, companyID string) string {
+	_, ui := siteURLs(DefaultSite)
+	return ticketLink(ui, DefaultCodebase, ticketID, companyID)
+}
+
+// MarkdownInternalTicketLink returns a markdown link to a ticket on the default site and codebase.
+//
+// Deprecated: use Client.MarkdownInternalTicketLink, which links to the client's configured site.
+func MarkdownInternalTicketLink(ticketID int, companyID string) string {
+	return fmt.Sprintf("[%d](%s)", ticketID, InternalTicketLink(ticketID, companyID))
+}
+
+func ticketLink(uiURL, codebase string, ticketID int, companyID string) string {
+	return fmt.Sprintf("%s/%s/services/system_io/Service/fv_sr100_request.rails?service_recid=%d&companyName=%s",
+		uiURL, codebase, ticketID, url.QueryEscape(companyID))
+}
+
+// siteURLs returns the base URLs of the API and the web UI for a site. The site may be a bare
+// host or a URL, and defaults to https. ConnectWise cloud API hosts are the UI host with an
+// "api-" prefix, e.g. api-eu.myconnectwise.net and eu.myconnectwise.net, while on-prem
+// installs serve both from the same host.
+func siteURLs(site string) (api, ui string) {
+	site = strings.TrimRight(strings.TrimSpace(site), "/")
+	if site == "" {
+		site = DefaultSite
+	}
+
+	scheme := "https"
+	if s, rest, ok := strings.Cut(site, "://"); ok {
+		scheme, site = s, rest
+	}
+
+	uiHost := site
+	if h, ok := strings.CutPrefix(site, "api-"); ok && strings.HasSuffix(h, ".myconnectwise.net") {
+		uiHost = h
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, site), fmt.Sprintf("%s://%s", scheme, uiHost)
+}
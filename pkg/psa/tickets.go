@@ -1,11 +1,9 @@
 package psa
 
 import (
+	"context"
 	"fmt"
-)
-
-const (
-	ticketLinkBase = "https://na.myconnectwise.net/v4_6_release/services/system_io/Service/fv_sr100_request.rails?service_recid="
+	"iter"
 )
 
 func ticketIdEndpoint(ticketId int) string {
@@ -25,70 +23,128 @@ func specificNoteEndpoint(ticketId, noteId int) string {
 }
 
 func (c *Client) PostTicket(ticket *Ticket) (*Ticket, error) {
-	return Post[Ticket](c, "service/tickets", ticket)
+	return c.PostTicketCtx(context.Background(), ticket)
+}
+
+func (c *Client) PostTicketCtx(ctx context.Context, ticket *Ticket) (*Ticket, error) {
+	return PostCtx[Ticket](ctx, c, "service/tickets", ticket)
 }
 
 func (c *Client) ListTickets(params map[string]string) ([]Ticket, error) {
-	return GetMany[Ticket](c, "service/tickets", params)
+	return c.ListTicketsCtx(context.Background(), params)
+}
+
+func (c *Client) ListTicketsCtx(ctx context.Context, params map[string]string) ([]Ticket, error) {
+	return GetManyCtx[Ticket](ctx, c, "service/tickets", params)
+}
+
+// ListTicketsSeq iterates over tickets, fetching pages as they are needed.
+func (c *Client) ListTicketsSeq(ctx context.Context, params map[string]string) iter.Seq2[Ticket, error] {
+	return GetManySeq[Ticket](ctx, c, "service/tickets", params)
 }
 
 func (c *Client) GetTicket(ticketID int, params map[string]string) (*Ticket, error) {
-	return GetOne[Ticket](c, ticketIdEndpoint(ticketID), params)
+	return c.GetTicketCtx(context.Background(), ticketID, params)
+}
+
+func (c *Client) GetTicketCtx(ctx context.Context, ticketID int, params map[string]string) (*Ticket, error) {
+	return GetOneCtx[Ticket](ctx, c, ticketIdEndpoint(ticketID), params)
 }
 
 func (c *Client) PutTicket(ticketID int, ticket *Ticket) (*Ticket, error) {
-	return Put[Ticket](c, ticketIdEndpoint(ticketID), ticket)
+	return c.PutTicketCtx(context.Background(), ticketID, ticket)
+}
+
+func (c *Client) PutTicketCtx(ctx context.Context, ticketID int, ticket *Ticket) (*Ticket, error) {
+	return PutCtx[Ticket](ctx, c, ticketIdEndpoint(ticketID), ticket)
 }
 
 func (c *Client) PatchTicket(ticketID int, patchOps []PatchOp) (*Ticket, error) {
-	return Patch[Ticket](c, ticketIdEndpoint(ticketID), patchOps)
+	return c.PatchTicketCtx(context.Background(), ticketID, patchOps)
+}
+
+func (c *Client) PatchTicketCtx(ctx context.Context, ticketID int, patchOps []PatchOp) (*Ticket, error) {
+	return PatchCtx[Ticket](ctx, c, ticketIdEndpoint(ticketID), patchOps)
 }
 
 func (c *Client) DeleteTicket(ticketID int) error {
-	return Delete(c, ticketIdEndpoint(ticketID))
+	return c.DeleteTicketCtx(context.Background(), ticketID)
+}
+
+func (c *Client) DeleteTicketCtx(ctx context.Context, ticketID int) error {
+	return DeleteCtx(ctx, c, ticketIdEndpoint(ticketID))
 }
 
 // ListServiceTicketNotesAll gets all ticket notes, regardless of if they have a time entry.
 //
 // This is most likely the one you want to use unless you consistently uncheck the time entry box.
 func (c *Client) ListServiceTicketNotesAll(params map[string]string, ticketID int) ([]ServiceTicketNoteAll, error) {
-	return GetMany[ServiceTicketNoteAll](c, allNotesEndpoint(ticketID), params)
+	return c.ListServiceTicketNotesAllCtx(context.Background(), params, ticketID)
+}
+
+func (c *Client) ListServiceTicketNotesAllCtx(ctx context.Context, params map[string]string, ticketID int) ([]ServiceTicketNoteAll, error) {
+	return GetManyCtx[ServiceTicketNoteAll](ctx, c, allNotesEndpoint(ticketID), params)
 }
 
 func (c *Client) PostServiceTicketNote(ticketNote *ServiceTicketNote, ticketID int) (*ServiceTicketNote, error) {
-	return Post[ServiceTicketNote](c, notesEndpoint(ticketID), ticketNote)
+	return c.PostServiceTicketNoteCtx(context.Background(), ticketNote, ticketID)
+}
+
+func (c *Client) PostServiceTicketNoteCtx(ctx context.Context, ticketNote *ServiceTicketNote, ticketID int) (*ServiceTicketNote, error) {
+	return PostCtx[ServiceTicketNote](ctx, c, notesEndpoint(ticketID), ticketNote)
 }
 
 // ListServiceTicketNotes gets all notes that are not time entry.
 //
 // Not recommended since you will probably get what you need through ListServiceTicketNotes
 func (c *Client) ListServiceTicketNotes(params map[string]string, ticketID int) ([]ServiceTicketNote, error) {
-	return GetMany[ServiceTicketNote](c, notesEndpoint(ticketID), params)
+	return c.ListServiceTicketNotesCtx(context.Background(), params, ticketID)
+}
+
+func (c *Client) ListServiceTicketNotesCtx(ctx context.Context, params map[string]string, ticketID int) ([]ServiceTicketNote, error) {
+	return GetManyCtx[ServiceTicketNote](ctx, c, notesEndpoint(ticketID), params)
 }
 
 func (c *Client) GetServiceTicketNote(noteID int, params map[string]string, ticketID int) (*ServiceTicketNote, error) {
-	return GetOne[ServiceTicketNote](c, specificNoteEndpoint(ticketID, noteID), params)
+	return c.GetServiceTicketNoteCtx(context.Background(), noteID, params, ticketID)
+}
+
+func (c *Client) GetServiceTicketNoteCtx(ctx context.Context, noteID int, params map[string]string, ticketID int) (*ServiceTicketNote, error) {
+	return GetOneCtx[ServiceTicketNote](ctx, c, specificNoteEndpoint(ticketID, noteID), params)
 }
 
 func (c *Client) PutServiceTicketNote(noteID int, ticketNote *ServiceTicketNote, ticketID int) (*ServiceTicketNote, error) {
-	return Put[ServiceTicketNote](c, specificNoteEndpoint(ticketID, noteID), ticketNote)
+	return c.PutServiceTicketNoteCtx(context.Background(), noteID, ticketNote, ticketID)
+}
+
+func (c *Client) PutServiceTicketNoteCtx(ctx context.Context, noteID int, ticketNote *ServiceTicketNote, ticketID int) (*ServiceTicketNote, error) {
+	return PutCtx[ServiceTicketNote](ctx, c, specificNoteEndpoint(ticketID, noteID), ticketNote)
 }
 
 func (c *Client) PatchServiceTicketNote(noteID int, patchOps []PatchOp, ticketID int) (*ServiceTicketNote, error) {
-	return Patch[ServiceTicketNote](c, specificNoteEndpoint(ticketID, noteID), patchOps)
+	return c.PatchServiceTicketNoteCtx(context.Background(), noteID, patchOps, ticketID)
+}
+
+func (c *Client) PatchServiceTicketNoteCtx(ctx context.Context, noteID int, patchOps []PatchOp, ticketID int) (*ServiceTicketNote, error) {
+	return PatchCtx[ServiceTicketNote](ctx, c, specificNoteEndpoint(ticketID, noteID), patchOps)
 }
 
 func (c *Client) DeleteServiceTicketNote(noteID int, ticketID int) error {
-	return Delete(c, specificNoteEndpoint(ticketID, noteID))
+	return c.DeleteServiceTicketNoteCtx(context.Background(), noteID, ticketID)
+}
+
+func (c *Client) DeleteServiceTicketNoteCtx(ctx context.Context, noteID int, ticketID int) error {
+	return DeleteCtx(ctx, c, specificNoteEndpoint(ticketID, noteID))
 }
 
 func (c *Client) GetMostRecentTicketNote(ticketID int) (*ServiceTicketNote, error) {
-	p := map[string]string{
-		"orderBy":  "id desc",
-		"pageSize": "1000",
-	}
+	return c.GetMostRecentTicketNoteCtx(context.Background(), ticketID)
+}
+
+func (c *Client) GetMostRecentTicketNoteCtx(ctx context.Context, ticketID int) (*ServiceTicketNote, error) {
+	p := NewQuery().OrderByDesc("id").PageSize(1000)
 
-	notes, err := c.ListServiceTicketNotesAll(p, ticketID)
+	notes, err := c.ListServiceTicketNotesAllCtx(ctx, p, ticketID)
 	if err != nil {
 		return nil, fmt.Errorf("listing service notes: %w", err)
 	}
@@ -97,18 +153,10 @@ func (c *Client) GetMostRecentTicketNote(ticketID int) (*ServiceTicketNote, erro
 		return nil, nil
 	}
 
-	note, err := c.GetServiceTicketNote(notes[0].ID, nil, ticketID)
+	note, err := c.GetServiceTicketNoteCtx(ctx, notes[0].ID, nil, ticketID)
 	if err != nil {
 		return nil, fmt.Errorf("getting details for note: %w", err)
 	}
 
 	return note, nil
 }
-
-func MarkdownInternalTicketLink(ticketID int, companyID string) string {
-	return fmt.Sprintf("[%d](%s)", ticketID, InternalTicketLink(ticketID, companyID))
-}
-
-func InternalTicketLink(ticketID int, companyID string) string {
-	return fmt.Sprintf("%s%d&companyName=%s", ticketLinkBase, ticketID, companyID)
-}
@@ -177,6 +177,87 @@ type BoardStatus struct {
 	TimeEntryNotAllowed bool `json:"timeEntryNotAllowed,omitempty"`
 }
 
+type BoardType struct {
+	Info  interface{} `json:"_info,omitempty"`
+	Board struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"board,omitempty"`
+	Category    string `json:"category,omitempty"`
+	DefaultFlag bool   `json:"defaultFlag,omitempty"`
+	Department  struct {
+		Info       interface{} `json:"_info,omitempty"`
+		ID         int         `json:"id,omitempty"`
+		Identifier string      `json:"identifier,omitempty"`
+		Name       string      `json:"name,omitempty"`
+	} `json:"department,omitempty"`
+	ID                   int    `json:"id,omitempty"`
+	InactiveFlag         bool   `json:"inactiveFlag,omitempty"`
+	IntegrationXref      string `json:"integrationXref,omitempty"`
+	Name                 string `json:"name"`
+	RequestForChangeFlag bool   `json:"requestForChangeFlag,omitempty"`
+	SkillCategory        string `json:"skillCategory,omitempty"`
+	SkillSubcategory     string `json:"skillSubcategory,omitempty"`
+}
+
+type BoardSubtype struct {
+	Info  interface{} `json:"_info,omitempty"`
+	Board struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"board,omitempty"`
+	ID                 int    `json:"id,omitempty"`
+	InactiveFlag       bool   `json:"inactiveFlag,omitempty"`
+	IntegrationXref    string `json:"integrationXref,omitempty"`
+	Name               string `json:"name"`
+	TypeAssociationIds []int  `json:"typeAssociationIds,omitempty"`
+}
+
+type BoardItem struct {
+	Info  interface{} `json:"_info,omitempty"`
+	Board struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"board,omitempty"`
+	ID                 int    `json:"id,omitempty"`
+	InactiveFlag       bool   `json:"inactiveFlag,omitempty"`
+	Name               string `json:"name"`
+	TypeAssociationIds []int  `json:"typeAssociationIds,omitempty"`
+}
+
+type BoardTeam struct {
+	Info                 interface{} `json:"_info,omitempty"`
+	BoardID              int         `json:"boardId,omitempty"`
+	BusinessUnitID       int         `json:"businessUnitId,omitempty"`
+	DefaultFlag          bool        `json:"defaultFlag,omitempty"`
+	ID                   int         `json:"id,omitempty"`
+	LocationID           int         `json:"locationId,omitempty"`
+	Members              []int       `json:"members,omitempty"`
+	Name                 string      `json:"name"`
+	NotifyOnTicketDelete bool        `json:"notifyOnTicketDelete,omitempty"`
+	TeamLeader           struct {
+		Info       interface{} `json:"_info,omitempty"`
+		ID         int         `json:"id,omitempty"`
+		Identifier string      `json:"identifier,omitempty"`
+		Name       string      `json:"name,omitempty"`
+	} `json:"teamLeader,omitempty"`
+}
+
+type Priority struct {
+	Info             interface{} `json:"_info,omitempty"`
+	Color            string      `json:"color,omitempty"`
+	DefaultFlag      bool        `json:"defaultFlag,omitempty"`
+	ID               int         `json:"id,omitempty"`
+	ImageLink        string      `json:"imageLink,omitempty"`
+	Level            string      `json:"level,omitempty"`
+	Name             string      `json:"name"`
+	SortOrder        int         `json:"sortOrder,omitempty"`
+	UrgencySortOrder string      `json:"urgencySortOrder,omitempty"`
+}
+
 type Callback struct {
 	Info                 interface{} `json:"_info,omitempty"`
 	ConnectWiseID        string      `json:"connectWiseID,omitempty"`
@@ -1127,6 +1208,58 @@ type Ticket struct {
 	Zip string `json:"zip,omitempty"`
 }
 
+type ScheduleEntry struct {
+	Info                       interface{} `json:"_info,omitempty"`
+	AcknowledgedDate           string      `json:"acknowledgedDate,omitempty"`
+	AcknowledgedFlag           bool        `json:"acknowledgedFlag,omitempty"`
+	AddMemberToProjectFlag     bool        `json:"addMemberToProjectFlag,omitempty"`
+	AllowScheduleConflictsFlag bool        `json:"allowScheduleConflictsFlag,omitempty"`
+	CloseDate                  string      `json:"closeDate,omitempty"`
+	DateEnd                    time.Time   `json:"dateEnd,omitempty"`
+	DateStart                  time.Time   `json:"dateStart,omitempty"`
+	DoneFlag                   bool        `json:"doneFlag,omitempty"`
+	Hours                      float64     `json:"hours,omitempty"`
+	ID                         int         `json:"id,omitempty"`
+	Member                     struct {
+		Info       interface{} `json:"_info,omitempty"`
+		ID         int         `json:"id,omitempty"`
+		Identifier string      `json:"identifier,omitempty"`
+		Name       string      `json:"name,omitempty"`
+	} `json:"member,omitempty"`
+	MeetingFlag bool   `json:"meetingFlag,omitempty"`
+	MobileGuid  string `json:"mobileGuid,omitempty"`
+	Name        string `json:"name,omitempty"`
+	ObjectID    int    `json:"objectId,omitempty"`
+	OwnerFlag   bool   `json:"ownerFlag,omitempty"`
+	Reminder    struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"reminder,omitempty"`
+	Span struct {
+		Info       interface{} `json:"_info,omitempty"`
+		ID         int         `json:"id,omitempty"`
+		Identifier string      `json:"identifier,omitempty"`
+		Name       string      `json:"name,omitempty"`
+	} `json:"span,omitempty"`
+	Status struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"status,omitempty"`
+	Type struct {
+		Info       interface{} `json:"_info,omitempty"`
+		ID         int         `json:"id,omitempty"`
+		Identifier string      `json:"identifier,omitempty"`
+		Name       string      `json:"name,omitempty"`
+	} `json:"type,omitempty"`
+	Where struct {
+		Info interface{} `json:"_info,omitempty"`
+		ID   int         `json:"id,omitempty"`
+		Name string      `json:"name,omitempty"`
+	} `json:"where,omitempty"`
+}
+
 type ServiceTicketNote struct {
 	Info    interface{} `json:"_info,omitempty"`
 	Contact struct {
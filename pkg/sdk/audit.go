@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// AuditQuery filters an audit event listing. Zero values are ignored.
+type AuditQuery struct {
+	UserID     int
+	Action     models.AuditAction
+	EntityType models.AuditEntity
+	EntityID   int
+	Since      time.Time
+	Until      time.Time
+	// Limit is the most events returned, newest first; the server default is used if zero.
+	Limit int
+}
+
+// ListAuditEvents lists changes made through the API, newest first.
+func (c *Client) ListAuditEvents(q *AuditQuery) ([]models.AuditEvent, error) {
+	return GetMany[models.AuditEvent](c, pathListAuditEvents, q.params())
+}
+
+func (q *AuditQuery) params() map[string]string {
+	if q == nil {
+		return nil
+	}
+
+	p := make(map[string]string)
+	ints := map[string]int{
+		"user_id":   q.UserID,
+		"entity_id": q.EntityID,
+		"limit":     q.Limit,
+	}
+
+	for k, v := range ints {
+		if v != 0 {
+			p[k] = strconv.Itoa(v)
+		}
+	}
+
+	times := map[string]time.Time{
+		"since": q.Since,
+		"until": q.Until,
+	}
+
+	for k, v := range times {
+		if !v.IsZero() {
+			p[k] = v.UTC().Format(time.RFC3339)
+		}
+	}
+
+	if q.Action != "" {
+		p["action"] = string(q.Action)
+	}
+
+	if q.EntityType != "" {
+		p["entity_type"] = string(q.EntityType)
+	}
+
+	return p
+}
@@ -1,23 +1,26 @@
+//go:generate go run ../../cmd/openapi-gen -o paths_gen.go
+
 package sdk
 
 import (
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/thecoretg/ticketbot/internal/models"
 	"resty.dev/v3"
 )
 
 type Client struct {
 	restClient *resty.Client
-}
 
-type APIError struct {
-	Message string `json:"error"`
+	// session is set for clients authenticated with a login instead of an API key
+	session   *Session
+	sessionMu sync.Mutex
 }
 
-func (e *APIError) Error() string {
-	return e.Message
-}
+// APIError is the error the server sent back. Its Code is stable enough to check.
+type APIError = models.APIError
 
 func NewClient(apiKey, baseURL string) (*Client, error) {
 	c := resty.New()
@@ -34,26 +37,26 @@ func NewClient(apiKey, baseURL string) (*Client, error) {
 }
 
 func (c *Client) Ping() error {
-	var apiErr APIError
+	var apiErr models.ErrorResponse
 	res, err := c.restClient.R().
 		SetError(&apiErr).
-		Get("")
+		Get("healthcheck")
 	if err != nil {
 		return err
 	}
 
 	if res.IsError() {
-		return fmt.Errorf("error response from ticketbot api: %w", err)
+		return fmt.Errorf("error response from ticketbot api: %w", &apiErr.Error)
 	}
 
 	return nil
 }
 
 func (c *Client) AuthTest() error {
-	var apiErr APIError
+	var apiErr models.ErrorResponse
 	res, err := c.restClient.R().
 		SetError(&apiErr).
-		Get("authtest")
+		Get(pathAuthTest)
 	if err != nil {
 		return err
 	}
@@ -68,7 +71,7 @@ func (c *Client) AuthTest() error {
 func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, error) {
 	var (
 		target T
-		apiErr APIError
+		apiErr models.ErrorResponse
 	)
 
 	res, err := c.restClient.R().
@@ -81,7 +84,7 @@ func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, er
 	}
 
 	if res.IsError() {
-		return nil, &apiErr
+		return nil, &apiErr.Error
 	}
 
 	return res.Result().(*T), nil
@@ -90,7 +93,7 @@ func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, er
 func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T, error) {
 	var (
 		allItems []T
-		apiErr   APIError
+		apiErr   models.ErrorResponse
 	)
 
 	for endpoint != "" {
@@ -106,7 +109,7 @@ func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T,
 		}
 
 		if res.IsError() {
-			return nil, &apiErr
+			return nil, &apiErr.Error
 		}
 
 		allItems = append(allItems, target...)
@@ -119,7 +122,7 @@ func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T,
 }
 
 func (c *Client) Post(endpoint string, body, target any) error {
-	var apiErr APIError
+	var apiErr models.ErrorResponse
 	req := c.restClient.R().
 		SetError(&apiErr).
 		SetBody(body)
@@ -134,14 +137,14 @@ func (c *Client) Post(endpoint string, body, target any) error {
 	}
 
 	if res.IsError() {
-		return &apiErr
+		return &apiErr.Error
 	}
 
 	return nil
 }
 
 func (c *Client) Put(endpoint string, body, target any) error {
-	var apiErr APIError
+	var apiErr models.ErrorResponse
 	req := c.restClient.R().
 		SetBody(body).
 		SetError(&apiErr)
@@ -156,14 +159,36 @@ func (c *Client) Put(endpoint string, body, target any) error {
 	}
 
 	if res.IsError() {
-		return &apiErr
+		return &apiErr.Error
+	}
+
+	return nil
+}
+
+func (c *Client) Patch(endpoint string, body, target any) error {
+	var apiErr models.ErrorResponse
+	req := c.restClient.R().
+		SetBody(body).
+		SetError(&apiErr)
+
+	if target != nil {
+		req.SetResult(target)
+	}
+
+	res, err := req.Patch(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if res.IsError() {
+		return &apiErr.Error
 	}
 
 	return nil
 }
 
 func (c *Client) Delete(endpoint string) error {
-	var apiErr APIError
+	var apiErr models.ErrorResponse
 	res, err := c.restClient.R().
 		SetError(&apiErr).
 		Delete(endpoint)
@@ -172,7 +197,7 @@ func (c *Client) Delete(endpoint string) error {
 	}
 
 	if res.IsError() {
-		return &apiErr
+		return &apiErr.Error
 	}
 
 	return nil
@@ -7,12 +7,12 @@ import (
 )
 
 func (c *Client) GetConfig() (*models.Config, error) {
-	return GetOne[models.Config](c, "config", nil)
+	return GetOne[models.Config](c, pathGetConfig, nil)
 }
 
 func (c *Client) UpdateConfig(params *models.Config) (*models.Config, error) {
 	cfg := &models.Config{}
-	if err := c.Put("config", params, cfg); err != nil {
+	if err := c.Put(pathUpdateConfig, params, cfg); err != nil {
 		return nil, fmt.Errorf("sending update request: %w", err)
 	}
 
@@ -7,9 +7,9 @@ import (
 )
 
 func (c *Client) ListBoards() ([]models.Board, error) {
-	return GetMany[models.Board](c, "cw/boards", nil)
+	return GetMany[models.Board](c, pathListBoards, nil)
 }
 
 func (c *Client) GetBoard(id int) (*models.Board, error) {
-	return GetOne[models.Board](c, fmt.Sprintf("cw/boards/%d", id), nil)
+	return GetOne[models.Board](c, fmt.Sprintf(pathGetBoard, id), nil)
 }
@@ -0,0 +1,33 @@
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// ListCompanies lists companies, optionally only active ones whose name contains the search string.
+func (c *Client) ListCompanies(name string) ([]models.Company, error) {
+	return GetMany[models.Company](c, pathListCompanies, nameParam(name))
+}
+
+func (c *Client) GetCompany(id int) (*models.Company, error) {
+	return GetOne[models.Company](c, fmt.Sprintf(pathGetCompany, id), nil)
+}
+
+// ListContacts lists contacts, optionally only active ones whose full name contains the search string.
+func (c *Client) ListContacts(name string) ([]models.Contact, error) {
+	return GetMany[models.Contact](c, pathListContacts, nameParam(name))
+}
+
+func (c *Client) GetContact(id int) (*models.Contact, error) {
+	return GetOne[models.Contact](c, fmt.Sprintf(pathGetContact, id), nil)
+}
+
+func nameParam(name string) map[string]string {
+	if name == "" {
+		return nil
+	}
+
+	return map[string]string{"name": name}
+}
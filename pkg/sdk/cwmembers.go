@@ -5,5 +5,5 @@ import (
 )
 
 func (c *Client) ListMembers() ([]models.Member, error) {
-	return GetMany[models.Member](c, "cw/members", nil)
+	return GetMany[models.Member](c, pathListMembers, nil)
 }
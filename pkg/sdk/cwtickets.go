@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// TicketQuery filters a ticket listing. Zero values are ignored.
+type TicketQuery struct {
+	BoardID      int
+	StatusID     int
+	OwnerID      int
+	CompanyID    int
+	UpdatedSince time.Time
+	// State is either "open" or "closed".
+	State string
+	// PageSize is how many tickets are requested per page; the server default is used if zero.
+	PageSize int
+}
+
+// ListTickets lists tickets from the server's local store, following pagination until all
+// matching tickets have been fetched.
+func (c *Client) ListTickets(q *TicketQuery) ([]models.Ticket, error) {
+	return GetMany[models.Ticket](c, pathListTickets, q.params())
+}
+
+// GetTicket gets a ticket from the server's local store with its board, status, company,
+// contact, owner, resources, and note history.
+func (c *Client) GetTicket(id int) (*models.FullTicket, error) {
+	return GetOne[models.FullTicket](c, fmt.Sprintf(pathGetTicket, id), nil)
+}
+
+// SearchTickets runs a full-text search over ticket summaries and notes in the server's local
+// store, best matches first. The server default is used if limit is zero.
+func (c *Client) SearchTickets(query string, limit int) ([]models.TicketSearchResult, error) {
+	p := map[string]string{"q": query}
+	if limit != 0 {
+		p["limit"] = strconv.Itoa(limit)
+	}
+
+	return GetMany[models.TicketSearchResult](c, pathSearchTickets, p)
+}
+
+func (q *TicketQuery) params() map[string]string {
+	if q == nil {
+		return nil
+	}
+
+	p := make(map[string]string)
+	ints := map[string]int{
+		"board_id":   q.BoardID,
+		"status_id":  q.StatusID,
+		"owner_id":   q.OwnerID,
+		"company_id": q.CompanyID,
+		"limit":      q.PageSize,
+	}
+
+	for k, v := range ints {
+		if v != 0 {
+			p[k] = strconv.Itoa(v)
+		}
+	}
+
+	if !q.UpdatedSince.IsZero() {
+		p["updated_since"] = q.UpdatedSince.UTC().Format(time.RFC3339)
+	}
+
+	if q.State != "" {
+		p["state"] = q.State
+	}
+
+	return p
+}
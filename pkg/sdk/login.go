@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// StartLogin begins a device login. Show the user the verification URI and user code, then call
+// WaitForLogin.
+func (c *Client) StartLogin() (*models.DeviceLoginResponse, error) {
+	d := &models.DeviceLoginResponse{}
+	if err := c.Post(pathStartDeviceLogin, struct{}{}, d); err != nil {
+		return nil, fmt.Errorf("starting login: %w", err)
+	}
+
+	return d, nil
+}
+
+// PollLogin checks once whether the user has signed in. It returns
+// models.ErrAuthorizationPending until they have.
+func (c *Client) PollLogin(deviceCode string) (*models.SessionTokens, error) {
+	t := &models.SessionTokens{}
+	if err := c.Post(pathPollDeviceLogin, &models.DeviceTokenPayload{DeviceCode: deviceCode}, t); err != nil {
+		return nil, loginError(err)
+	}
+
+	return t, nil
+}
+
+// WaitForLogin polls until the user signs in, the login expires or is denied, or the context
+// is done.
+func (c *Client) WaitForLogin(ctx context.Context, d *models.DeviceLoginResponse) (*models.SessionTokens, error) {
+	interval := time.Duration(d.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(d.ExpiresIn)*time.Second)
+	defer cancel()
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, models.ErrLoginExpired
+			}
+			return nil, ctx.Err()
+		case <-t.C:
+			tok, err := c.PollLogin(d.DeviceCode)
+			if errors.Is(err, models.ErrAuthorizationPending) {
+				continue
+			}
+
+			return tok, err
+		}
+	}
+}
+
+func (c *Client) RefreshSession(refreshToken string) (*models.SessionTokens, error) {
+	t := &models.SessionTokens{}
+	if err := c.Post(pathRefreshSession, &models.RefreshTokenPayload{RefreshToken: refreshToken}, t); err != nil {
+		return nil, loginError(err)
+	}
+
+	return t, nil
+}
+
+// Logout ends the session on the server.
+func (c *Client) Logout(refreshToken string) error {
+	return c.Post(pathLogout, &models.RefreshTokenPayload{RefreshToken: refreshToken}, nil)
+}
+
+// loginError turns the server's login error codes back into their models errors.
+func loginError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	for _, e := range []error{
+		models.ErrAuthorizationPending,
+		models.ErrLoginExpired,
+		models.ErrLoginDenied,
+		models.ErrInvalidRefreshToken,
+	} {
+		if apiErr.Code == e.Error() {
+			return e
+		}
+	}
+
+	return err
+}
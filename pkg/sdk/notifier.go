@@ -8,7 +8,7 @@ import (
 )
 
 func (c *Client) ListNotifierRules() ([]models.NotifierRuleFull, error) {
-	return GetMany[models.NotifierRuleFull](c, "notifiers/rules", nil)
+	return GetMany[models.NotifierRuleFull](c, pathListNotifierRules, nil)
 }
 
 func (c *Client) GetNotifierRule(id int) (*models.NotifierRule, error) {
@@ -16,28 +16,55 @@ func (c *Client) GetNotifierRule(id int) (*models.NotifierRule, error) {
 		return nil, errors.New("no id provided")
 	}
 
-	return GetOne[models.NotifierRule](c, fmt.Sprintf("notifiers/rules/%d", id), nil)
+	return GetOne[models.NotifierRule](c, fmt.Sprintf(pathGetNotifierRule, id), nil)
 }
 
 func (c *Client) CreateNotifierRule(payload *models.NotifierRule) (*models.NotifierRule, error) {
 	n := &models.NotifierRule{}
-	if err := c.Post("notifiers/rules", payload, n); err != nil {
+	if err := c.Post(pathCreateNotifierRule, payload, n); err != nil {
 		return nil, fmt.Errorf("posting to server: %w", err)
 	}
 
 	return n, nil
 }
 
+func (c *Client) UpdateNotifierRule(payload *models.NotifierRule) (*models.NotifierRule, error) {
+	if payload.ID == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	n := &models.NotifierRule{}
+	if err := c.Put(fmt.Sprintf(pathUpdateNotifierRule, payload.ID), payload, n); err != nil {
+		return nil, fmt.Errorf("putting to server: %w", err)
+	}
+
+	return n, nil
+}
+
+// PatchNotifierRule changes only the fields given, keyed by their json names.
+func (c *Client) PatchNotifierRule(id int, fields map[string]any) (*models.NotifierRule, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	n := &models.NotifierRule{}
+	if err := c.Patch(fmt.Sprintf(pathPatchNotifierRule, id), fields, n); err != nil {
+		return nil, fmt.Errorf("patching on server: %w", err)
+	}
+
+	return n, nil
+}
+
 func (c *Client) DeleteNotifierRule(id int) error {
 	if id == 0 {
 		return errors.New("no id provided")
 	}
 
-	return c.Delete(fmt.Sprintf("notifiers/rules/%d", id))
+	return c.Delete(fmt.Sprintf(pathDeleteNotifierRule, id))
 }
 
 func (c *Client) ListUserForwards() ([]models.NotifierForwardFull, error) {
-	return GetMany[models.NotifierForwardFull](c, "notifiers/forwards", nil)
+	return GetMany[models.NotifierForwardFull](c, pathListForwards, nil)
 }
 
 func (c *Client) GetUserForward(id int) (*models.NotifierForward, error) {
@@ -45,22 +72,49 @@ func (c *Client) GetUserForward(id int) (*models.NotifierForward, error) {
 		return nil, errors.New("no id provided")
 	}
 
-	return GetOne[models.NotifierForward](c, fmt.Sprintf("notifiers/forwards/%d", id), nil)
+	return GetOne[models.NotifierForward](c, fmt.Sprintf(pathGetForward, id), nil)
 }
 
 func (c *Client) CreateUserForward(payload *models.NotifierForward) (*models.NotifierForward, error) {
 	uf := &models.NotifierForward{}
-	if err := c.Post("notifiers/forwards", payload, uf); err != nil {
+	if err := c.Post(pathCreateForward, payload, uf); err != nil {
 		return nil, fmt.Errorf("posting to server: %w", err)
 	}
 
 	return uf, nil
 }
 
+func (c *Client) UpdateUserForward(payload *models.NotifierForward) (*models.NotifierForward, error) {
+	if payload.ID == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	uf := &models.NotifierForward{}
+	if err := c.Put(fmt.Sprintf(pathUpdateForward, payload.ID), payload, uf); err != nil {
+		return nil, fmt.Errorf("putting to server: %w", err)
+	}
+
+	return uf, nil
+}
+
+// PatchUserForward changes only the fields given, keyed by their json names. A nil date clears it.
+func (c *Client) PatchUserForward(id int, fields map[string]any) (*models.NotifierForward, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	uf := &models.NotifierForward{}
+	if err := c.Patch(fmt.Sprintf(pathPatchForward, id), fields, uf); err != nil {
+		return nil, fmt.Errorf("patching on server: %w", err)
+	}
+
+	return uf, nil
+}
+
 func (c *Client) DeleteUserForward(id int) error {
 	if id == 0 {
 		return errors.New("no id provided")
 	}
 
-	return c.Delete(fmt.Sprintf("notifiers/forwards/%d", id))
+	return c.Delete(fmt.Sprintf(pathDeleteForward, id))
 }
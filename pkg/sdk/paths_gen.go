@@ -0,0 +1,112 @@
+// Code generated by openapi-gen from the server's routes. DO NOT EDIT.
+
+package sdk
+
+// API paths by operation ID, relative to the server's root URL. Paths with an id take it
+// with fmt.Sprintf.
+const (
+	// GET /audit
+	pathListAuditEvents = "v1/audit"
+	// POST /auth/device
+	pathStartDeviceLogin = "v1/auth/device"
+	// POST /auth/logout
+	pathLogout = "v1/auth/logout"
+	// POST /auth/refresh
+	pathRefreshSession = "v1/auth/refresh"
+	// POST /auth/token
+	pathPollDeviceLogin = "v1/auth/token"
+	// GET /authtest
+	pathAuthTest = "v1/authtest"
+	// GET /config
+	pathGetConfig = "v1/config"
+	// PUT /config
+	pathUpdateConfig = "v1/config"
+	// GET /config/schedules
+	pathListSchedules = "v1/config/schedules"
+	// POST /config/schedules
+	pathCreateSchedule = "v1/config/schedules"
+	// DELETE /config/schedules/{id}
+	pathDeleteSchedule = "v1/config/schedules/%d"
+	// GET /config/schedules/{id}
+	pathGetSchedule = "v1/config/schedules/%d"
+	// PUT /config/schedules/{id}
+	pathUpdateSchedule = "v1/config/schedules/%d"
+	// GET /cw/boards
+	pathListBoards = "v1/cw/boards"
+	// GET /cw/boards/{id}
+	pathGetBoard = "v1/cw/boards/%d"
+	// GET /cw/companies
+	pathListCompanies = "v1/cw/companies"
+	// GET /cw/companies/{id}
+	pathGetCompany = "v1/cw/companies/%d"
+	// GET /cw/contacts
+	pathListContacts = "v1/cw/contacts"
+	// GET /cw/contacts/{id}
+	pathGetContact = "v1/cw/contacts/%d"
+	// GET /cw/members
+	pathListMembers = "v1/cw/members"
+	// GET /cw/search
+	pathSearchTickets = "v1/cw/search"
+	// GET /cw/tickets
+	pathListTickets = "v1/cw/tickets"
+	// GET /cw/tickets/{id}
+	pathGetTicket = "v1/cw/tickets/%d"
+	// GET /notifiers/forwards
+	pathListForwards = "v1/notifiers/forwards"
+	// POST /notifiers/forwards
+	pathCreateForward = "v1/notifiers/forwards"
+	// DELETE /notifiers/forwards/{id}
+	pathDeleteForward = "v1/notifiers/forwards/%d"
+	// GET /notifiers/forwards/{id}
+	pathGetForward = "v1/notifiers/forwards/%d"
+	// PATCH /notifiers/forwards/{id}
+	pathPatchForward = "v1/notifiers/forwards/%d"
+	// PUT /notifiers/forwards/{id}
+	pathUpdateForward = "v1/notifiers/forwards/%d"
+	// GET /notifiers/rules
+	pathListNotifierRules = "v1/notifiers/rules"
+	// POST /notifiers/rules
+	pathCreateNotifierRule = "v1/notifiers/rules"
+	// DELETE /notifiers/rules/{id}
+	pathDeleteNotifierRule = "v1/notifiers/rules/%d"
+	// GET /notifiers/rules/{id}
+	pathGetNotifierRule = "v1/notifiers/rules/%d"
+	// PATCH /notifiers/rules/{id}
+	pathPatchNotifierRule = "v1/notifiers/rules/%d"
+	// PUT /notifiers/rules/{id}
+	pathUpdateNotifierRule = "v1/notifiers/rules/%d"
+	// POST /sync
+	pathStartSync = "v1/sync"
+	// GET /sync/runs
+	pathListSyncRuns = "v1/sync/runs"
+	// GET /sync/runs/{id}
+	pathGetSyncRun = "v1/sync/runs/%d"
+	// GET /users
+	pathListUsers = "v1/users"
+	// POST /users
+	pathCreateUser = "v1/users"
+	// GET /users/keys
+	pathListAPIKeys = "v1/users/keys"
+	// POST /users/keys
+	pathCreateAPIKey = "v1/users/keys"
+	// DELETE /users/keys/{id}
+	pathDeleteAPIKey = "v1/users/keys/%d"
+	// GET /users/keys/{id}
+	pathGetAPIKey = "v1/users/keys/%d"
+	// POST /users/keys/{id}/rotate
+	pathRotateAPIKey = "v1/users/keys/%d/rotate"
+	// GET /users/me
+	pathGetCurrentUser = "v1/users/me"
+	// DELETE /users/{id}
+	pathDeleteUser = "v1/users/%d"
+	// GET /users/{id}
+	pathGetUser = "v1/users/%d"
+	// PATCH /users/{id}
+	pathPatchUser = "v1/users/%d"
+	// PUT /users/{id}
+	pathUpdateUser = "v1/users/%d"
+	// GET /webex/rooms
+	pathListRecipients = "v1/webex/rooms"
+	// GET /webex/rooms/{id}
+	pathGetRecipient = "v1/webex/rooms/%d"
+)
@@ -5,5 +5,5 @@ import (
 )
 
 func (c *Client) ListRecipients() ([]models.WebexRecipient, error) {
-	return GetMany[models.WebexRecipient](c, "webex/rooms", nil)
+	return GetMany[models.WebexRecipient](c, pathListRecipients, nil)
 }
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+func (c *Client) ListSyncSchedules() ([]models.SyncSchedule, error) {
+	return GetMany[models.SyncSchedule](c, pathListSchedules, nil)
+}
+
+func (c *Client) GetSyncSchedule(id int) (*models.SyncSchedule, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	return GetOne[models.SyncSchedule](c, fmt.Sprintf(pathGetSchedule, id), nil)
+}
+
+func (c *Client) CreateSyncSchedule(payload *models.SyncSchedule) (*models.SyncSchedule, error) {
+	s := &models.SyncSchedule{}
+	if err := c.Post(pathCreateSchedule, payload, s); err != nil {
+		return nil, fmt.Errorf("posting to server: %w", err)
+	}
+
+	return s, nil
+}
+
+func (c *Client) UpdateSyncSchedule(payload *models.SyncSchedule) (*models.SyncSchedule, error) {
+	if payload.ID == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	s := &models.SyncSchedule{}
+	if err := c.Put(fmt.Sprintf(pathUpdateSchedule, payload.ID), payload, s); err != nil {
+		return nil, fmt.Errorf("sending update request: %w", err)
+	}
+
+	return s, nil
+}
+
+func (c *Client) DeleteSyncSchedule(id int) error {
+	if id == 0 {
+		return errors.New("no id provided")
+	}
+
+	return c.Delete(fmt.Sprintf(pathDeleteSchedule, id))
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thecoretg/ticketbot/internal/models"
+	"resty.dev/v3"
+)
+
+// sessionRefreshMargin refreshes the access token a little before it expires, so a request
+// doesn't race the expiry.
+const sessionRefreshMargin = time.Minute
+
+var (
+	ErrNoSession      = errors.New("not logged in")
+	ErrSessionExpired = errors.New("login session expired; log in again")
+)
+
+// Session is a login saved in the user's config directory.
+type Session struct {
+	BaseURL string `json:"base_url"`
+	models.SessionTokens
+}
+
+// SessionPath is where the session is saved, such as ~/.config/ticketbot/session.json on Linux.
+func SessionPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("finding config dir: %w", err)
+	}
+
+	return filepath.Join(dir, "ticketbot", "session.json"), nil
+}
+
+// LoadSession reads the saved session, or returns ErrNoSession if there isn't one.
+func LoadSession() (*Session, error) {
+	p, err := SessionPath()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNoSession
+		}
+		return nil, fmt.Errorf("reading session: %w", err)
+	}
+
+	s := &Session{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("parsing session %s: %w", p, err)
+	}
+
+	return s, nil
+}
+
+// Save writes the session so only the current user can read it.
+func (s *Session) Save() error {
+	p, err := SessionPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling session: %w", err)
+	}
+
+	// write then rename, so a failed write can't leave a half-written session behind
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return fmt.Errorf("writing session: %w", err)
+	}
+
+	return os.Rename(tmp, p)
+}
+
+// DeleteSession removes the saved session, if any.
+func DeleteSession() error {
+	p, err := SessionPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing session: %w", err)
+	}
+
+	return nil
+}
+
+// NewSessionClient creates a client that authenticates with a saved session. The access token is
+// refreshed and the session saved again whenever it is about to expire.
+func NewSessionClient(s *Session) (*Client, error) {
+	c, err := NewClient("", s.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c.session = s
+	c.restClient.AddRequestMiddleware(func(_ *resty.Client, r *resty.Request) error {
+		tok, err := c.sessionToken()
+		if err != nil {
+			return err
+		}
+
+		r.SetAuthToken(tok)
+		return nil
+	})
+
+	return c, nil
+}
+
+func (c *Client) sessionToken() (string, error) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	s := c.session
+	if time.Until(s.ExpiresOn) > sessionRefreshMargin {
+		return s.AccessToken, nil
+	}
+
+	if time.Now().After(s.RefreshExpiresOn) {
+		return "", ErrSessionExpired
+	}
+
+	// refresh with a plain client, since this one would try to refresh again
+	rc, err := NewClient("", s.BaseURL)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := rc.RefreshSession(s.RefreshToken)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRefreshToken) {
+			return "", ErrSessionExpired
+		}
+		return "", fmt.Errorf("refreshing session: %w", err)
+	}
+
+	s.SessionTokens = *t
+	if err := s.Save(); err != nil {
+		return "", fmt.Errorf("saving refreshed session: %w", err)
+	}
+
+	return s.AccessToken, nil
+}
@@ -1,7 +1,36 @@
 package sdk
 
-import "github.com/thecoretg/ticketbot/internal/models"
+import (
+	"errors"
+	"fmt"
+	"strconv"
 
-func (c *Client) Sync(payload *models.SyncPayload) error {
-	return c.Post("sync", payload, nil)
+	"github.com/thecoretg/ticketbot/internal/models"
+)
+
+// Sync starts a sync on the server and returns the run, which can be polled with GetSyncRun.
+func (c *Client) Sync(payload *models.SyncPayload) (*models.SyncRun, error) {
+	r := &models.SyncRun{}
+	if err := c.Post(pathStartSync, payload, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (c *Client) ListSyncRuns(limit int) ([]models.SyncRun, error) {
+	var params map[string]string
+	if limit > 0 {
+		params = map[string]string{"limit": strconv.Itoa(limit)}
+	}
+
+	return GetMany[models.SyncRun](c, pathListSyncRuns, params)
+}
+
+func (c *Client) GetSyncRun(id int) (*models.SyncRun, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	return GetOne[models.SyncRun](c, fmt.Sprintf(pathGetSyncRun, id), nil)
 }
@@ -8,54 +8,117 @@ import (
 )
 
 func (c *Client) GetCurrentUser() (*models.APIUser, error) {
-	return GetOne[models.APIUser](c, "users/me", nil)
+	return GetOne[models.APIUser](c, pathGetCurrentUser, nil)
 }
 
 func (c *Client) ListUsers() ([]models.APIUser, error) {
-	return GetMany[models.APIUser](c, "users", nil)
+	return GetMany[models.APIUser](c, pathListUsers, nil)
 }
 
-func (c *Client) GetUser(email string) (*models.APIUser, error) {
-	return GetOne[models.APIUser](c, fmt.Sprintf("users/%s", email), nil)
+func (c *Client) GetUser(id int) (*models.APIUser, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	return GetOne[models.APIUser](c, fmt.Sprintf(pathGetUser, id), nil)
 }
 
-func (c *Client) CreateUser(email string) (*models.APIUser, error) {
+// CreateUser creates a user with the role. If the role is empty, the server makes them read only.
+func (c *Client) CreateUser(email string, role models.Role) (*models.APIUser, error) {
+	if role != "" && !role.Valid() {
+		return nil, fmt.Errorf("invalid role %q; must be one of %v", role, models.Roles)
+	}
+
 	p := &models.APIUser{
 		EmailAddress: email,
+		Role:         role,
 	}
 
 	u := &models.APIUser{}
 
-	if err := c.Post("users", p, u); err != nil {
+	if err := c.Post(pathCreateUser, p, u); err != nil {
 		return nil, fmt.Errorf("posting to server: %w", err)
 	}
 
 	return u, nil
 }
 
+func (c *Client) UpdateUser(payload *models.APIUser) (*models.APIUser, error) {
+	if payload.ID == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	if !payload.Role.Valid() {
+		return nil, fmt.Errorf("invalid role %q; must be one of %v", payload.Role, models.Roles)
+	}
+
+	u := &models.APIUser{}
+	if err := c.Put(fmt.Sprintf(pathUpdateUser, payload.ID), payload, u); err != nil {
+		return nil, fmt.Errorf("putting to server: %w", err)
+	}
+
+	return u, nil
+}
+
+// PatchUser changes only the fields given, keyed by their json names.
+func (c *Client) PatchUser(id int, fields map[string]any) (*models.APIUser, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	u := &models.APIUser{}
+	if err := c.Patch(fmt.Sprintf(pathPatchUser, id), fields, u); err != nil {
+		return nil, fmt.Errorf("patching on server: %w", err)
+	}
+
+	return u, nil
+}
+
 func (c *Client) DeleteUser(id int) error {
 	if id == 0 {
 		return errors.New("no id provided")
 	}
 
-	return c.Delete(fmt.Sprintf("users/%d", id))
+	return c.Delete(fmt.Sprintf(pathDeleteUser, id))
 }
 
 func (c *Client) ListAPIKeys() ([]models.APIKey, error) {
-	return GetMany[models.APIKey](c, "users/keys", nil)
+	return GetMany[models.APIKey](c, pathListAPIKeys, nil)
 }
 
-func (c *Client) CreateAPIKey(email string) (string, error) {
-	p := &models.CreateAPIKeyPayload{
-		Email: email,
+func (c *Client) CreateAPIKey(p *models.CreateAPIKeyPayload) (*models.CreateAPIKeyResponse, error) {
+	if p.Email == "" {
+		return nil, errors.New("no email provided")
+	}
+
+	for _, s := range p.Scopes {
+		if !models.ValidScope(s) {
+			return nil, fmt.Errorf("invalid scope %q; must be one of %v", s, models.APIKeyScopes)
+		}
 	}
 
 	k := &models.CreateAPIKeyResponse{}
-	if err := c.Post("users/keys", p, k); err != nil {
-		return "", fmt.Errorf("posting to server: %w", err)
+	if err := c.Post(pathCreateAPIKey, p, k); err != nil {
+		return nil, fmt.Errorf("posting to server: %w", err)
+	}
+
+	return k, nil
+}
+
+// RotateAPIKey issues a replacement for a key, and the old key stops working after the grace
+// period. A zero grace uses the server's default.
+func (c *Client) RotateAPIKey(id int, graceHours int) (*models.RotateAPIKeyResponse, error) {
+	if id == 0 {
+		return nil, errors.New("no id provided")
+	}
+
+	p := &models.RotateAPIKeyPayload{GraceHours: graceHours}
+	r := &models.RotateAPIKeyResponse{}
+	if err := c.Post(fmt.Sprintf(pathRotateAPIKey, id), p, r); err != nil {
+		return nil, fmt.Errorf("posting to server: %w", err)
 	}
 
-	return k.Key, nil
+	return r, nil
 }
 
 func (c *Client) DeleteAPIKey(id int) error {
@@ -63,5 +126,5 @@ func (c *Client) DeleteAPIKey(id int) error {
 		return errors.New("no id provided")
 	}
 
-	return c.Delete(fmt.Sprintf("users/keys/%d", id))
+	return c.Delete(fmt.Sprintf(pathDeleteAPIKey, id))
 }
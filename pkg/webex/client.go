@@ -2,6 +2,7 @@ package webex
 
 import (
 	"net/http"
+	"strings"
 
 	"resty.dev/v3"
 )
@@ -10,14 +11,30 @@ type Client struct {
 	restClient *resty.Client
 	httpClient *http.Client
 	apiKey     string
+	baseURL    string
 }
 
-func NewClient(token string) *Client {
+type Option func(*Client)
+
+// WithBaseURL points the client at another Webex API, such as a local fake. The URL includes
+// the version path, e.g. http://localhost:8081/v1.
+func WithBaseURL(u string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(u, "/")
+	}
+}
+
+func NewClient(token string, opts ...Option) *Client {
 	c := resty.New()
 	c.SetAuthToken(token)
 	c.SetHeader("Content-Type", "application/json")
 	c.SetHeader("Accept", "application/json")
 	c.SetRetryCount(3)
 
-	return &Client{restClient: c}
+	cl := &Client{restClient: c, baseURL: defaultBaseURL}
+	for _, o := range opts {
+		o(cl)
+	}
+
+	return cl
 }
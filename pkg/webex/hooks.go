@@ -1,6 +1,7 @@
 package webex
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -11,11 +12,19 @@ import (
 )
 
 func (c *Client) CreateWebhook(webhook *Webhook) (*Webhook, error) {
-	return Post[Webhook](c, "webhooks", webhook)
+	return c.CreateWebhookCtx(context.Background(), webhook)
+}
+
+func (c *Client) CreateWebhookCtx(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	return PostCtx[Webhook](ctx, c, "webhooks", webhook)
 }
 
 func (c *Client) GetWebhooks(params map[string]string) ([]Webhook, error) {
-	resp, err := GetOne[ListWebhooksResp](c, "webhooks", params)
+	return c.GetWebhooksCtx(context.Background(), params)
+}
+
+func (c *Client) GetWebhooksCtx(ctx context.Context, params map[string]string) ([]Webhook, error) {
+	resp, err := GetOneCtx[ListWebhooksResp](ctx, c, "webhooks", params)
 	if err != nil {
 		return nil, fmt.Errorf("getting list of webhooks: %w", err)
 	}
@@ -25,15 +34,27 @@ func (c *Client) GetWebhooks(params map[string]string) ([]Webhook, error) {
 }
 
 func (c *Client) GetWebhook(webhookID string, params map[string]string) (*Webhook, error) {
-	return GetOne[Webhook](c, fmt.Sprintf("webhooks/%s", webhookID), params)
+	return c.GetWebhookCtx(context.Background(), webhookID, params)
+}
+
+func (c *Client) GetWebhookCtx(ctx context.Context, webhookID string, params map[string]string) (*Webhook, error) {
+	return GetOneCtx[Webhook](ctx, c, fmt.Sprintf("webhooks/%s", webhookID), params)
 }
 
 func (c *Client) PutWebhook(webhookID string, webhook *Webhook) (*Webhook, error) {
-	return Put[Webhook](c, fmt.Sprintf("webhooks/%s", webhookID), webhook)
+	return c.PutWebhookCtx(context.Background(), webhookID, webhook)
+}
+
+func (c *Client) PutWebhookCtx(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, error) {
+	return PutCtx[Webhook](ctx, c, fmt.Sprintf("webhooks/%s", webhookID), webhook)
 }
 
 func (c *Client) DeleteWebhook(webhookID string) error {
-	return Delete(c, fmt.Sprintf("webhooks/%s", webhookID))
+	return c.DeleteWebhookCtx(context.Background(), webhookID)
+}
+
+func (c *Client) DeleteWebhookCtx(ctx context.Context, webhookID string) error {
+	return DeleteCtx(ctx, c, fmt.Sprintf("webhooks/%s", webhookID))
 }
 
 // ValidateWebhook checks the X-Webex-Signature header against the HMAC-SHA256 of the body.
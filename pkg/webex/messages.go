@@ -1,6 +1,7 @@
 package webex
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -13,13 +14,25 @@ func NewMessageToRoom(roomID, roomName, text string) Message {
 }
 
 func (c *Client) GetMessage(messageID string, params map[string]string) (*Message, error) {
-	return GetOne[Message](c, fmt.Sprintf("messages/%s", messageID), params)
+	return c.GetMessageCtx(context.Background(), messageID, params)
+}
+
+func (c *Client) GetMessageCtx(ctx context.Context, messageID string, params map[string]string) (*Message, error) {
+	return GetOneCtx[Message](ctx, c, fmt.Sprintf("messages/%s", messageID), params)
 }
 
 func (c *Client) PostMessage(message *Message) (*Message, error) {
-	return Post[Message](c, "messages", message)
+	return c.PostMessageCtx(context.Background(), message)
+}
+
+func (c *Client) PostMessageCtx(ctx context.Context, message *Message) (*Message, error) {
+	return PostCtx[Message](ctx, c, "messages", message)
 }
 
 func (c *Client) GetAttachmentAction(messageID string) (*AttachmentAction, error) {
-	return GetOne[AttachmentAction](c, fmt.Sprintf("attachment/actions/%s", messageID), nil)
+	return c.GetAttachmentActionCtx(context.Background(), messageID)
+}
+
+func (c *Client) GetAttachmentActionCtx(ctx context.Context, messageID string) (*AttachmentAction, error) {
+	return GetOneCtx[AttachmentAction](ctx, c, fmt.Sprintf("attachment/actions/%s", messageID), nil)
 }
@@ -1,16 +1,29 @@
 package webex
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+)
 
 func (c *Client) ListPeople(email string) ([]Person, error) {
+	return c.ListPeopleCtx(context.Background(), email)
+}
+
+func (c *Client) ListPeopleCtx(ctx context.Context, email string) ([]Person, error) {
 	params := map[string]string{
 		"email": email,
 	}
 
-	resp, err := GetOne[ListPeopleResp](c, "people", params)
+	resp, err := GetOneCtx[ListPeopleResp](ctx, c, "people", params)
 	if err != nil {
 		return nil, fmt.Errorf("listing people: %w", err)
 	}
 
 	return resp.Items, nil
 }
+
+// ListPeopleSeq iterates over people matching the params, fetching pages as they are needed.
+func (c *Client) ListPeopleSeq(ctx context.Context, params map[string]string) iter.Seq2[Person, error] {
+	return GetItemsSeq[Person](ctx, c, "people", params)
+}
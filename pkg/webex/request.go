@@ -1,24 +1,31 @@
 package webex
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
 
 const (
-	baseURL = "https://webexapis.com/v1"
+	defaultBaseURL = "https://webexapis.com/v1"
 )
 
 var ErrNotFound = errors.New("404 status received")
 
 func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, error) {
+	return GetOneCtx[T](context.Background(), c, endpoint, params)
+}
+
+func GetOneCtx[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) (*T, error) {
 	var target T
 	res, err := c.restClient.R().
+		SetContext(ctx).
 		SetQueryParams(params).
 		SetResult(&target).
-		Get(fullURL(baseURL, endpoint))
+		Get(fullURL(c.baseURL, endpoint))
 	if err != nil {
 		return nil, err
 	}
@@ -34,12 +41,17 @@ func GetOne[T any](c *Client, endpoint string, params map[string]string) (*T, er
 }
 
 func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T, error) {
+	return GetManyCtx[T](context.Background(), c, endpoint, params)
+}
+
+func GetManyCtx[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) ([]T, error) {
 	var allItems []T
 
-	endpoint = fullURL(baseURL, endpoint)
+	endpoint = fullURL(c.baseURL, endpoint)
 	for endpoint != "" {
 		var target []T
 		req := c.restClient.R().
+			SetContext(ctx).
 			SetQueryParams(params).
 			SetResult(&target)
 
@@ -64,12 +76,60 @@ func GetMany[T any](c *Client, endpoint string, params map[string]string) ([]T,
 	return allItems, nil
 }
 
+type itemsResp[T any] struct {
+	Items []T `json:"items"`
+}
+
+// GetItemsSeq returns an iterator over the items of a Webex list endpoint, which wraps each page
+// in an "items" object. Pages are requested lazily as the iterator is consumed by following the
+// Link header. If a request fails, the error is yielded and iteration stops.
+func GetItemsSeq[T any](ctx context.Context, c *Client, endpoint string, params map[string]string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		p := params
+		next := fullURL(c.baseURL, endpoint)
+		for next != "" {
+			var target itemsResp[T]
+			res, err := c.restClient.R().
+				SetContext(ctx).
+				SetQueryParams(p).
+				SetResult(&target).
+				Get(next)
+			if err == nil && res.IsError() {
+				err = fmt.Errorf("error response from Webex API: %s", res.String())
+				if res.StatusCode() == http.StatusNotFound {
+					err = ErrNotFound
+				}
+			}
+
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range target.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			p = nil
+			next = parseLinkHeader(res.Header().Get("Link"), "next")
+		}
+	}
+}
+
 func Put[T any](c *Client, endpoint string, body any) (*T, error) {
+	return PutCtx[T](context.Background(), c, endpoint, body)
+}
+
+func PutCtx[T any](ctx context.Context, c *Client, endpoint string, body any) (*T, error) {
 	var target T
 	res, err := c.restClient.R().
+		SetContext(ctx).
 		SetBody(body).
 		SetResult(target).
-		Put(fullURL(baseURL, endpoint))
+		Put(fullURL(c.baseURL, endpoint))
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +145,16 @@ func Put[T any](c *Client, endpoint string, body any) (*T, error) {
 }
 
 func Post[T any](c *Client, endpoint string, body any) (*T, error) {
+	return PostCtx[T](context.Background(), c, endpoint, body)
+}
+
+func PostCtx[T any](ctx context.Context, c *Client, endpoint string, body any) (*T, error) {
 	var target T
 	res, err := c.restClient.R().
+		SetContext(ctx).
 		SetBody(body).
 		SetResult(target).
-		Post(fullURL(baseURL, endpoint))
+		Post(fullURL(c.baseURL, endpoint))
 	if err != nil {
 		return nil, err
 	}
@@ -102,8 +167,13 @@ func Post[T any](c *Client, endpoint string, body any) (*T, error) {
 }
 
 func Delete(c *Client, endpoint string) error {
+	return DeleteCtx(context.Background(), c, endpoint)
+}
+
+func DeleteCtx(ctx context.Context, c *Client, endpoint string) error {
 	res, err := c.restClient.R().
-		Delete(fullURL(baseURL, endpoint))
+		SetContext(ctx).
+		Delete(fullURL(c.baseURL, endpoint))
 	if err != nil {
 		return err
 	}
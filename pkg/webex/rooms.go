@@ -1,12 +1,25 @@
 package webex
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"iter"
+)
 
 func (c *Client) ListRooms(params map[string]string) ([]Room, error) {
-	resp, err := GetOne[ListRoomsResp](c, "rooms", params)
+	return c.ListRoomsCtx(context.Background(), params)
+}
+
+func (c *Client) ListRoomsCtx(ctx context.Context, params map[string]string) ([]Room, error) {
+	resp, err := GetOneCtx[ListRoomsResp](ctx, c, "rooms", params)
 	if err != nil {
 		return nil, fmt.Errorf("listing rooms: %w", err)
 	}
 
 	return resp.Items, nil
 }
+
+// ListRoomsSeq iterates over rooms matching the params, fetching pages as they are needed.
+func (c *Client) ListRoomsSeq(ctx context.Context, params map[string]string) iter.Seq2[Room, error] {
+	return GetItemsSeq[Room](ctx, c, "rooms", params)
+}
@@ -2,16 +2,40 @@
 SELECT * FROM api_key
 WHERE id = $1;
 
+-- name: GetAPIKeyByKeyID :one
+SELECT * FROM api_key
+WHERE key_id = $1;
+
 -- name: ListAPIKeys :many
 SELECT * FROM api_key
 ORDER BY created_on;
 
+-- name: ListLegacyAPIKeys :many
+SELECT * FROM api_key
+WHERE key_id IS NULL AND legacy_until > NOW()
+ORDER BY created_on;
+
 -- name: InsertAPIKey :one
 INSERT INTO api_key
-(user_id, key_hash, key_hint)
-VALUES ($1, $2, $3)
+(user_id, key_hash, key_hint, key_id, legacy_until, name, expires_on, scopes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
+-- name: UpdateAPIKeyExpiry :one
+UPDATE api_key
+SET
+    expires_on = $2,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_key
+SET
+    last_used_at = NOW(),
+    last_used_ip = $2
+WHERE id = $1;
+
 -- name: SoftDeleteAPIKey :one
 UPDATE api_key
 SET
@@ -19,14 +19,15 @@ ORDER BY email_address;
 
 -- name: InsertUser :one
 INSERT INTO api_user
-(email_address)
-VALUES ($1)
+(email_address, role)
+VALUES ($1, $2)
 RETURNING *;
 
 -- name: UpdateUser :one
 UPDATE api_user
 SET
     email_address = $2,
+    role = $3,
     updated_on = NOW()
 WHERE id = $1
 RETURNING *;
@@ -0,0 +1,16 @@
+-- name: ListAuditEvents :many
+SELECT * FROM audit_event
+WHERE (sqlc.narg(user_id)::int IS NULL OR user_id = sqlc.narg(user_id))
+    AND (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action))
+    AND (sqlc.narg(entity_type)::text IS NULL OR entity_type = sqlc.narg(entity_type))
+    AND (sqlc.narg(entity_id)::int IS NULL OR entity_id = sqlc.narg(entity_id))
+    AND (sqlc.narg(since)::timestamp IS NULL OR created_on >= sqlc.narg(since))
+    AND (sqlc.narg(until)::timestamp IS NULL OR created_on < sqlc.narg(until))
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit)::int;
+
+-- name: InsertAuditEvent :one
+INSERT INTO audit_event
+(user_id, action, entity_type, entity_id, before, after)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
@@ -0,0 +1,38 @@
+-- name: GetBoardItem :one
+SELECT * FROM cw_board_item
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAllBoardItems :many
+SELECT * FROM cw_board_item
+ORDER BY id;
+
+-- name: ListBoardItemsByBoard :many
+SELECT * FROM cw_board_item
+WHERE board_id = $1
+ORDER BY id;
+
+-- name: UpsertBoardItem :one
+INSERT INTO cw_board_item (
+    id,
+    board_id,
+    name,
+    inactive
+)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET
+    board_id = EXCLUDED.board_id,
+    name = EXCLUDED.name,
+    inactive = EXCLUDED.inactive,
+    updated_on = NOW()
+RETURNING *;
+
+-- name: SoftDeleteBoardItem :exec
+UPDATE cw_board_item
+SET
+    deleted = TRUE,
+    updated_on = NOW()
+WHERE id = $1;
+
+-- name: DeleteBoardItem :exec
+DELETE FROM cw_board_item
+WHERE id = $1;
@@ -0,0 +1,38 @@
+-- name: GetBoardSubtype :one
+SELECT * FROM cw_board_subtype
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAllBoardSubtypes :many
+SELECT * FROM cw_board_subtype
+ORDER BY id;
+
+-- name: ListBoardSubtypesByBoard :many
+SELECT * FROM cw_board_subtype
+WHERE board_id = $1
+ORDER BY id;
+
+-- name: UpsertBoardSubtype :one
+INSERT INTO cw_board_subtype (
+    id,
+    board_id,
+    name,
+    inactive
+)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET
+    board_id = EXCLUDED.board_id,
+    name = EXCLUDED.name,
+    inactive = EXCLUDED.inactive,
+    updated_on = NOW()
+RETURNING *;
+
+-- name: SoftDeleteBoardSubtype :exec
+UPDATE cw_board_subtype
+SET
+    deleted = TRUE,
+    updated_on = NOW()
+WHERE id = $1;
+
+-- name: DeleteBoardSubtype :exec
+DELETE FROM cw_board_subtype
+WHERE id = $1;
@@ -0,0 +1,40 @@
+-- name: GetBoardTeam :one
+SELECT * FROM cw_board_team
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAllBoardTeams :many
+SELECT * FROM cw_board_team
+ORDER BY id;
+
+-- name: ListBoardTeamsByBoard :many
+SELECT * FROM cw_board_team
+WHERE board_id = $1
+ORDER BY id;
+
+-- name: UpsertBoardTeam :one
+INSERT INTO cw_board_team (
+    id,
+    board_id,
+    name,
+    leader_id,
+    default_team
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    board_id = EXCLUDED.board_id,
+    name = EXCLUDED.name,
+    leader_id = EXCLUDED.leader_id,
+    default_team = EXCLUDED.default_team,
+    updated_on = NOW()
+RETURNING *;
+
+-- name: SoftDeleteBoardTeam :exec
+UPDATE cw_board_team
+SET
+    deleted = TRUE,
+    updated_on = NOW()
+WHERE id = $1;
+
+-- name: DeleteBoardTeam :exec
+DELETE FROM cw_board_team
+WHERE id = $1;
@@ -0,0 +1,40 @@
+-- name: GetBoardType :one
+SELECT * FROM cw_board_type
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAllBoardTypes :many
+SELECT * FROM cw_board_type
+ORDER BY id;
+
+-- name: ListBoardTypesByBoard :many
+SELECT * FROM cw_board_type
+WHERE board_id = $1
+ORDER BY id;
+
+-- name: UpsertBoardType :one
+INSERT INTO cw_board_type (
+    id,
+    board_id,
+    name,
+    default_type,
+    inactive
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    board_id = EXCLUDED.board_id,
+    name = EXCLUDED.name,
+    default_type = EXCLUDED.default_type,
+    inactive = EXCLUDED.inactive,
+    updated_on = NOW()
+RETURNING *;
+
+-- name: SoftDeleteBoardType :exec
+UPDATE cw_board_type
+SET
+    deleted = TRUE,
+    updated_on = NOW()
+WHERE id = $1;
+
+-- name: DeleteBoardType :exec
+DELETE FROM cw_board_type
+WHERE id = $1;
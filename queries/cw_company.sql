@@ -6,6 +6,11 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM cw_company
 ORDER BY id;
 
+-- name: SearchCompanies :many
+SELECT * FROM cw_company
+WHERE deleted = false AND strpos(lower(name), lower(sqlc.arg(name)::text)) > 0
+ORDER BY name;
+
 -- name: UpsertCompany :one
 INSERT INTO cw_company
 (id, name)
@@ -6,6 +6,11 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM cw_contact
 ORDER BY id;
 
+-- name: SearchContacts :many
+SELECT * FROM cw_contact
+WHERE deleted = false AND strpos(lower(first_name || ' ' || COALESCE(last_name, '')), lower(sqlc.arg(name)::text)) > 0
+ORDER BY first_name, last_name;
+
 -- name: UpsertContact :one
 INSERT INTO cw_contact
 (id, first_name, last_name, company_id)
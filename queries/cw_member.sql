@@ -6,6 +6,10 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM cw_member
 WHERE identifier = $1 LIMIT 1;
 
+-- name: GetActiveMemberByEmail :one
+SELECT * FROM cw_member
+WHERE deleted = false AND lower(primary_email) = lower(sqlc.arg(email)::text) LIMIT 1;
+
 -- name: ListMembers :many
 SELECT * FROM cw_member
 ORDER BY id;
@@ -0,0 +1,35 @@
+-- name: GetPriority :one
+SELECT * FROM cw_priority
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAllPriorities :many
+SELECT * FROM cw_priority
+ORDER BY id;
+
+-- name: UpsertPriority :one
+INSERT INTO cw_priority (
+    id,
+    name,
+    color,
+    sort_order,
+    default_priority
+)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name,
+    color = EXCLUDED.color,
+    sort_order = EXCLUDED.sort_order,
+    default_priority = EXCLUDED.default_priority,
+    updated_on = NOW()
+RETURNING *;
+
+-- name: SoftDeletePriority :exec
+UPDATE cw_priority
+SET
+    deleted = TRUE,
+    updated_on = NOW()
+WHERE id = $1;
+
+-- name: DeletePriority :exec
+DELETE FROM cw_priority
+WHERE id = $1;
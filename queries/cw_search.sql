@@ -0,0 +1,41 @@
+-- name: SearchTickets :many
+WITH q AS (
+    SELECT websearch_to_tsquery('english', sqlc.arg(query)::text) AS query
+),
+note_hits AS (
+    SELECT
+        n.ticket_id,
+        MAX(ts_rank(to_tsvector('english', COALESCE(n.content, '')), q.query)) AS rank,
+        (ARRAY_AGG(
+            ts_headline('english', COALESCE(n.content, ''), q.query,
+                'StartSel=**, StopSel=**, MaxWords=25, MinWords=10, MaxFragments=2')
+            ORDER BY ts_rank(to_tsvector('english', COALESCE(n.content, '')), q.query) DESC
+        ))[1] AS snippet
+    FROM cw_ticket_note n, q
+    WHERE n.deleted = false
+        AND to_tsvector('english', COALESCE(n.content, '')) @@ q.query
+    GROUP BY n.ticket_id
+)
+SELECT
+    t.id,
+    t.summary,
+    t.board_id,
+    b.name AS board_name,
+    t.status_id,
+    s.name AS status_name,
+    s.closed,
+    t.company_id,
+    c.name AS company_name,
+    t.updated_on,
+    (ts_rank(to_tsvector('english', t.summary), q.query) * 2 + COALESCE(nh.rank, 0))::real AS rank,
+    COALESCE(nh.snippet, '')::text AS snippet
+FROM cw_ticket t
+CROSS JOIN q
+JOIN cw_board b ON b.id = t.board_id
+JOIN cw_ticket_status s ON s.id = t.status_id
+JOIN cw_company c ON c.id = t.company_id
+LEFT JOIN note_hits nh ON nh.ticket_id = t.id
+WHERE t.deleted = false
+    AND (to_tsvector('english', t.summary) @@ q.query OR nh.ticket_id IS NOT NULL)
+ORDER BY rank DESC, t.id DESC
+LIMIT sqlc.arg(max_results)::int;
@@ -6,6 +6,21 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM cw_ticket
 ORDER BY id;
 
+-- name: QueryTickets :many
+SELECT * FROM cw_ticket
+WHERE deleted = false
+    AND id > sqlc.arg(cursor)::int
+    AND (sqlc.narg(board_id)::int IS NULL OR board_id = sqlc.narg(board_id))
+    AND (sqlc.narg(status_id)::int IS NULL OR status_id = sqlc.narg(status_id))
+    AND (sqlc.narg(owner_id)::int IS NULL OR owner_id = sqlc.narg(owner_id))
+    AND (sqlc.narg(company_id)::int IS NULL OR company_id = sqlc.narg(company_id))
+    AND (sqlc.narg(updated_since)::timestamp IS NULL OR updated_on >= sqlc.narg(updated_since))
+    AND (sqlc.narg(closed)::bool IS NULL OR status_id IN (
+        SELECT s.id FROM cw_ticket_status s WHERE s.closed = sqlc.narg(closed)
+    ))
+ORDER BY id
+LIMIT sqlc.arg(page_size)::int;
+
 -- name: CheckTicketExists :one
 SELECT EXISTS (
     SELECT 1
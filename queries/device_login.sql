@@ -0,0 +1,36 @@
+-- name: GetDeviceLoginByDeviceCode :one
+SELECT * FROM device_login
+WHERE device_code_hash = $1 LIMIT 1;
+
+-- name: GetDeviceLoginByUserCode :one
+SELECT * FROM device_login
+WHERE user_code = $1 LIMIT 1;
+
+-- name: GetDeviceLoginByState :one
+SELECT * FROM device_login
+WHERE state = $1 LIMIT 1;
+
+-- name: InsertDeviceLogin :one
+INSERT INTO device_login(device_code_hash, user_code, state, expires_on)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ApproveDeviceLogin :one
+UPDATE device_login
+SET user_id = $2
+WHERE id = $1 AND user_id IS NULL AND denied_reason IS NULL
+RETURNING *;
+
+-- name: DenyDeviceLogin :one
+UPDATE device_login
+SET denied_reason = $2
+WHERE id = $1 AND user_id IS NULL AND denied_reason IS NULL
+RETURNING *;
+
+-- name: DeleteDeviceLogin :exec
+DELETE FROM device_login
+WHERE id = $1;
+
+-- name: DeleteExpiredDeviceLogins :exec
+DELETE FROM device_login
+WHERE expires_on < NOW();
@@ -0,0 +1,23 @@
+-- name: ClaimDispatchNotification :one
+-- Returns no row when the recipient was already sent this window, or another instance claimed
+-- it less than five minutes ago and may still be sending.
+INSERT INTO dispatch_notification
+(schedule_entry_id, ticket_id, member_id, date_start, date_end, recipient_id, forwarded_from_id, claimed_on)
+VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+ON CONFLICT (schedule_entry_id, member_id, date_start, date_end, recipient_id) DO UPDATE SET
+    ticket_id = EXCLUDED.ticket_id,
+    forwarded_from_id = EXCLUDED.forwarded_from_id,
+    claimed_on = EXCLUDED.claimed_on
+WHERE dispatch_notification.sent = FALSE
+AND (dispatch_notification.claimed_on IS NULL OR dispatch_notification.claimed_on < CURRENT_TIMESTAMP - INTERVAL '5 minutes')
+RETURNING *;
+
+-- name: MarkDispatchNotificationSent :exec
+UPDATE dispatch_notification
+SET sent = TRUE
+WHERE id = $1;
+
+-- name: ReleaseDispatchNotification :exec
+UPDATE dispatch_notification
+SET claimed_on = NULL
+WHERE id = $1 AND sent = FALSE;
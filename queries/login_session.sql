@@ -0,0 +1,31 @@
+-- name: GetLoginSessionByTokenHash :one
+SELECT * FROM login_session
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: GetLoginSessionByRefreshHash :one
+SELECT * FROM login_session
+WHERE refresh_hash = $1 LIMIT 1;
+
+-- name: InsertLoginSession :one
+INSERT INTO login_session(user_id, token_hash, refresh_hash, expires_on, refresh_expires_on)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: RefreshLoginSession :one
+UPDATE login_session
+SET
+    token_hash = $2,
+    refresh_hash = $3,
+    expires_on = $4,
+    refresh_expires_on = $5,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteLoginSession :exec
+DELETE FROM login_session
+WHERE id = $1;
+
+-- name: DeleteExpiredLoginSessions :exec
+DELETE FROM login_session
+WHERE refresh_expires_on < NOW();
@@ -43,6 +43,19 @@ INSERT INTO notifier_forward (
 ) VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
+-- name: UpdateNotifierForward :one
+UPDATE notifier_forward
+SET
+    source_id = $2,
+    destination_id = $3,
+    start_date = $4,
+    end_date = $5,
+    enabled = $6,
+    user_keeps_copy = $7,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteNotifierForward :exec
 DELETE FROM notifier_forward
 WHERE id = $1;
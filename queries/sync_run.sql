@@ -0,0 +1,23 @@
+-- name: ListSyncRuns :many
+SELECT * FROM sync_run
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: GetSyncRun :one
+SELECT * FROM sync_run
+WHERE id = $1 LIMIT 1;
+
+-- name: InsertSyncRun :one
+INSERT INTO sync_run(payload, status)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpdateSyncRun :one
+UPDATE sync_run
+SET
+    status = $2,
+    results = $3,
+    errors = $4,
+    finished_on = $5
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,33 @@
+-- name: ListSyncSchedules :many
+SELECT * FROM sync_schedule
+ORDER BY name;
+
+-- name: GetSyncSchedule :one
+SELECT * FROM sync_schedule
+WHERE id = $1 LIMIT 1;
+
+-- name: InsertSyncSchedule :one
+INSERT INTO sync_schedule(name, cron, payload, enabled)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateSyncSchedule :one
+UPDATE sync_schedule
+SET
+    name = $2,
+    cron = $3,
+    payload = $4,
+    enabled = $5,
+    updated_on = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ClaimSyncScheduleRun :one
+UPDATE sync_schedule
+SET last_run_on = sqlc.arg(last_run_on)
+WHERE id = sqlc.arg(id) AND last_run_on IS NOT DISTINCT FROM sqlc.narg(prev_run_on)
+RETURNING *;
+
+-- name: DeleteSyncSchedule :exec
+DELETE FROM sync_schedule
+WHERE id = $1;